@@ -37,6 +37,51 @@ var (
 	// ImageActionsTimer records the time cost of each image action.
 	ImageActionsTimer = metrics.NewLabelTimer(subsystemPouch, "image_actions", "The number of seconds it takes to process each image action", "action")
 
+	// ContainerMonitorQueueDepth records the number of container events waiting to be handled by monitor.
+	ContainerMonitorQueueDepth = metrics.NewGauge(subsystemPouch, "container_monitor_queue_depth", "The number of container events waiting to be handled by monitor")
+
+	// ContainerMonitorHandleTimer records the time cost of handling each container event.
+	ContainerMonitorHandleTimer = metrics.NewLabelTimer(subsystemPouch, "container_monitor_handle", "The number of seconds it takes to handle each container event", "kind")
+
+	// EventWebhookDeliveredCounter records the number of events delivered to each webhook.
+	EventWebhookDeliveredCounter = metrics.NewLabelCounter(subsystemPouch, "event_webhook_delivered_events", "The number of events delivered to webhook", "webhook")
+
+	// EventWebhookFailuresCounter records the number of failed deliveries to each webhook.
+	EventWebhookFailuresCounter = metrics.NewLabelCounter(subsystemPouch, "event_webhook_delivery_failures", "The number of failed deliveries to webhook", "webhook")
+
+	// EventWebhookDroppedCounter records the number of events dropped by each webhook, because the spool is full or the batch is rejected.
+	EventWebhookDroppedCounter = metrics.NewLabelCounter(subsystemPouch, "event_webhook_dropped_events", "The number of events dropped by webhook", "webhook")
+
+	// EventWebhookDeliveryLagTimer records the time from the oldest event of a batch is published to the batch is delivered.
+	EventWebhookDeliveryLagTimer = metrics.NewLabelTimer(subsystemPouch, "event_webhook_delivery_lag", "The number of seconds from events are published to they are delivered to webhook", "webhook")
+
+	// EventWebhookSpoolDepth records the number of batches waiting to be delivered in the spool of each webhook.
+	EventWebhookSpoolDepth = metrics.NewLabelGauge(subsystemPouch, "event_webhook_spool_batches", "The number of batches waiting to be delivered to webhook", "webhook")
+
+	// APIInflightRequests records the number of admitted API requests in flight of each class.
+	APIInflightRequests = metrics.NewLabelGauge(subsystemPouch, "api_inflight_requests", "The number of admitted API requests in flight", "class")
+
+	// APIQueuedRequests records the number of API requests waiting for admission of each class.
+	APIQueuedRequests = metrics.NewLabelGauge(subsystemPouch, "api_queued_requests", "The number of API requests waiting for admission", "class")
+
+	// APIRejectedRequestsCounter records the number of API requests rejected by admission control of each class.
+	APIRejectedRequestsCounter = metrics.NewLabelCounter(subsystemPouch, "api_rejected_requests", "The number of API requests rejected by admission control", "class")
+
+	// APIQueueWaitTimer records the time that the admitted API requests wait in queue of each class.
+	APIQueueWaitTimer = metrics.NewLabelTimer(subsystemPouch, "api_queue_wait", "The number of seconds that API requests wait for admission", "class")
+
+	// GRPCRequestsCounter records the number of gRPC requests of each method by status code.
+	GRPCRequestsCounter = metrics.NewLabelCounter(subsystemPouch, "grpc_requests", "The number of gRPC requests", "method", "code")
+
+	// GRPCRequestsTimer records the time to handle the gRPC requests of each method.
+	GRPCRequestsTimer = metrics.NewLabelTimer(subsystemPouch, "grpc_requests", "The number of seconds it takes to handle each gRPC request", "method")
+
+	// HookPluginCallsCounter records the number of hook calls to each remote hook plugin by result.
+	HookPluginCallsCounter = metrics.NewLabelCounter(subsystemPouch, "hook_plugin_calls", "The number of hook calls to remote hook plugin", "plugin", "hook", "result")
+
+	// HookPluginCallsTimer records the time of hook calls to each remote hook plugin.
+	HookPluginCallsTimer = metrics.NewLabelTimer(subsystemPouch, "hook_plugin_calls", "The number of seconds it takes to call hook of remote hook plugin", "plugin", "hook")
+
 	// EngineVersion records the version and commit information of the engine process.
 	EngineVersion = metrics.NewLabelGauge(subsystemPouch, "engine", "The version and commit information of the engine process", "commit", "version", "kernel")
 )
@@ -57,5 +102,20 @@ func Register() {
 		registry.MustRegister(ImageSuccessActionsCounter)
 		registry.MustRegister(ContainerActionsTimer)
 		registry.MustRegister(ImageActionsTimer)
+		registry.MustRegister(ContainerMonitorQueueDepth)
+		registry.MustRegister(ContainerMonitorHandleTimer)
+		registry.MustRegister(EventWebhookDeliveredCounter)
+		registry.MustRegister(EventWebhookFailuresCounter)
+		registry.MustRegister(EventWebhookDroppedCounter)
+		registry.MustRegister(EventWebhookDeliveryLagTimer)
+		registry.MustRegister(EventWebhookSpoolDepth)
+		registry.MustRegister(APIInflightRequests)
+		registry.MustRegister(APIQueuedRequests)
+		registry.MustRegister(APIRejectedRequestsCounter)
+		registry.MustRegister(APIQueueWaitTimer)
+		registry.MustRegister(GRPCRequestsCounter)
+		registry.MustRegister(GRPCRequestsTimer)
+		registry.MustRegister(HookPluginCallsCounter)
+		registry.MustRegister(HookPluginCallsTimer)
 	})
 }
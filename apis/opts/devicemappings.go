@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/cdi"
 )
 
 // ParseDeviceMappings parse devicemappings
@@ -28,6 +29,15 @@ func ParseDeviceMappings(devices []string) ([]*types.DeviceMapping, error) {
 
 // parseDevice parses a device mapping string to a container.DeviceMapping struct
 func parseDevice(device string) (*types.DeviceMapping, error) {
+	// CDI device is resolved by daemon, the name may contain colon.
+	if cdi.IsQualifiedName(device) {
+		return &types.DeviceMapping{
+			PathOnHost:        device,
+			PathInContainer:   device,
+			CgroupPermissions: "rwm",
+		}, nil
+	}
+
 	src := ""
 	dst := ""
 	permissions := "rwm"
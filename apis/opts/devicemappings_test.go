@@ -45,6 +45,18 @@ func TestParseDeviceMapping(t *testing.T) {
 			}},
 			wantErr: false,
 		},
+		{
+			name: "cdiDevice",
+			args: args{
+				device: []string{"vendor.com/device=mig:0"},
+			},
+			want: []*types.DeviceMapping{{
+				PathOnHost:        "vendor.com/device=mig:0",
+				PathInContainer:   "vendor.com/device=mig:0",
+				CgroupPermissions: "rwm",
+			}},
+			wantErr: false,
+		},
 		{
 			name: "deviceMappingWrong1",
 			args: args{
@@ -0,0 +1,72 @@
+package opts
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ParseSecrets parses the secret params of container, the format is
+// name[,target=<path>,uid=<uid>,gid=<gid>,mode=<octal mode>].
+func ParseSecrets(secrets []string) ([]*types.SecretReference, error) {
+	var refs []*types.SecretReference
+	for _, secret := range secrets {
+		ref, err := parseSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+func parseSecret(secret string) (*types.SecretReference, error) {
+	fields := strings.Split(secret, ",")
+	ref := &types.SecretReference{}
+
+	for i, field := range fields {
+		kv := strings.SplitN(field, "=", 2)
+		if i == 0 && len(kv) == 1 {
+			ref.Name = field
+			continue
+		}
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid secret %s: %s is not in key=value format", secret, field)
+		}
+
+		key, value := strings.ToLower(kv[0]), kv[1]
+		switch key {
+		case "source", "src", "name":
+			ref.Name = value
+		case "target":
+			ref.Target = value
+		case "uid":
+			uid, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || uid < 0 {
+				return nil, fmt.Errorf("invalid secret %s: invalid uid %s", secret, value)
+			}
+			ref.UID = uid
+		case "gid":
+			gid, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || gid < 0 {
+				return nil, fmt.Errorf("invalid secret %s: invalid gid %s", secret, value)
+			}
+			ref.GID = gid
+		case "mode":
+			mode, err := strconv.ParseUint(value, 8, 32)
+			if err != nil || mode&^0777 != 0 {
+				return nil, fmt.Errorf("invalid secret %s: invalid mode %s", secret, value)
+			}
+			ref.Mode = uint32(mode)
+		default:
+			return nil, fmt.Errorf("invalid secret %s: unknown option %s", secret, key)
+		}
+	}
+
+	if ref.Name == "" {
+		return nil, fmt.Errorf("invalid secret %s: name of secret is required", secret)
+	}
+	return ref, nil
+}
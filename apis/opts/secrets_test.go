@@ -0,0 +1,35 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSecrets(t *testing.T) {
+	refs, err := ParseSecrets([]string{
+		"db-password",
+		"tls-key,target=/etc/tls/key.pem,uid=1000,gid=1000,mode=0400",
+		"source=token,target=/token",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.SecretReference{
+		{Name: "db-password"},
+		{Name: "tls-key", Target: "/etc/tls/key.pem", UID: 1000, GID: 1000, Mode: 0400},
+		{Name: "token", Target: "/token"},
+	}, refs)
+
+	for _, secret := range []string{
+		"",
+		"target=/token",
+		"token,target",
+		"token,uid=-1",
+		"token,mode=999",
+		"token,mode=01777",
+		"token,size=10",
+	} {
+		_, err := ParseSecrets([]string{secret})
+		assert.Error(t, err, secret)
+	}
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/alibaba/pouch/apis/metrics"
+	serverTypes "github.com/alibaba/pouch/apis/server/types"
+	"github.com/alibaba/pouch/pkg/admission"
+	"github.com/alibaba/pouch/pkg/httputils"
+)
+
+// unlimitedAPIs are never limited, so that the health check and version
+// negotiation work when daemon is busy.
+var unlimitedAPIs = map[string]bool{
+	"/_ping":   true,
+	"/version": true,
+	"/metrics": true,
+}
+
+// apiClass returns the class of API for admission control.
+func apiClass(h *serverTypes.HandlerSpec) admission.Class {
+	if serverTypes.StreamingAPIs[h.Path] {
+		return admission.ClassStreaming
+	}
+	if h.Method == http.MethodGet || h.Method == http.MethodHead {
+		return admission.ClassRead
+	}
+	return admission.ClassMutating
+}
+
+// clientIdentity returns the identity of client which the per-client limits
+// apply to, it's the common name of TLS certificate, the uid of unix socket
+// peer, or the IP address.
+func clientIdentity(req *http.Request) string {
+	if req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+		return "cn:" + req.TLS.PeerCertificates[0].Subject.CommonName
+	}
+	if cred := peerCredFromContext(req.Context()); cred != nil {
+		return fmt.Sprintf("uid:%d", cred.UID)
+	}
+	if host, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+		return "ip:" + host
+	}
+	return "ip:" + req.RemoteAddr
+}
+
+// observeAdmission exports the stats of admission control as metrics.
+func observeAdmission(class admission.Class, stats admission.Stats) {
+	metrics.APIInflightRequests.WithLabelValues(string(class)).Set(float64(stats.Inflight))
+	metrics.APIQueuedRequests.WithLabelValues(string(class)).Set(float64(stats.Queued))
+}
+
+// withAdmission admits the request by the limits of its class and client,
+// the request over limit is rejected with 429 and Retry-After. The request
+// stops waiting in queue if the client goes away.
+func (s *Server) withAdmission(h *serverTypes.HandlerSpec, handler serverTypes.Handler) serverTypes.Handler {
+	if s.admission == nil || unlimitedAPIs[h.Path] {
+		return handler
+	}
+
+	class := apiClass(h)
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		start := time.Now()
+		release, err := s.admission.Acquire(req.Context(), class, clientIdentity(req))
+		if err != nil {
+			if err != admission.ErrRejected {
+				return err
+			}
+			metrics.APIRejectedRequestsCounter.WithLabelValues(string(class)).Inc()
+			rw.Header().Set("Retry-After", strconv.Itoa(s.admission.RetryAfter(class)))
+			return httputils.NewHTTPError(fmt.Errorf("too many %s requests, please retry later", class), http.StatusTooManyRequests)
+		}
+		defer release()
+
+		metrics.APIQueueWaitTimer.WithLabelValues(string(class)).Observe(time.Since(start).Seconds())
+		return handler(ctx, rw, req)
+	}
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	serverTypes "github.com/alibaba/pouch/apis/server/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/admission"
+	"github.com/alibaba/pouch/pkg/audit"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIClass(t *testing.T) {
+	assert.Equal(t, admission.ClassStreaming, apiClass(&serverTypes.HandlerSpec{Method: http.MethodGet, Path: "/containers/{name:.*}/logs"}))
+	assert.Equal(t, admission.ClassStreaming, apiClass(&serverTypes.HandlerSpec{Method: http.MethodPost, Path: "/images/create"}))
+	assert.Equal(t, admission.ClassRead, apiClass(&serverTypes.HandlerSpec{Method: http.MethodGet, Path: "/containers/json"}))
+	assert.Equal(t, admission.ClassMutating, apiClass(&serverTypes.HandlerSpec{Method: http.MethodPost, Path: "/containers/create"}))
+	assert.Equal(t, admission.ClassMutating, apiClass(&serverTypes.HandlerSpec{Method: http.MethodDelete, Path: "/volumes/{name:.*}"}))
+}
+
+func TestStreamingAPIsAreRouted(t *testing.T) {
+	paths := map[string]bool{}
+	err := initRoute(&Server{Config: &config.Config{}}).Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		if tpl, err := route.GetPathTemplate(); err == nil {
+			paths[tpl] = true
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	for path := range serverTypes.StreamingAPIs {
+		assert.True(t, paths[path], "streaming API %s is not routed", path)
+	}
+}
+
+func TestClientIdentity(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/containers/json", nil)
+	req.RemoteAddr = "10.0.0.1:4321"
+	assert.Equal(t, "ip:10.0.0.1", clientIdentity(req))
+
+	ctx := context.WithValue(req.Context(), peerCredKey{}, &audit.PeerCred{UID: 1000})
+	assert.Equal(t, "uid:1000", clientIdentity(req.WithContext(ctx)))
+
+	req.TLS = &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "automation"}}},
+	}
+	assert.Equal(t, "cn:automation", clientIdentity(req))
+}
+
+func TestWithAdmission(t *testing.T) {
+	block := make(chan struct{})
+	started := make(chan struct{})
+	handler := func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		started <- struct{}{}
+		<-block
+		rw.WriteHeader(http.StatusOK)
+		return nil
+	}
+
+	cfg := admission.Config{Mutating: admission.Limit{MaxInflightPerClient: 1, RetryAfter: 3}}
+	assert.NoError(t, cfg.Validate())
+	s := &Server{admission: admission.New(cfg, observeAdmission)}
+
+	create := &serverTypes.HandlerSpec{Method: http.MethodPost, Path: "/containers/create", HandlerFunc: handler}
+	h := filter(s.withAdmission(create, create.HandlerFunc), s)
+
+	newRequest := func(addr string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/containers/create", nil)
+		req.RemoteAddr = addr
+		return req
+	}
+
+	done := make(chan int)
+	go func() {
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, newRequest("10.0.0.1:1000"))
+		done <- rw.Code
+	}()
+	<-started
+
+	// the second request of the same client is rejected.
+	rw := httptest.NewRecorder()
+	h.ServeHTTP(rw, newRequest("10.0.0.1:1001"))
+	assert.Equal(t, http.StatusTooManyRequests, rw.Code)
+	assert.Equal(t, "3", rw.Header().Get("Retry-After"))
+	assert.Contains(t, rw.Body.String(), "too many mutating requests")
+
+	// the request of another client is admitted.
+	go func() {
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, newRequest("10.0.0.2:1000"))
+		done <- rw.Code
+	}()
+	<-started
+
+	close(block)
+	assert.Equal(t, http.StatusOK, <-done)
+	assert.Equal(t, http.StatusOK, <-done)
+
+	// ping is never limited.
+	ping := &serverTypes.HandlerSpec{Method: http.MethodGet, Path: "/_ping", HandlerFunc: handler}
+	assert.Nil(t, s.withAdmission(ping, nil))
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	serverTypes "github.com/alibaba/pouch/apis/server/types"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/version"
+
+	"github.com/gorilla/mux"
+)
+
+// apiVersionHeader is the header of response which is the latest api
+// version of daemon, so that client can negotiate the version.
+const apiVersionHeader = "API-Version"
+
+// apiVersionKey is the key of the api version of request in context.
+type apiVersionKey struct{}
+
+// withAPIVersion rejects the request if its api version is not supported
+// by daemon or the API, and saves the version in context for handler to
+// gate the fields by version.
+func withAPIVersion(h *serverTypes.HandlerSpec) serverTypes.Handler {
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		rw.Header().Set(apiVersionHeader, version.APIVersion)
+
+		v := mux.Vars(req)["version"]
+		if v == "" {
+			v = version.APIVersion
+		}
+
+		if version.CompareAPIVersion(v, version.APIVersion) > 0 {
+			return httputils.NewHTTPError(fmt.Errorf("client API version %s is too new, the maximum supported API version is %s", v, version.APIVersion), http.StatusBadRequest)
+		}
+		if version.CompareAPIVersion(v, version.MinAPIVersion) < 0 {
+			return httputils.NewHTTPError(fmt.Errorf("client API version %s is too old, the minimum supported API version is %s, please upgrade your client", v, version.MinAPIVersion), http.StatusBadRequest)
+		}
+		if h.MinVersion != "" && version.CompareAPIVersion(v, h.MinVersion) < 0 {
+			return httputils.NewHTTPError(fmt.Errorf("%s %s requires API version %s, but the client API version is %s", h.Method, h.Path, h.MinVersion, v), http.StatusBadRequest)
+		}
+
+		return h.HandlerFunc(context.WithValue(ctx, apiVersionKey{}, v), rw, req)
+	}
+}
+
+// apiVersionFromContext returns the api version of request, which is the
+// latest one if it's not specified.
+func apiVersionFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(apiVersionKey{}).(string); ok {
+		return v
+	}
+	return version.APIVersion
+}
+
+// checkFieldVersion returns error if the field set in request is introduced
+// in a newer api version than the one of request.
+func checkFieldVersion(ctx context.Context, field string, set bool, minVersion string) error {
+	if v := apiVersionFromContext(ctx); set && version.CompareAPIVersion(v, minVersion) < 0 {
+		return httputils.NewHTTPError(fmt.Errorf("%s requires API version %s, but the client API version is %s", field, minVersion, v), http.StatusBadRequest)
+	}
+	return nil
+}
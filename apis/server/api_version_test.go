@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	serverTypes "github.com/alibaba/pouch/apis/server/types"
+	"github.com/alibaba/pouch/version"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithAPIVersion(t *testing.T) {
+	var requested string
+	handler := func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		requested = apiVersionFromContext(ctx)
+		if err := checkFieldVersion(ctx, "WorkingDir", req.FormValue("dir") != "", "1.25"); err != nil {
+			return err
+		}
+		rw.WriteHeader(http.StatusOK)
+		return nil
+	}
+
+	s := &Server{}
+	r := mux.NewRouter()
+	for _, h := range []*serverTypes.HandlerSpec{
+		{Method: http.MethodGet, Path: "/containers/json", HandlerFunc: handler},
+		{Method: http.MethodGet, Path: "/pods/json", HandlerFunc: handler, MinVersion: "1.25"},
+	} {
+		r.Path(versionMatcher + h.Path).Methods(h.Method).Handler(filter(withAPIVersion(h), s))
+		r.Path(h.Path).Methods(h.Method).Handler(filter(withAPIVersion(h), s))
+	}
+
+	for _, tc := range []struct {
+		path      string
+		code      int
+		version   string
+		errString string
+	}{
+		{"/containers/json", http.StatusOK, version.APIVersion, ""},
+		{"/v1.24/containers/json", http.StatusOK, "1.24", ""},
+		{"/v1.23/containers/json", http.StatusBadRequest, "", "too old"},
+		{"/v9.0/containers/json", http.StatusBadRequest, "", "too new"},
+		{"/v1.24/pods/json", http.StatusBadRequest, "", "GET /pods/json requires API version 1.25"},
+		{"/v1.25/pods/json", http.StatusOK, "1.25", ""},
+		{"/v1.24/containers/json?dir=/", http.StatusBadRequest, "1.24", "WorkingDir requires API version 1.25"},
+		{"/v1.25/containers/json?dir=/", http.StatusOK, "1.25", ""},
+	} {
+		requested = ""
+		rw := httptest.NewRecorder()
+		r.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+		assert.Equal(t, tc.code, rw.Code, tc.path)
+		assert.Equal(t, tc.version, requested, tc.path)
+		assert.Equal(t, version.APIVersion, rw.Header().Get(apiVersionHeader), tc.path)
+		if tc.errString != "" {
+			assert.True(t, strings.Contains(rw.Body.String(), tc.errString), rw.Body.String())
+		}
+	}
+}
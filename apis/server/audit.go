@@ -0,0 +1,187 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/netutils"
+
+	"github.com/gorilla/mux"
+)
+
+// maxAuditBodySize is the max size of request body recorded in audit log.
+const maxAuditBodySize = 64 * 1024
+
+type peerCredKey struct{}
+
+// withPeerCred stores the credentials of unix socket peer into the context
+// of connection, which is used by http.Server.ConnContext.
+func withPeerCred(ctx context.Context, conn net.Conn) context.Context {
+	if cred := unixPeerCred(conn); cred != nil {
+		return context.WithValue(ctx, peerCredKey{}, cred)
+	}
+	return ctx
+}
+
+// unixPeerCred returns the credentials of unix socket peer, or nil if conn
+// is not unix socket.
+func unixPeerCred(conn net.Conn) *audit.PeerCred {
+	if _, ok := conn.(*net.UnixConn); !ok {
+		return nil
+	}
+
+	cred, err := netutils.GetPeerCred(conn)
+	if err != nil {
+		log.With(nil).Warnf("failed to get peer credentials of %s: %v", conn.LocalAddr(), err)
+		return nil
+	}
+
+	return &audit.PeerCred{
+		UID: cred.Uid,
+		GID: cred.Gid,
+		PID: cred.Pid,
+	}
+}
+
+// peerCredFromContext returns the credentials of unix socket peer.
+func peerCredFromContext(ctx context.Context) *audit.PeerCred {
+	cred, _ := ctx.Value(peerCredKey{}).(*audit.PeerCred)
+	return cred
+}
+
+// newAuditRecord creates the audit record of request, the request body is
+// replaced since part of it has been read to be recorded.
+func (s *Server) newAuditRecord(ctx context.Context, req *http.Request, requestID string) *audit.Record {
+	record := &audit.Record{
+		Time:      time.Now(),
+		RequestID: requestID,
+		Method:    req.Method,
+		Path:      req.URL.RequestURI(),
+		Client: audit.Client{
+			Address: req.RemoteAddr,
+			Peer:    peerCredFromContext(req.Context()),
+		},
+	}
+
+	if req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+		record.Client.TLSSubject = req.TLS.PeerCertificates[0].Subject.CommonName
+		record.Client.TLSIssuer = req.TLS.PeerCertificates[0].Issuer.CommonName
+	}
+
+	for key, value := range mux.Vars(req) {
+		if key == "version" {
+			continue
+		}
+		record.AddTarget(key, value)
+	}
+
+	// resolve the container ID since the name in path may be name or
+	// the prefix of ID.
+	if name, ok := mux.Vars(req)["name"]; ok && strings.Contains(req.URL.Path, "/containers/") && s.ContainerMgr != nil {
+		if c, err := s.ContainerMgr.Get(ctx, name); err == nil {
+			record.AddTarget("container_id", c.ID)
+		}
+	}
+
+	record.Body, record.BodyTruncated = readAuditBody(req)
+	return record
+}
+
+// readAuditBody reads the json request body and returns the redacted body.
+// The body larger than maxAuditBodySize is not recorded.
+func readAuditBody(req *http.Request) ([]byte, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, false
+	}
+
+	// NOTE: pouch client doesn't set Content-Type for json body, so only
+	// skip the body which is not json explicitly, such as tar stream.
+	if ct := req.Header.Get("Content-Type"); ct != "" && !strings.Contains(ct, "json") {
+		return nil, false
+	}
+
+	// the value of secret is never recorded.
+	if strings.HasSuffix(req.URL.Path, "/secrets/create") {
+		return nil, false
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(req.Body, maxAuditBodySize+1))
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), req.Body), req.Body}
+	if err != nil || len(data) == 0 {
+		return nil, false
+	}
+
+	if len(data) > maxAuditBodySize {
+		return nil, true
+	}
+	return audit.Redact(data), false
+}
+
+// auditResponseWriter records the status code of response.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *auditResponseWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Status returns the status code of response, 200 if nothing written.
+func (w *auditResponseWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+// Flush implements http.Flusher.
+func (w *auditResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// CloseNotify implements http.CloseNotifier.
+func (w *auditResponseWriter) CloseNotify() <-chan bool {
+	if n, ok := w.ResponseWriter.(http.CloseNotifier); ok {
+		return n.CloseNotify()
+	}
+	return make(chan bool)
+}
+
+// Hijack implements http.Hijacker.
+func (w *auditResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a hijack connection")
+	}
+
+	if w.status == 0 {
+		w.status = http.StatusSwitchingProtocols
+	}
+	return h.Hijack()
+}
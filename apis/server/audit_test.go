@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterAuditLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	logger, err := audit.NewLogger(audit.Config{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{auditLogger: logger}
+
+	r := mux.NewRouter()
+	r.Path("/containers/{name:.*}/start").Methods(http.MethodPost).Handler(filter(func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// handler still reads the whole body.
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), "PASSWORD=abc")
+		return errors.Wrap(errtypes.ErrNotfound, "container foo")
+	}, s))
+	r.Path("/containers/json").Methods(http.MethodGet).Handler(filter(func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		return EncodeResponse(rw, http.StatusOK, []string{})
+	}, s))
+
+	req := httptest.NewRequest(http.MethodPost, "/containers/foo/start", strings.NewReader(`{"Env":["PASSWORD=abc"]}`))
+	rw := httptest.NewRecorder()
+	r.ServeHTTP(rw, req)
+	assert.Equal(t, http.StatusNotFound, rw.Code)
+
+	// read-only call is not recorded by default.
+	req = httptest.NewRequest(http.MethodGet, "/containers/json", nil)
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.NoError(t, logger.Close())
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Equal(t, 1, len(lines))
+
+	record := &audit.Record{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), record))
+	assert.Equal(t, http.MethodPost, record.Method)
+	assert.Equal(t, "/containers/foo/start", record.Path)
+	assert.Equal(t, map[string]string{"name": "foo"}, record.Targets)
+	assert.Equal(t, `{"Env":["PASSWORD=<redacted>"]}`, string(record.Body))
+	assert.Equal(t, http.StatusNotFound, record.Status)
+	assert.Equal(t, audit.OutcomeFailure, record.Outcome)
+	assert.Contains(t, record.Error, "container foo")
+	assert.Equal(t, 10, len(record.RequestID))
+}
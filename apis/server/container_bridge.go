@@ -13,6 +13,7 @@ import (
 	"github.com/alibaba/pouch/apis/metrics"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/audit"
 	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/streams"
@@ -46,8 +47,8 @@ func (s *Server) createContainer(ctx context.Context, rw http.ResponseWriter, re
 	logCreateOptions(ctx, "container", config)
 
 	// validate request body
-	if err := config.Validate(strfmt.NewFormats()); err != nil {
-		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	if err := validateContainerCreateConfig(ctx, config); err != nil {
+		return err
 	}
 
 	name := req.FormValue("name")
@@ -57,24 +58,41 @@ func (s *Server) createContainer(ctx context.Context, rw http.ResponseWriter, re
 		config.SpecificID = specificID
 	}
 
-	// to do compensation to potential nil pointer after validation
-	if config.HostConfig == nil {
-		config.HostConfig = &types.HostConfig{}
-	}
-	if config.NetworkingConfig == nil {
-		config.NetworkingConfig = &types.NetworkingConfig{}
-	}
-
 	container, err := s.ContainerMgr.Create(ctx, name, config)
 	if err != nil {
 		return err
 	}
+	audit.AddTarget(ctx, "container_id", container.ID)
 
 	metrics.ContainerSuccessActionsCounter.WithLabelValues(label).Inc()
 
 	return EncodeResponse(rw, http.StatusCreated, container)
 }
 
+// validateContainerCreateConfig validates the config to create container, and
+// does compensation to the potential nil pointers after validation.
+func validateContainerCreateConfig(ctx context.Context, config *types.ContainerCreateConfig) error {
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+	if hc := config.HostConfig; hc != nil {
+		if err := checkFieldVersion(ctx, "HostConfig.Pod", hc.Pod != "", "1.25"); err != nil {
+			return err
+		}
+		if err := checkFieldVersion(ctx, "HostConfig.Secrets", len(hc.Secrets) > 0, "1.25"); err != nil {
+			return err
+		}
+	}
+
+	if config.HostConfig == nil {
+		config.HostConfig = &types.HostConfig{}
+	}
+	if config.NetworkingConfig == nil {
+		config.NetworkingConfig = &types.NetworkingConfig{}
+	}
+	return nil
+}
+
 func (s *Server) getContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	name := mux.Vars(req)["name"]
 
@@ -83,6 +101,11 @@ func (s *Server) getContainer(ctx context.Context, rw http.ResponseWriter, req *
 		return err
 	}
 
+	return EncodeResponse(rw, http.StatusOK, buildContainerJSON(c))
+}
+
+// buildContainerJSON builds the details of container returned by inspect.
+func buildContainerJSON(c *mgr.Container) types.ContainerJSON {
 	mounts := []types.MountPoint{}
 	for _, mp := range c.Mounts {
 		mounts = append(mounts, *mp)
@@ -94,7 +117,7 @@ func (s *Server) getContainer(ctx context.Context, rw http.ResponseWriter, req *
 		hostRootPath = mergedDir
 	}
 
-	container := types.ContainerJSON{
+	return types.ContainerJSON{
 		ID:           c.ID,
 		Name:         c.Name,
 		Image:        c.Image,
@@ -122,8 +145,6 @@ func (s *Server) getContainer(ctx context.Context, rw http.ResponseWriter, req *
 		ProcessLabel:    c.ProcessLabel,
 		ExecIds:         c.ExecIds,
 	}
-
-	return EncodeResponse(rw, http.StatusOK, container)
 }
 
 func (s *Server) getContainers(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
@@ -145,45 +166,60 @@ func (s *Server) getContainers(ctx context.Context, rw http.ResponseWriter, req
 	containerList := make([]types.Container, 0, len(cons))
 
 	for _, c := range cons {
-		status, err := c.FormatStatus()
+		singleCon, err := buildContainer(c)
 		if err != nil {
 			return err
 		}
 
-		t, err := time.Parse(utils.TimeLayout, c.Created)
-		if err != nil {
-			return err
-		}
-
-		var netSettings *types.ContainerNetworkSettings
-		if c.NetworkSettings != nil {
-			netSettings = &types.ContainerNetworkSettings{
-				Networks: c.NetworkSettings.Networks,
+		if httputils.BoolValue(req, "size") {
+			singleCon.SizeRw, singleCon.SizeRootFs, err = s.ContainerMgr.Size(ctx, c.ID)
+			if err != nil {
+				log.With(ctx).Warnf("failed to get size of container %s: %v", c.ID, err)
 			}
 		}
 
-		mounts := []types.MountPoint{}
-		for _, mp := range c.Mounts {
-			mounts = append(mounts, *mp)
-		}
+		containerList = append(containerList, singleCon)
+	}
+	return EncodeResponse(rw, http.StatusOK, containerList)
+}
+
+// buildContainer builds the summary of container returned by list.
+func buildContainer(c *mgr.Container) (types.Container, error) {
+	status, err := c.FormatStatus()
+	if err != nil {
+		return types.Container{}, err
+	}
+
+	t, err := time.Parse(utils.TimeLayout, c.Created)
+	if err != nil {
+		return types.Container{}, err
+	}
 
-		singleCon := types.Container{
-			ID:              c.ID,
-			Names:           []string{c.Name},
-			Image:           c.Config.Image,
-			ImageID:         c.Image,
-			Command:         strings.Join(c.Config.Cmd, " "),
-			Status:          status,
-			Created:         t.Unix(),
-			Labels:          c.Config.Labels,
-			HostConfig:      c.HostConfig,
-			NetworkSettings: netSettings,
-			Mounts:          mounts,
+	var netSettings *types.ContainerNetworkSettings
+	if c.NetworkSettings != nil {
+		netSettings = &types.ContainerNetworkSettings{
+			Networks: c.NetworkSettings.Networks,
 		}
+	}
 
-		containerList = append(containerList, singleCon)
+	mounts := []types.MountPoint{}
+	for _, mp := range c.Mounts {
+		mounts = append(mounts, *mp)
 	}
-	return EncodeResponse(rw, http.StatusOK, containerList)
+
+	return types.Container{
+		ID:              c.ID,
+		Names:           []string{c.Name},
+		Image:           c.Config.Image,
+		ImageID:         c.Image,
+		Command:         strings.Join(c.Config.Cmd, " "),
+		Status:          status,
+		Created:         t.Unix(),
+		Labels:          c.Config.Labels,
+		HostConfig:      c.HostConfig,
+		NetworkSettings: netSettings,
+		Mounts:          mounts,
+	}, nil
 }
 
 func (s *Server) startContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
@@ -351,6 +387,39 @@ func (s *Server) attachContainer(ctx context.Context, rw http.ResponseWriter, re
 	return nil
 }
 
+func (s *Server) attachContainerWebSocket(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	c, err := s.ContainerMgr.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	ws, err := openWebSocketConnection(rw, req)
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+
+	go ws.handleResize(func(opts types.ResizeOptions) error {
+		return s.ContainerMgr.Resize(ctx, c.ID, opts)
+	})
+
+	attach := &streams.AttachConfig{
+		UseStdin:  httputils.BoolValue(req, "stdin"),
+		Stdin:     ws.stdin,
+		UseStdout: true,
+		Stdout:    ws.stdout,
+		UseStderr: true,
+		Stderr:    ws.stderr,
+	}
+
+	if err := s.ContainerMgr.AttachContainerIO(ctx, c.ID, attach); err != nil {
+		ws.writeError(err)
+	}
+	return nil
+}
+
 func (s *Server) updateContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	label := util_metrics.ActionUpdateLabel
 	defer func(start time.Time) {
@@ -29,8 +29,8 @@ func (s *Server) createContainerExec(ctx context.Context, rw http.ResponseWriter
 	logCreateOptions(ctx, "container exec for "+name, config)
 
 	// validate request body
-	if err := config.Validate(strfmt.NewFormats()); err != nil {
-		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	if err := validateExecCreateConfig(ctx, config); err != nil {
+		return err
 	}
 
 	id, err := s.ContainerMgr.CreateExec(ctx, name, config)
@@ -45,6 +45,14 @@ func (s *Server) createContainerExec(ctx context.Context, rw http.ResponseWriter
 	return EncodeResponse(rw, http.StatusCreated, execCreateResp)
 }
 
+// validateExecCreateConfig validates the config to create exec process.
+func validateExecCreateConfig(ctx context.Context, config *types.ExecCreateConfig) error {
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+	return checkFieldVersion(ctx, "WorkingDir", config.WorkingDir != "", "1.25")
+}
+
 func (s *Server) startContainerExec(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	config := &types.ExecStartConfig{}
 	// decode request body
@@ -110,6 +118,43 @@ func (s *Server) startContainerExec(ctx context.Context, rw http.ResponseWriter,
 	return nil
 }
 
+func (s *Server) startContainerExecWebSocket(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	execInfo, err := s.ContainerMgr.InspectExec(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	ws, err := openWebSocketConnection(rw, req)
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+
+	log.With(ctx).Infof("start exec %s over websocket", name)
+
+	go ws.handleResize(func(opts types.ResizeOptions) error {
+		return s.ContainerMgr.ResizeExec(ctx, name, opts)
+	})
+
+	attach := &streams.AttachConfig{
+		Terminal:  execInfo.ProcessConfig.Tty,
+		UseStdin:  true,
+		Stdin:     ws.stdin,
+		UseStdout: true,
+		Stdout:    ws.stdout,
+	}
+	if !attach.Terminal {
+		attach.UseStderr, attach.Stderr = true, ws.stderr
+	}
+
+	if err := s.ContainerMgr.StartExec(ctx, name, attach, 0); err != nil {
+		ws.writeError(err)
+	}
+	return nil
+}
+
 func (s *Server) getExecInfo(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	name := mux.Vars(req)["name"]
 	execInfo, err := s.ContainerMgr.InspectExec(ctx, name)
@@ -146,6 +191,27 @@ func (s *Server) resizeExec(ctx context.Context, rw http.ResponseWriter, req *ht
 
 }
 
+func (s *Server) killExec(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	if err := s.ContainerMgr.KillExec(ctx, name, req.FormValue("signal")); err != nil {
+		return err
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) listContainerExecs(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	execs, err := s.ContainerMgr.ListExecs(ctx, name)
+	if err != nil {
+		return err
+	}
+	return EncodeResponse(rw, http.StatusOK, execs)
+}
+
 func openHijackConnection(rw http.ResponseWriter) (io.ReadCloser, io.Writer, func() error, error) {
 	hijacker, ok := rw.(http.Hijacker)
 	if !ok {
@@ -0,0 +1,470 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/alibaba/pouch/apis/grpc/v1"
+	"github.com/alibaba/pouch/apis/metrics"
+	"github.com/alibaba/pouch/pkg/admission"
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/randomid"
+	"github.com/alibaba/pouch/pkg/trace"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// grpcReadMethods are the RPCs which only read the state of daemon, they
+// are in the read class of admission control and audited as GET.
+var grpcReadMethods = map[string]bool{
+	"/pouch.v1.ContainerService/List":        true,
+	"/pouch.v1.ContainerService/Inspect":     true,
+	"/pouch.v1.ContainerService/InspectExec": true,
+	"/pouch.v1.ImageService/List":            true,
+	"/pouch.v1.ImageService/Inspect":         true,
+	"/pouch.v1.VolumeService/List":           true,
+	"/pouch.v1.VolumeService/Inspect":        true,
+	"/pouch.v1.NetworkService/List":          true,
+	"/pouch.v1.NetworkService/Inspect":       true,
+	"/pouch.v1.SystemService/Version":        true,
+}
+
+// grpcUnlimitedMethods are never limited by admission control, the same as
+// /version of REST API.
+var grpcUnlimitedMethods = map[string]bool{
+	"/pouch.v1.SystemService/Version": true,
+}
+
+// grpcServicesToWait are the services whose requests are drained when
+// daemon stops, the same as routeGroupToWait of REST API.
+var grpcServicesToWait = []string{"/pouch.v1.ContainerService/", "/pouch.v1.VolumeService/", "/pouch.v1.NetworkService/"}
+
+// newGRPCServer creates the gRPC server which serves the same managers as
+// REST API.
+func (s *Server) newGRPCServer() *grpc.Server {
+	server := grpc.NewServer(
+		grpc.Creds(connCredentials{}),
+		grpc.UnaryInterceptor(s.unaryInterceptor),
+		grpc.StreamInterceptor(s.streamInterceptor),
+	)
+
+	v1.RegisterContainerServiceServer(server, &grpcContainerService{s})
+	v1.RegisterImageServiceServer(server, &grpcImageService{s})
+	v1.RegisterVolumeServiceServer(server, &grpcVolumeService{s})
+	v1.RegisterNetworkServiceServer(server, &grpcNetworkService{s})
+	v1.RegisterSystemServiceServer(server, &grpcSystemService{s})
+	return server
+}
+
+// grpcNextProtos are the protocols negotiated by ALPN on the TLS listeners
+// of gRPC, gRPC is served over HTTP/2.
+var grpcNextProtos = []string{"h2"}
+
+// connAuthInfo is the identity of gRPC connection, it's the TLS state or the
+// credentials of unix socket peer.
+type connAuthInfo struct {
+	tls  *tls.ConnectionState
+	peer *audit.PeerCred
+}
+
+// AuthType implements credentials.AuthInfo.
+func (info connAuthInfo) AuthType() string {
+	if info.tls != nil {
+		return "tls"
+	}
+	return "insecure"
+}
+
+// connCredentials gets the identity of gRPC connection. The TLS of listener
+// is done by netutils.GetListeners, the same as REST API, so the handshake
+// is only finished here to get the state.
+type connCredentials struct{}
+
+func (connCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, fmt.Errorf("client handshake is not supported")
+}
+
+func (connCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		if err := tlsConn.Handshake(); err != nil {
+			return nil, nil, err
+		}
+		state := tlsConn.ConnectionState()
+		return conn, connAuthInfo{tls: &state}, nil
+	}
+	return conn, connAuthInfo{peer: unixPeerCred(conn)}, nil
+}
+
+func (connCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "pouch"}
+}
+
+func (c connCredentials) Clone() credentials.TransportCredentials {
+	return c
+}
+
+func (connCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+// grpcCall is the information of a gRPC request.
+type grpcCall struct {
+	method  string
+	stream  bool
+	address string
+	auth    connAuthInfo
+}
+
+func newGRPCCall(ctx context.Context, method string, stream bool) *grpcCall {
+	call := &grpcCall{method: method, stream: stream}
+	if p, ok := peer.FromContext(ctx); ok {
+		if p.Addr != nil {
+			call.address = p.Addr.String()
+		}
+		call.auth, _ = p.AuthInfo.(connAuthInfo)
+	}
+	return call
+}
+
+// peerCertificate returns the certificate of client, or nil if the client
+// is not verified by TLS.
+func (call *grpcCall) peerCertificate() *tls.ConnectionState {
+	if call.auth.tls != nil && len(call.auth.tls.PeerCertificates) > 0 {
+		return call.auth.tls
+	}
+	return nil
+}
+
+// identity returns the identity of client for admission control, the same
+// as clientIdentity of REST API.
+func (call *grpcCall) identity() string {
+	if state := call.peerCertificate(); state != nil {
+		return "cn:" + state.PeerCertificates[0].Subject.CommonName
+	}
+	if call.auth.peer != nil {
+		return fmt.Sprintf("uid:%d", call.auth.peer.UID)
+	}
+	if host, _, err := net.SplitHostPort(call.address); err == nil {
+		return "ip:" + host
+	}
+	return "ip:" + call.address
+}
+
+// class returns the class of admission control.
+func (call *grpcCall) class() admission.Class {
+	if call.stream {
+		return admission.ClassStreaming
+	}
+	if grpcReadMethods[call.method] {
+		return admission.ClassRead
+	}
+	return admission.ClassMutating
+}
+
+// httpMethod returns the http method which the call is equivalent to, it
+// decides whether the call is audited.
+func (call *grpcCall) httpMethod() string {
+	if grpcReadMethods[call.method] {
+		return http.MethodGet
+	}
+	return http.MethodPost
+}
+
+func (s *Server) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	call := newGRPCCall(ctx, info.FullMethod, false)
+	err = s.handleGRPC(ctx, call, func(ctx context.Context, record *audit.Record) error {
+		if record != nil {
+			addGRPCAuditTargets(record, req)
+		}
+		resp, err = handler(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+func (s *Server) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	call := newGRPCCall(ss.Context(), info.FullMethod, true)
+	return s.handleGRPC(ss.Context(), call, func(ctx context.Context, record *audit.Record) error {
+		return handler(srv, &grpcServerStream{ServerStream: ss, ctx: ctx, record: record})
+	})
+}
+
+// grpcServerStream replaces the context of stream with the one of request,
+// and records the request in audit log. Only the first request is recorded,
+// the following requests of client stream are the input frames.
+type grpcServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	record   *audit.Record
+	received bool
+}
+
+func (ss *grpcServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+func (ss *grpcServerStream) RecvMsg(m interface{}) error {
+	if err := ss.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if ss.record != nil && !ss.received {
+		addGRPCAuditTargets(ss.record, m)
+	}
+	ss.received = true
+	return nil
+}
+
+// handleGRPC does the same as filter of REST API: it checks the manager
+// whitelist, records the request in audit log, admits the request and logs
+// it, then converts the error of handler into gRPC status.
+func (s *Server) handleGRPC(ctx context.Context, call *grpcCall, handler func(context.Context, *audit.Record) error) (err error) {
+	requestID := randomid.Generate()[:10]
+	ctx = log.NewContext(ctx, map[string]interface{}{
+		"RequestID": requestID,
+	})
+
+	ctx, span := trace.StartSpan(trace.ExtractGRPC(ctx), call.method,
+		trace.WithKind(trace.SpanKindServer),
+		trace.WithAttribute("rpc.system", "grpc"),
+		trace.WithAttribute("pouch.request_id", requestID),
+	)
+	if span != nil {
+		ctx = log.AddFields(ctx, map[string]interface{}{"TraceID": span.SpanContext().TraceID.String()})
+	}
+	defer func() {
+		span.Finish(err)
+	}()
+
+	for _, service := range grpcServicesToWait {
+		if strings.HasPrefix(call.method, service) {
+			atomic.AddInt32(&s.FlyingReq, 1)
+			defer atomic.AddInt32(&s.FlyingReq, -1)
+			break
+		}
+	}
+
+	start := time.Now()
+	defer func() {
+		metrics.GRPCRequestsCounter.WithLabelValues(call.method, status.Code(err).String()).Inc()
+		metrics.GRPCRequestsTimer.WithLabelValues(call.method).Observe(time.Since(start).Seconds())
+	}()
+
+	var record *audit.Record
+	if s.auditLogger != nil && s.auditLogger.ShouldAudit(call.httpMethod()) {
+		record = s.newGRPCAuditRecord(call, requestID)
+		ctx = audit.WithRecord(ctx, record)
+		defer func() {
+			record.Finish(grpcHTTPStatus(err), err)
+			if logErr := s.auditLogger.Log(record); logErr != nil {
+				log.With(ctx).Errorf("failed to write audit log: %v", logErr)
+			}
+		}()
+	}
+
+	clientInfo := call.address
+	if state := call.peerCertificate(); state != nil {
+		s.lock.RLock()
+		_, isManager := s.ManagerWhiteList[state.PeerCertificates[0].Subject.CommonName]
+		denied := len(s.ManagerWhiteList) > 0 && !isManager
+		s.lock.RUnlock()
+		if denied {
+			return status.Error(codes.PermissionDenied, "tls verified error")
+		}
+
+		issuer := state.PeerCertificates[0].Issuer.CommonName
+		clientName := state.PeerCertificates[0].Subject.CommonName
+		ctx = utils.SetTLSIssuer(ctx, issuer)
+		ctx = utils.SetTLSCommonName(ctx, clientName)
+		clientInfo = fmt.Sprintf("%s %s %s", clientInfo, issuer, clientName)
+	}
+
+	logf := log.With(ctx).Infof
+	if grpcReadMethods[call.method] {
+		logf = log.With(ctx).Debugf
+	}
+	logf("Calling gRPC %s, client %s", call.method, clientInfo)
+	defer func() {
+		logf("End of Calling gRPC %s, costs %d ms. client %s", call.method, time.Since(start)/time.Millisecond, clientInfo)
+	}()
+
+	if s.admission != nil && !grpcUnlimitedMethods[call.method] {
+		class := call.class()
+		release, err := s.admission.Acquire(ctx, class, call.identity())
+		if err != nil {
+			if err != admission.ErrRejected {
+				return grpcError(err)
+			}
+			metrics.APIRejectedRequestsCounter.WithLabelValues(string(class)).Inc()
+			return status.Errorf(codes.ResourceExhausted, "too many %s requests, please retry later", class)
+		}
+		defer release()
+
+		metrics.APIQueueWaitTimer.WithLabelValues(string(class)).Observe(time.Since(start).Seconds())
+	}
+
+	if err := handler(ctx, record); err != nil {
+		log.With(ctx).Errorf("Handler for gRPC %s, client %s returns error: %s", call.method, clientInfo, err)
+		return grpcError(err)
+	}
+	return nil
+}
+
+// newGRPCAuditRecord creates the audit record of gRPC request, the targets
+// and body are added when the request message is received.
+func (s *Server) newGRPCAuditRecord(call *grpcCall, requestID string) *audit.Record {
+	record := &audit.Record{
+		Time:      time.Now(),
+		RequestID: requestID,
+		Method:    "GRPC",
+		Path:      call.method,
+		Client: audit.Client{
+			Address: call.address,
+			Peer:    call.auth.peer,
+		},
+	}
+
+	if state := call.peerCertificate(); state != nil {
+		record.Client.TLSSubject = state.PeerCertificates[0].Subject.CommonName
+		record.Client.TLSIssuer = state.PeerCertificates[0].Issuer.CommonName
+	}
+	return record
+}
+
+// grpcAuditBody returns whether the request message carries a config which
+// is recorded as body.
+func grpcAuditBody(req interface{}) bool {
+	switch req.(type) {
+	case *v1.CreateContainerRequest, *v1.UpdateContainerRequest, *v1.CreateExecRequest, *v1.ExecRequest,
+		*v1.CreateVolumeRequest, *v1.CreateNetworkRequest, *v1.ConnectNetworkRequest:
+		return true
+	}
+	return false
+}
+
+// addGRPCAuditTargets records the objects and the JSON of request message
+// which carries a config, the JSON is redacted as the body of REST API.
+func addGRPCAuditTargets(record *audit.Record, req interface{}) {
+	if r, ok := req.(interface{ GetName() string }); ok {
+		record.AddTarget("name", r.GetName())
+	}
+	if r, ok := req.(interface{ GetId() string }); ok {
+		record.AddTarget("id", r.GetId())
+	}
+	if r, ok := req.(interface{ GetRef() string }); ok {
+		record.AddTarget("ref", r.GetRef())
+	}
+	if r, ok := req.(interface{ GetNetwork() string }); ok {
+		record.AddTarget("network", r.GetNetwork())
+	}
+	if r, ok := req.(interface{ GetContainer() string }); ok {
+		record.AddTarget("container", r.GetContainer())
+	}
+	if grpcAuditBody(req) {
+		if data, err := json.Marshal(req); err != nil {
+			log.With(nil).Warnf("failed to marshal gRPC request for audit log: %v", err)
+		} else if len(data) > maxAuditBodySize {
+			record.BodyTruncated = true
+		} else {
+			record.Body = audit.Redact(data)
+		}
+	}
+}
+
+// grpcError converts the error of handler into gRPC status, the status code
+// is decided by the same rules as the http status code of REST API.
+func grpcError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch errors.Cause(err) {
+	case context.Canceled:
+		return status.Error(codes.Canceled, err.Error())
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	if _, ok := err.(httputils.HTTPError); !ok && errtypes.IsAlreadyExisted(err) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	code := codes.Unknown
+	switch errorStatusCode(err) {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusUnauthorized, http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict, http.StatusNotModified:
+		code = codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		code = codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		code = codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		code = codes.Unavailable
+	}
+	return status.Error(code, err.Error())
+}
+
+// grpcHTTPStatus returns the http status code which the result of gRPC
+// request is equivalent to, it's recorded in audit log.
+func grpcHTTPStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// grpcStreamWriter sends each write as a message of stream, it's used by
+// the managers which write the JSON messages to io.Writer one by one.
+type grpcStreamWriter func([]byte) error
+
+func (send grpcStreamWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	// the buffer may be reused by the caller after write.
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	if err := send(data); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
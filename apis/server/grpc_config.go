@@ -0,0 +1,300 @@
+package server
+
+import (
+	"github.com/alibaba/pouch/apis/grpc/v1"
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// The typed configs of gRPC requests are converted into the types of REST
+// API, so that they are validated and handled by the same code.
+
+func int64Value(v *v1.Int64Value) *int64 {
+	if v == nil {
+		return nil
+	}
+	value := v.Value
+	return &value
+}
+
+func boolValue(v *v1.BoolValue) *bool {
+	if v == nil {
+		return nil
+	}
+	value := v.Value
+	return &value
+}
+
+// emptyObjects converts the list into the map whose values are the empty
+// JSON object, such as ExposedPorts and Volumes of ContainerConfig.
+func emptyObjects(keys []string) map[string]interface{} {
+	if len(keys) == 0 {
+		return nil
+	}
+	m := make(map[string]interface{}, len(keys))
+	for _, k := range keys {
+		m[k] = map[string]interface{}{}
+	}
+	return m
+}
+
+func containerConfigFromGRPC(c *v1.ContainerConfig) types.ContainerConfig {
+	if c == nil {
+		return types.ContainerConfig{}
+	}
+	return types.ContainerConfig{
+		Image:           c.Image,
+		Cmd:             c.Cmd,
+		Entrypoint:      c.Entrypoint,
+		Env:             c.Env,
+		WorkingDir:      c.WorkingDir,
+		User:            c.User,
+		Hostname:        strfmt.Hostname(c.Hostname),
+		Domainname:      c.Domainname,
+		Labels:          c.Labels,
+		Tty:             c.Tty,
+		OpenStdin:       c.OpenStdin,
+		StdinOnce:       c.StdinOnce,
+		AttachStdin:     c.AttachStdin,
+		AttachStdout:    c.AttachStdout,
+		AttachStderr:    c.AttachStderr,
+		ExposedPorts:    emptyObjects(c.ExposedPorts),
+		Volumes:         emptyObjects(c.Volumes),
+		StopSignal:      c.StopSignal,
+		StopTimeout:     int64Value(c.StopTimeout),
+		Shell:           c.Shell,
+		NetworkDisabled: c.NetworkDisabled,
+		MacAddress:      c.MacAddress,
+		DiskQuota:       c.DiskQuota,
+		QuotaID:         c.QuotaId,
+		SpecAnnotation:  c.SpecAnnotation,
+		Snapshotter:     c.Snapshotter,
+	}
+}
+
+func restartPolicyFromGRPC(p *v1.RestartPolicy) *types.RestartPolicy {
+	if p == nil {
+		return nil
+	}
+	return &types.RestartPolicy{
+		Name:              p.Name,
+		MaximumRetryCount: p.MaximumRetryCount,
+	}
+}
+
+func resourcesFromGRPC(r *v1.Resources) types.Resources {
+	if r == nil {
+		return types.Resources{}
+	}
+
+	resources := types.Resources{
+		CgroupParent:      r.CgroupParent,
+		CPUShares:         r.CpuShares,
+		CPUPeriod:         r.CpuPeriod,
+		CPUQuota:          r.CpuQuota,
+		CpusetCpus:        r.CpusetCpus,
+		CpusetMems:        r.CpusetMems,
+		NanoCpus:          r.NanoCpus,
+		Memory:            r.Memory,
+		MemoryReservation: r.MemoryReservation,
+		MemorySwap:        r.MemorySwap,
+		MemorySwappiness:  int64Value(r.MemorySwappiness),
+		KernelMemory:      r.KernelMemory,
+		OomKillDisable:    boolValue(r.OomKillDisable),
+		PidsLimit:         r.PidsLimit,
+		BlkioWeight:       uint16(r.BlkioWeight),
+	}
+	for _, d := range r.Devices {
+		resources.Devices = append(resources.Devices, &types.DeviceMapping{
+			PathOnHost:        d.PathOnHost,
+			PathInContainer:   d.PathInContainer,
+			CgroupPermissions: d.CgroupPermissions,
+		})
+	}
+	for _, u := range r.Ulimits {
+		resources.Ulimits = append(resources.Ulimits, &types.Ulimit{
+			Name: u.Name,
+			Soft: u.Soft,
+			Hard: u.Hard,
+		})
+	}
+	return resources
+}
+
+func hostConfigFromGRPC(c *v1.HostConfig) *types.HostConfig {
+	if c == nil {
+		return nil
+	}
+
+	config := &types.HostConfig{
+		Binds:           c.Binds,
+		AutoRemove:      c.AutoRemove,
+		RestartPolicy:   restartPolicyFromGRPC(c.RestartPolicy),
+		NetworkMode:     c.NetworkMode,
+		PublishAllPorts: c.PublishAllPorts,
+		Links:           c.Links,
+		DNS:             c.Dns,
+		DNSOptions:      c.DnsOptions,
+		DNSSearch:       c.DnsSearch,
+		ExtraHosts:      c.ExtraHosts,
+		CapAdd:          c.CapAdd,
+		CapDrop:         c.CapDrop,
+		GroupAdd:        c.GroupAdd,
+		SecurityOpt:     c.SecurityOpt,
+		Privileged:      c.Privileged,
+		ReadonlyRootfs:  c.ReadonlyRootfs,
+		IpcMode:         c.IpcMode,
+		PidMode:         c.PidMode,
+		UTSMode:         c.UtsMode,
+		UsernsMode:      c.UsernsMode,
+		Runtime:         c.Runtime,
+		ShmSize:         int64Value(c.ShmSize),
+		Sysctls:         c.Sysctls,
+		Tmpfs:           c.Tmpfs,
+		VolumesFrom:     c.VolumesFrom,
+		VolumeDriver:    c.VolumeDriver,
+		OomScoreAdj:     c.OomScoreAdj,
+		EnableLxcfs:     c.EnableLxcfs,
+		Resources:       resourcesFromGRPC(c.Resources),
+	}
+	if c.LogConfig != nil {
+		config.LogConfig = &types.LogConfig{
+			LogDriver: c.LogConfig.Type,
+			LogOpts:   c.LogConfig.Config,
+		}
+	}
+	if len(c.PortBindings) > 0 {
+		config.PortBindings = make(types.PortMap, len(c.PortBindings))
+		for port, bindings := range c.PortBindings {
+			list := []types.PortBinding{}
+			for _, b := range bindings.GetBindings() {
+				list = append(list, types.PortBinding{HostIP: b.HostIp, HostPort: b.HostPort})
+			}
+			config.PortBindings[port] = list
+		}
+	}
+	return config
+}
+
+func endpointSettingsFromGRPC(e *v1.EndpointSettings) *types.EndpointSettings {
+	if e == nil {
+		return nil
+	}
+
+	settings := &types.EndpointSettings{
+		Aliases:    e.Aliases,
+		Links:      e.Links,
+		MacAddress: e.MacAddress,
+		DriverOpts: e.DriverOpts,
+	}
+	if e.IpamConfig != nil {
+		settings.IPAMConfig = &types.EndpointIPAMConfig{
+			IPV4Address:  e.IpamConfig.Ipv4Address,
+			IPV6Address:  e.IpamConfig.Ipv6Address,
+			LinkLocalIps: e.IpamConfig.LinkLocalIps,
+		}
+	}
+	return settings
+}
+
+func networkingConfigFromGRPC(c *v1.NetworkingConfig) *types.NetworkingConfig {
+	if c == nil {
+		return nil
+	}
+
+	config := &types.NetworkingConfig{}
+	if len(c.EndpointsConfig) > 0 {
+		config.EndpointsConfig = make(map[string]*types.EndpointSettings, len(c.EndpointsConfig))
+		for name, e := range c.EndpointsConfig {
+			config.EndpointsConfig[name] = endpointSettingsFromGRPC(e)
+		}
+	}
+	return config
+}
+
+func containerCreateConfigFromGRPC(req *v1.CreateContainerRequest) *types.ContainerCreateConfig {
+	return &types.ContainerCreateConfig{
+		ContainerConfig:  containerConfigFromGRPC(req.Config),
+		HostConfig:       hostConfigFromGRPC(req.HostConfig),
+		NetworkingConfig: networkingConfigFromGRPC(req.NetworkingConfig),
+	}
+}
+
+func updateConfigFromGRPC(c *v1.UpdateConfig) *types.UpdateConfig {
+	if c == nil {
+		return &types.UpdateConfig{}
+	}
+	return &types.UpdateConfig{
+		Resources:      resourcesFromGRPC(c.Resources),
+		Env:            c.Env,
+		Label:          c.Labels,
+		RestartPolicy:  restartPolicyFromGRPC(c.RestartPolicy),
+		DiskQuota:      c.DiskQuota,
+		SpecAnnotation: c.SpecAnnotation,
+	}
+}
+
+func execCreateConfigFromGRPC(c *v1.ExecConfig) *types.ExecCreateConfig {
+	if c == nil {
+		return &types.ExecCreateConfig{}
+	}
+	return &types.ExecCreateConfig{
+		Cmd:          c.Cmd,
+		Env:          c.Env,
+		User:         c.User,
+		WorkingDir:   c.WorkingDir,
+		Privileged:   c.Privileged,
+		Tty:          c.Tty,
+		AttachStdin:  c.AttachStdin,
+		AttachStdout: c.AttachStdout,
+		AttachStderr: c.AttachStderr,
+		DetachKeys:   c.DetachKeys,
+	}
+}
+
+func volumeCreateConfigFromGRPC(c *v1.VolumeConfig) *types.VolumeCreateConfig {
+	if c == nil {
+		return &types.VolumeCreateConfig{}
+	}
+	return &types.VolumeCreateConfig{
+		Name:       c.Name,
+		Driver:     c.Driver,
+		DriverOpts: c.DriverOpts,
+		Labels:     c.Labels,
+	}
+}
+
+func networkCreateConfigFromGRPC(c *v1.NetworkConfig) *types.NetworkCreateConfig {
+	if c == nil {
+		return &types.NetworkCreateConfig{}
+	}
+
+	config := &types.NetworkCreateConfig{
+		Name: c.Name,
+		NetworkCreate: types.NetworkCreate{
+			Driver:         c.Driver,
+			CheckDuplicate: c.CheckDuplicate,
+			EnableIPV6:     c.EnableIpv6,
+			Internal:       c.Internal,
+			Labels:         c.Labels,
+			Options:        c.Options,
+		},
+	}
+	if c.Ipam != nil {
+		ipam := &types.IPAM{
+			Driver:  c.Ipam.Driver,
+			Options: c.Ipam.Options,
+		}
+		for _, pool := range c.Ipam.Config {
+			ipam.Config = append(ipam.Config, types.IPAMConfig{
+				Subnet:     pool.Subnet,
+				IPRange:    pool.IpRange,
+				Gateway:    pool.Gateway,
+				AuxAddress: pool.AuxAddress,
+			})
+		}
+		config.IPAM = ipam
+	}
+	return config
+}
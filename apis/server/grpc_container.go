@@ -0,0 +1,464 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/alibaba/pouch/apis/grpc/v1"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/streams"
+	"github.com/alibaba/pouch/pkg/utils/filters"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// grpcContainerService implements v1.ContainerServiceServer on ContainerMgr.
+type grpcContainerService struct {
+	*Server
+}
+
+func (s *grpcContainerService) Create(ctx context.Context, req *v1.CreateContainerRequest) (*v1.CreateContainerResponse, error) {
+	config := containerCreateConfigFromGRPC(req)
+
+	logCreateOptions(ctx, "container", config)
+
+	if err := validateContainerCreateConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	container, err := s.ContainerMgr.Create(ctx, req.Name, config)
+	if err != nil {
+		return nil, err
+	}
+	audit.AddTarget(ctx, "container_id", container.ID)
+
+	return &v1.CreateContainerResponse{
+		Id:       container.ID,
+		Name:     container.Name,
+		Warnings: container.Warnings,
+	}, nil
+}
+
+func (s *grpcContainerService) Start(ctx context.Context, req *v1.StartContainerRequest) (*v1.StartContainerResponse, error) {
+	options := &types.ContainerStartOptions{
+		DetachKeys: req.DetachKeys,
+	}
+	if err := s.ContainerMgr.Start(ctx, req.Name, options); err != nil {
+		return nil, err
+	}
+	return &v1.StartContainerResponse{}, nil
+}
+
+func (s *grpcContainerService) Stop(ctx context.Context, req *v1.StopContainerRequest) (*v1.StopContainerResponse, error) {
+	if err := s.ContainerMgr.Stop(ctx, req.Name, req.Timeout); err != nil {
+		return nil, err
+	}
+	return &v1.StopContainerResponse{}, nil
+}
+
+func (s *grpcContainerService) Restart(ctx context.Context, req *v1.RestartContainerRequest) (*v1.RestartContainerResponse, error) {
+	if err := s.ContainerMgr.Restart(ctx, req.Name, req.Timeout); err != nil {
+		return nil, err
+	}
+	return &v1.RestartContainerResponse{}, nil
+}
+
+func (s *grpcContainerService) Pause(ctx context.Context, req *v1.PauseContainerRequest) (*v1.PauseContainerResponse, error) {
+	if err := s.ContainerMgr.Pause(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	return &v1.PauseContainerResponse{}, nil
+}
+
+func (s *grpcContainerService) Unpause(ctx context.Context, req *v1.UnpauseContainerRequest) (*v1.UnpauseContainerResponse, error) {
+	if err := s.ContainerMgr.Unpause(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	return &v1.UnpauseContainerResponse{}, nil
+}
+
+func (s *grpcContainerService) Update(ctx context.Context, req *v1.UpdateContainerRequest) (*v1.UpdateContainerResponse, error) {
+	config := updateConfigFromGRPC(req.Config)
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return nil, httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	if err := s.ContainerMgr.Update(ctx, req.Name, config); err != nil {
+		return nil, err
+	}
+	return &v1.UpdateContainerResponse{}, nil
+}
+
+func (s *grpcContainerService) Remove(ctx context.Context, req *v1.RemoveContainerRequest) (*v1.RemoveContainerResponse, error) {
+	option := &types.ContainerRemoveOptions{
+		Force:   req.Force,
+		Volumes: req.Volumes,
+	}
+	if err := s.ContainerMgr.Remove(ctx, req.Name, option); err != nil {
+		return nil, err
+	}
+	return &v1.RemoveContainerResponse{}, nil
+}
+
+func (s *grpcContainerService) List(ctx context.Context, req *v1.ListContainersRequest) (*v1.ListContainersResponse, error) {
+	filter, err := filters.FromURLParam(req.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	cons, err := s.ContainerMgr.List(ctx, &mgr.ContainerListOption{
+		All:    req.All,
+		Filter: filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ListContainersResponse{}
+	for _, c := range cons {
+		container, err := buildContainer(c)
+		if err != nil {
+			return nil, err
+		}
+
+		summary := &v1.ContainerSummary{
+			Id:      container.ID,
+			Names:   container.Names,
+			Image:   container.Image,
+			ImageId: container.ImageID,
+			Command: container.Command,
+			Created: container.Created,
+			Status:  container.Status,
+			Labels:  container.Labels,
+		}
+		if c.State != nil {
+			summary.State = string(c.State.Status)
+		}
+		resp.Containers = append(resp.Containers, summary)
+	}
+	return resp, nil
+}
+
+func (s *grpcContainerService) Inspect(ctx context.Context, req *v1.InspectContainerRequest) (*v1.InspectContainerResponse, error) {
+	c, err := s.ContainerMgr.Get(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	container := buildContainerJSON(c)
+	return &v1.InspectContainerResponse{Container: containerToGRPC(&container)}, nil
+}
+
+func (s *grpcContainerService) Logs(req *v1.ContainerLogsRequest, stream v1.ContainerService_LogsServer) error {
+	ctx := stream.Context()
+	opts := &types.ContainerLogsOptions{
+		ShowStdout: req.Stdout,
+		ShowStderr: req.Stderr,
+		Tail:       req.Tail,
+		Since:      req.Since,
+		Until:      req.Until,
+		Follow:     req.Follow,
+		Details:    req.Details,
+	}
+
+	msgCh, _, err := s.ContainerMgr.Logs(ctx, req.Name, opts)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-msgCh:
+			if !ok {
+				return nil
+			}
+			if msg.Err != nil {
+				return msg.Err
+			}
+
+			if (msg.Source == "stdout" && !opts.ShowStdout) || (msg.Source == "stderr" && !opts.ShowStderr) {
+				continue
+			}
+
+			logMsg := &v1.LogMessage{
+				Source:    msg.Source,
+				Line:      msg.Line,
+				Timestamp: msg.Timestamp.UnixNano(),
+			}
+			if opts.Details {
+				logMsg.Attrs = msg.Attrs
+			}
+			if err := stream.Send(logMsg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *grpcContainerService) Stats(req *v1.ContainerStatsRequest, stream v1.ContainerService_StatsServer) error {
+	config := &mgr.ContainerStatsConfig{
+		Stream: req.Stream,
+		OutStream: grpcStreamWriter(func(data []byte) error {
+			var stats types.ContainerStats
+			if err := json.Unmarshal(data, &stats); err != nil {
+				return err
+			}
+			return stream.Send(containerStatsToGRPC(&stats))
+		}),
+	}
+
+	return s.ContainerMgr.StreamStats(stream.Context(), req.Name, config)
+}
+
+func (s *grpcContainerService) Attach(stream v1.ContainerService_AttachServer) error {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	c, err := s.ContainerMgr.Get(ctx, req.Name)
+	if err != nil {
+		return err
+	}
+
+	stdin := newGRPCStdin(req.Stdin && c.Config.OpenStdin, func(opts types.ResizeOptions) error {
+		return s.ContainerMgr.Resize(ctx, c.ID, opts)
+	})
+	defer stdin.Close()
+
+	go stdin.receive(req.Input, func() (*v1.StreamInput, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Input, nil
+	})
+
+	output := grpcOutputWriters(new(sync.Mutex), func(out *v1.StreamOutput) error {
+		return stream.Send(&v1.AttachResponse{Output: out})
+	})
+	attach := &streams.AttachConfig{
+		UseStdin:  req.Stdin,
+		Stdin:     stdin,
+		UseStdout: true,
+		Stdout:    output("stdout"),
+		UseStderr: true,
+		Stderr:    output("stderr"),
+	}
+	return s.ContainerMgr.AttachContainerIO(ctx, c.ID, attach)
+}
+
+func (s *grpcContainerService) CreateExec(ctx context.Context, req *v1.CreateExecRequest) (*v1.CreateExecResponse, error) {
+	config := execCreateConfigFromGRPC(req.Config)
+
+	logCreateOptions(ctx, "container exec for "+req.Name, config)
+
+	if err := validateExecCreateConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	id, err := s.ContainerMgr.CreateExec(ctx, req.Name, config)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateExecResponse{Id: id}, nil
+}
+
+func (s *grpcContainerService) StartExec(req *v1.StartExecRequest, stream v1.ContainerService_StartExecServer) error {
+	ctx := stream.Context()
+
+	execInfo, err := s.ContainerMgr.InspectExec(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+
+	attach := &streams.AttachConfig{Detach: req.Detach}
+	if !req.Detach {
+		output := grpcOutputWriters(new(sync.Mutex), stream.Send)
+
+		attach.Terminal = execInfo.ProcessConfig.Tty
+		attach.UseStdout, attach.Stdout = true, output("stdout")
+		if !attach.Terminal {
+			attach.UseStderr, attach.Stderr = true, output("stderr")
+		}
+	}
+
+	return s.ContainerMgr.StartExec(ctx, req.Id, attach, 0)
+}
+
+func (s *grpcContainerService) Exec(stream v1.ContainerService_ExecServer) error {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	id := req.Id
+	if id == "" {
+		config := execCreateConfigFromGRPC(req.Config)
+
+		logCreateOptions(ctx, "container exec for "+req.Container, config)
+
+		if err := validateExecCreateConfig(ctx, config); err != nil {
+			return err
+		}
+
+		if id, err = s.ContainerMgr.CreateExec(ctx, req.Container, config); err != nil {
+			return err
+		}
+	}
+
+	execInfo, err := s.ContainerMgr.InspectExec(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// the output is sent after the id, and the exit after the output.
+	lock := new(sync.Mutex)
+	send := func(resp *v1.ExecResponse) error {
+		lock.Lock()
+		defer lock.Unlock()
+		return stream.Send(resp)
+	}
+	if err := send(&v1.ExecResponse{Id: id}); err != nil {
+		return err
+	}
+
+	stdin := newGRPCStdin(execInfo.OpenStdin, func(opts types.ResizeOptions) error {
+		return s.ContainerMgr.ResizeExec(ctx, id, opts)
+	})
+	defer stdin.Close()
+
+	go stdin.receive(req.Input, func() (*v1.StreamInput, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Input, nil
+	})
+
+	output := grpcOutputWriters(lock, func(out *v1.StreamOutput) error {
+		return stream.Send(&v1.ExecResponse{Output: out})
+	})
+	attach := &streams.AttachConfig{
+		Terminal:  execInfo.ProcessConfig.Tty,
+		UseStdin:  true,
+		Stdin:     stdin,
+		UseStdout: true,
+		Stdout:    output("stdout"),
+	}
+	if !attach.Terminal {
+		attach.UseStderr, attach.Stderr = true, output("stderr")
+	}
+
+	if err := s.ContainerMgr.StartExec(ctx, id, attach, 0); err != nil {
+		return err
+	}
+
+	if execInfo, err = s.ContainerMgr.InspectExec(ctx, id); err != nil {
+		return err
+	}
+	// detached by the detach keys, the exec process keeps running.
+	if execInfo.Running {
+		return nil
+	}
+	return send(&v1.ExecResponse{Exit: &v1.ExecExit{ExitCode: execInfo.ExitCode}})
+}
+
+func (s *grpcContainerService) InspectExec(ctx context.Context, req *v1.InspectExecRequest) (*v1.InspectExecResponse, error) {
+	execInfo, err := s.ContainerMgr.InspectExec(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.InspectExecResponse{Exec: execToGRPC(execInfo)}, nil
+}
+
+// grpcOutputWriters returns the writer of stdout or stderr which sends the
+// output by send, the writers are locked by lock since they are written
+// concurrently.
+func grpcOutputWriters(lock sync.Locker, send func(*v1.StreamOutput) error) func(source string) grpcStreamWriter {
+	return func(source string) grpcStreamWriter {
+		return func(data []byte) error {
+			lock.Lock()
+			defer lock.Unlock()
+			return send(&v1.StreamOutput{Source: source, Data: data})
+		}
+	}
+}
+
+// grpcStdin is the stdin of Attach and Exec, it's written by the input
+// frames of client stream.
+type grpcStdin struct {
+	*io.PipeReader
+	w *io.PipeWriter
+
+	// open is false if the process doesn't read stdin or stdin is closed,
+	// the stdin frames are dropped so that the resize frames are never
+	// blocked.
+	open     bool
+	resizeFn func(opts types.ResizeOptions) error
+}
+
+func newGRPCStdin(open bool, resizeFn func(opts types.ResizeOptions) error) *grpcStdin {
+	r, w := io.Pipe()
+	return &grpcStdin{
+		PipeReader: r,
+		w:          w,
+		open:       open,
+		resizeFn:   resizeFn,
+	}
+}
+
+// receive applies the input of first request and the inputs received by
+// recv until the client stream ends, then stdin is closed.
+func (in *grpcStdin) receive(input *v1.StreamInput, recv func() (*v1.StreamInput, error)) {
+	defer in.w.Close()
+
+	for {
+		if input != nil {
+			in.apply(input)
+		}
+
+		var err error
+		if input, err = recv(); err != nil {
+			return
+		}
+	}
+}
+
+func (in *grpcStdin) apply(input *v1.StreamInput) {
+	if in.open && len(input.Stdin) > 0 {
+		// the pipe is closed if the process exits.
+		if _, err := in.w.Write(input.Stdin); err != nil {
+			in.open = false
+		}
+	}
+
+	if input.Resize != nil {
+		opts := types.ResizeOptions{
+			Height: int64(input.Resize.Height),
+			Width:  int64(input.Resize.Width),
+		}
+		if err := in.resizeFn(opts); err != nil {
+			log.With(nil).Warnf("failed to resize tty by gRPC frame %+v: %v", opts, err)
+		}
+	}
+
+	if input.CloseStdin {
+		in.open = false
+		in.w.Close()
+	}
+}
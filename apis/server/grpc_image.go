@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/grpc/v1"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// grpcImageService implements v1.ImageServiceServer on ImageMgr.
+type grpcImageService struct {
+	*Server
+}
+
+// decodeGRPCAuth decodes the JSON encoded auth config in gRPC request.
+func decodeGRPCAuth(data []byte, config *types.AuthConfig) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, config); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+	return nil
+}
+
+func (s *grpcImageService) Pull(req *v1.PullImageRequest, stream v1.ImageService_PullServer) error {
+	ctx := stream.Context()
+
+	authConfig := &types.AuthConfig{}
+	if err := decodeGRPCAuth(req.Auth, authConfig); err != nil {
+		return err
+	}
+	if err := authConfig.Validate(strfmt.NewFormats()); err != nil {
+		return err
+	}
+
+	out := grpcStreamWriter(func(data []byte) error {
+		return stream.Send(&v1.PullImageProgress{Message: data})
+	})
+	if err := s.ImageMgr.PullImage(ctx, req.Ref, authConfig, out); err != nil {
+		log.With(ctx).Errorf("failed to pull image %s: %v", req.Ref, err)
+		return err
+	}
+	return nil
+}
+
+func (s *grpcImageService) List(ctx context.Context, req *v1.ListImagesRequest) (*v1.ListImagesResponse, error) {
+	filter, err := filters.FromParam(req.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := s.ImageMgr.ListImages(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ListImagesResponse{}
+	for _, image := range images {
+		resp.Images = append(resp.Images, &v1.ImageSummary{
+			Id:          image.ID,
+			RepoTags:    image.RepoTags,
+			RepoDigests: image.RepoDigests,
+			CreatedAt:   image.CreatedAt,
+			Size_:       image.Size,
+		})
+	}
+	return resp, nil
+}
+
+func (s *grpcImageService) Inspect(ctx context.Context, req *v1.InspectImageRequest) (*v1.InspectImageResponse, error) {
+	image, err := s.ImageMgr.GetImage(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.InspectImageResponse{Image: imageToGRPC(image)}, nil
+}
+
+func (s *grpcImageService) Remove(ctx context.Context, req *v1.RemoveImageRequest) (*v1.RemoveImageResponse, error) {
+	if err := s.removeImageIfUnused(ctx, req.Name, req.Force); err != nil {
+		return nil, err
+	}
+	return &v1.RemoveImageResponse{}, nil
+}
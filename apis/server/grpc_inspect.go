@@ -0,0 +1,499 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/alibaba/pouch/apis/grpc/v1"
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// The inspect results and stats of REST API are converted into the typed
+// messages of gRPC responses.
+
+func int64ValueToGRPC(v *int64) *v1.Int64Value {
+	if v == nil {
+		return nil
+	}
+	return &v1.Int64Value{Value: *v}
+}
+
+func boolValueToGRPC(v *bool) *v1.BoolValue {
+	if v == nil {
+		return nil
+	}
+	return &v1.BoolValue{Value: *v}
+}
+
+// objectKeys returns the sorted keys of the map whose values are the empty
+// JSON object, it's the reverse of emptyObjects.
+func objectKeys(m map[string]interface{}) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containerConfigToGRPC(c *types.ContainerConfig) *v1.ContainerConfig {
+	if c == nil {
+		return nil
+	}
+	return &v1.ContainerConfig{
+		Image:           c.Image,
+		Cmd:             c.Cmd,
+		Entrypoint:      c.Entrypoint,
+		Env:             c.Env,
+		WorkingDir:      c.WorkingDir,
+		User:            c.User,
+		Hostname:        string(c.Hostname),
+		Domainname:      c.Domainname,
+		Labels:          c.Labels,
+		Tty:             c.Tty,
+		OpenStdin:       c.OpenStdin,
+		StdinOnce:       c.StdinOnce,
+		AttachStdin:     c.AttachStdin,
+		AttachStdout:    c.AttachStdout,
+		AttachStderr:    c.AttachStderr,
+		ExposedPorts:    objectKeys(c.ExposedPorts),
+		Volumes:         objectKeys(c.Volumes),
+		StopSignal:      c.StopSignal,
+		StopTimeout:     int64ValueToGRPC(c.StopTimeout),
+		Shell:           c.Shell,
+		NetworkDisabled: c.NetworkDisabled,
+		MacAddress:      c.MacAddress,
+		DiskQuota:       c.DiskQuota,
+		QuotaId:         c.QuotaID,
+		SpecAnnotation:  c.SpecAnnotation,
+		Snapshotter:     c.Snapshotter,
+	}
+}
+
+func resourcesToGRPC(r *types.Resources) *v1.Resources {
+	resources := &v1.Resources{
+		CgroupParent:      r.CgroupParent,
+		CpuShares:         r.CPUShares,
+		CpuPeriod:         r.CPUPeriod,
+		CpuQuota:          r.CPUQuota,
+		CpusetCpus:        r.CpusetCpus,
+		CpusetMems:        r.CpusetMems,
+		NanoCpus:          r.NanoCpus,
+		Memory:            r.Memory,
+		MemoryReservation: r.MemoryReservation,
+		MemorySwap:        r.MemorySwap,
+		MemorySwappiness:  int64ValueToGRPC(r.MemorySwappiness),
+		KernelMemory:      r.KernelMemory,
+		OomKillDisable:    boolValueToGRPC(r.OomKillDisable),
+		PidsLimit:         r.PidsLimit,
+		BlkioWeight:       uint32(r.BlkioWeight),
+	}
+	for _, d := range r.Devices {
+		if d == nil {
+			continue
+		}
+		resources.Devices = append(resources.Devices, &v1.DeviceMapping{
+			PathOnHost:        d.PathOnHost,
+			PathInContainer:   d.PathInContainer,
+			CgroupPermissions: d.CgroupPermissions,
+		})
+	}
+	for _, u := range r.Ulimits {
+		if u == nil {
+			continue
+		}
+		resources.Ulimits = append(resources.Ulimits, &v1.Ulimit{
+			Name: u.Name,
+			Soft: u.Soft,
+			Hard: u.Hard,
+		})
+	}
+	return resources
+}
+
+func portBindingsToGRPC(ports types.PortMap) map[string]*v1.PortBindings {
+	if len(ports) == 0 {
+		return nil
+	}
+	m := make(map[string]*v1.PortBindings, len(ports))
+	for port, bindings := range ports {
+		list := &v1.PortBindings{}
+		for _, b := range bindings {
+			list.Bindings = append(list.Bindings, &v1.PortBinding{HostIp: b.HostIP, HostPort: b.HostPort})
+		}
+		m[port] = list
+	}
+	return m
+}
+
+func hostConfigToGRPC(c *types.HostConfig) *v1.HostConfig {
+	if c == nil {
+		return nil
+	}
+
+	config := &v1.HostConfig{
+		Binds:           c.Binds,
+		AutoRemove:      c.AutoRemove,
+		NetworkMode:     c.NetworkMode,
+		PortBindings:    portBindingsToGRPC(c.PortBindings),
+		PublishAllPorts: c.PublishAllPorts,
+		Links:           c.Links,
+		Dns:             c.DNS,
+		DnsOptions:      c.DNSOptions,
+		DnsSearch:       c.DNSSearch,
+		ExtraHosts:      c.ExtraHosts,
+		CapAdd:          c.CapAdd,
+		CapDrop:         c.CapDrop,
+		GroupAdd:        c.GroupAdd,
+		SecurityOpt:     c.SecurityOpt,
+		Privileged:      c.Privileged,
+		ReadonlyRootfs:  c.ReadonlyRootfs,
+		IpcMode:         c.IpcMode,
+		PidMode:         c.PidMode,
+		UtsMode:         c.UTSMode,
+		UsernsMode:      c.UsernsMode,
+		Runtime:         c.Runtime,
+		ShmSize:         int64ValueToGRPC(c.ShmSize),
+		Sysctls:         c.Sysctls,
+		Tmpfs:           c.Tmpfs,
+		VolumesFrom:     c.VolumesFrom,
+		VolumeDriver:    c.VolumeDriver,
+		OomScoreAdj:     c.OomScoreAdj,
+		EnableLxcfs:     c.EnableLxcfs,
+		Resources:       resourcesToGRPC(&c.Resources),
+	}
+	if c.RestartPolicy != nil {
+		config.RestartPolicy = &v1.RestartPolicy{
+			Name:              c.RestartPolicy.Name,
+			MaximumRetryCount: c.RestartPolicy.MaximumRetryCount,
+		}
+	}
+	if c.LogConfig != nil {
+		config.LogConfig = &v1.LogConfig{
+			Type:   c.LogConfig.LogDriver,
+			Config: c.LogConfig.LogOpts,
+		}
+	}
+	return config
+}
+
+func endpointSettingsToGRPC(e *types.EndpointSettings) *v1.EndpointSettings {
+	if e == nil {
+		return nil
+	}
+
+	settings := &v1.EndpointSettings{
+		Aliases:             e.Aliases,
+		Links:               e.Links,
+		MacAddress:          e.MacAddress,
+		DriverOpts:          e.DriverOpts,
+		NetworkId:           e.NetworkID,
+		EndpointId:          e.EndpointID,
+		Gateway:             e.Gateway,
+		IpAddress:           e.IPAddress,
+		IpPrefixLen:         e.IPPrefixLen,
+		Ipv6Gateway:         e.IPV6Gateway,
+		GlobalIpv6Address:   e.GlobalIPV6Address,
+		GlobalIpv6PrefixLen: e.GlobalIPV6PrefixLen,
+	}
+	if e.IPAMConfig != nil {
+		settings.IpamConfig = &v1.EndpointIPAMConfig{
+			Ipv4Address:  e.IPAMConfig.IPV4Address,
+			Ipv6Address:  e.IPAMConfig.IPV6Address,
+			LinkLocalIps: e.IPAMConfig.LinkLocalIps,
+		}
+	}
+	return settings
+}
+
+func ipAddressesToGRPC(addrs []*types.IPAddress) []*v1.IPAddress {
+	var list []*v1.IPAddress
+	for _, a := range addrs {
+		if a == nil {
+			continue
+		}
+		list = append(list, &v1.IPAddress{Addr: a.Addr, PrefixLen: a.PrefixLen})
+	}
+	return list
+}
+
+func networkSettingsToGRPC(s *types.NetworkSettings) *v1.NetworkSettings {
+	if s == nil {
+		return nil
+	}
+
+	settings := &v1.NetworkSettings{
+		SandboxId:              s.SandboxID,
+		SandboxKey:             s.SandboxKey,
+		Ports:                  portBindingsToGRPC(s.Ports),
+		Bridge:                 s.Bridge,
+		HairpinMode:            s.HairpinMode,
+		LinkLocalIpv6Address:   s.LinkLocalIPV6Address,
+		LinkLocalIpv6PrefixLen: s.LinkLocalIPV6PrefixLen,
+		SecondaryIpAddresses:   ipAddressesToGRPC(s.SecondaryIPAddresses),
+		SecondaryIpv6Addresses: ipAddressesToGRPC(s.SecondaryIPV6Addresses),
+	}
+	if len(s.Networks) > 0 {
+		settings.Networks = make(map[string]*v1.EndpointSettings, len(s.Networks))
+		for name, e := range s.Networks {
+			settings.Networks[name] = endpointSettingsToGRPC(e)
+		}
+	}
+	return settings
+}
+
+func containerStateToGRPC(s *types.ContainerState) *v1.ContainerState {
+	if s == nil {
+		return nil
+	}
+	return &v1.ContainerState{
+		Status:       string(s.Status),
+		Running:      s.Running,
+		Paused:       s.Paused,
+		Restarting:   s.Restarting,
+		OomKilled:    s.OOMKilled,
+		Dead:         s.Dead,
+		Pid:          s.Pid,
+		ExitCode:     s.ExitCode,
+		Error:        s.Error,
+		StartedAt:    s.StartedAt,
+		FinishedAt:   s.FinishedAt,
+		Exited:       s.Exited,
+		CrashLooping: s.CrashLooping,
+	}
+}
+
+func containerToGRPC(c *types.ContainerJSON) *v1.ContainerDetail {
+	container := &v1.ContainerDetail{
+		Id:              c.ID,
+		Name:            c.Name,
+		Image:           c.Image,
+		Created:         c.Created,
+		Path:            c.Path,
+		Args:            c.Args,
+		State:           containerStateToGRPC(c.State),
+		Config:          containerConfigToGRPC(c.Config),
+		HostConfig:      hostConfigToGRPC(c.HostConfig),
+		NetworkSettings: networkSettingsToGRPC(c.NetworkSettings),
+		RestartCount:    c.RestartCount,
+		Driver:          c.Driver,
+		ExecIds:         c.ExecIds,
+		LogPath:         c.LogPath,
+		ResolvConfPath:  c.ResolvConfPath,
+		HostnamePath:    c.HostnamePath,
+		HostsPath:       c.HostsPath,
+		HostRootPath:    c.HostRootPath,
+		MountLabel:      c.MountLabel,
+		ProcessLabel:    c.ProcessLabel,
+		AppArmorProfile: c.AppArmorProfile,
+		SizeRw:          int64ValueToGRPC(c.SizeRw),
+		SizeRootFs:      int64ValueToGRPC(c.SizeRootFs),
+	}
+	for _, m := range c.Mounts {
+		container.Mounts = append(container.Mounts, &v1.MountPoint{
+			Type:        m.Type,
+			Name:        m.Name,
+			Source:      m.Source,
+			Destination: m.Destination,
+			Driver:      m.Driver,
+			Mode:        m.Mode,
+			Rw:          m.RW,
+			Propagation: m.Propagation,
+		})
+	}
+	if c.GraphDriver != nil {
+		container.GraphDriver = &v1.StorageData{Name: c.GraphDriver.Name, Data: c.GraphDriver.Data}
+	}
+	if c.Snapshotter != nil {
+		container.Snapshotter = &v1.StorageData{Name: c.Snapshotter.Name, Data: c.Snapshotter.Data}
+	}
+	return container
+}
+
+func execToGRPC(e *types.ContainerExecInspect) *v1.ExecDetail {
+	exec := &v1.ExecDetail{
+		Id:          e.ID,
+		ContainerId: e.ContainerID,
+		Running:     e.Running,
+		Exited:      e.Exited,
+		ExitCode:    e.ExitCode,
+		OpenStdin:   e.OpenStdin,
+		OpenStdout:  e.OpenStdout,
+		OpenStderr:  e.OpenStderr,
+		CanRemove:   e.CanRemove,
+		DetachKeys:  e.DetachKeys,
+		StartedAt:   e.StartedAt,
+		FinishedAt:  e.FinishedAt,
+	}
+	if p := e.ProcessConfig; p != nil {
+		exec.ProcessConfig = &v1.ProcessConfig{
+			Entrypoint: p.Entrypoint,
+			Arguments:  p.Arguments,
+			Privileged: p.Privileged,
+			Tty:        p.Tty,
+			User:       p.User,
+			WorkingDir: p.WorkingDir,
+		}
+	}
+	return exec
+}
+
+func imageToGRPC(i *types.ImageInfo) *v1.ImageDetail {
+	image := &v1.ImageDetail{
+		Id:           i.ID,
+		RepoTags:     i.RepoTags,
+		RepoDigests:  i.RepoDigests,
+		CreatedAt:    i.CreatedAt,
+		Size_:        i.Size,
+		Architecture: i.Architecture,
+		Os:           i.Os,
+		Config:       containerConfigToGRPC(i.Config),
+	}
+	if i.RootFS != nil {
+		image.RootFs = &v1.ImageRootFS{
+			Type:      i.RootFS.Type,
+			Layers:    i.RootFS.Layers,
+			BaseLayer: i.RootFS.BaseLayer,
+		}
+	}
+	return image
+}
+
+func networkToGRPC(n *types.NetworkInspectResp) *v1.NetworkDetail {
+	network := &v1.NetworkDetail{
+		Id:         n.ID,
+		Name:       n.Name,
+		Driver:     n.Driver,
+		Scope:      n.Scope,
+		EnableIpv6: n.EnableIPV6,
+		Internal:   n.Internal,
+		Labels:     n.Labels,
+		Options:    n.Options,
+	}
+	if n.IPAM != nil {
+		ipam := &v1.IPAM{
+			Driver:  n.IPAM.Driver,
+			Options: n.IPAM.Options,
+		}
+		for _, pool := range n.IPAM.Config {
+			ipam.Config = append(ipam.Config, &v1.IPAMPool{
+				Subnet:     pool.Subnet,
+				IpRange:    pool.IPRange,
+				Gateway:    pool.Gateway,
+				AuxAddress: pool.AuxAddress,
+			})
+		}
+		network.Ipam = ipam
+	}
+	return network
+}
+
+func pressureToGRPC(p *types.PressureStats) *v1.PressureStats {
+	if p == nil {
+		return nil
+	}
+
+	data := func(d *types.PressureData) *v1.PressureData {
+		if d == nil {
+			return nil
+		}
+		return &v1.PressureData{Avg10: d.Avg10, Avg60: d.Avg60, Avg300: d.Avg300, Total: d.Total}
+	}
+	return &v1.PressureStats{Some: data(p.Some), Full: data(p.Full)}
+}
+
+func cpuStatsToGRPC(s *types.CPUStats) *v1.CPUStats {
+	if s == nil {
+		return nil
+	}
+
+	stats := &v1.CPUStats{
+		SystemCpuUsage: s.SyetemCPUUsage,
+		OnlineCpus:     s.OnlineCpus,
+		Pressure:       pressureToGRPC(s.Pressure),
+	}
+	if u := s.CPUUsage; u != nil {
+		stats.CpuUsage = &v1.CPUUsage{
+			TotalUsage:        u.TotalUsage,
+			PercpuUsage:       u.PercpuUsage,
+			UsageInKernelmode: u.UsageInKernelmode,
+			UsageInUsermode:   u.UsageInUsermode,
+		}
+	}
+	if t := s.ThrottlingData; t != nil {
+		stats.ThrottlingData = &v1.ThrottlingData{
+			Periods:          t.Periods,
+			ThrottledPeriods: t.ThrottledPeriods,
+			ThrottledTime:    t.ThrottledTime,
+		}
+	}
+	return stats
+}
+
+func blkioEntriesToGRPC(entries []*types.BlkioStatEntry) []*v1.BlkioStatEntry {
+	var list []*v1.BlkioStatEntry
+	for _, e := range entries {
+		if e == nil {
+			continue
+		}
+		list = append(list, &v1.BlkioStatEntry{Major: e.Major, Minor: e.Minor, Op: e.Op, Value: e.Value})
+	}
+	return list
+}
+
+func containerStatsToGRPC(s *types.ContainerStats) *v1.ContainerStats {
+	stats := &v1.ContainerStats{
+		Read:        s.Read.String(),
+		Id:          s.ID,
+		Name:        s.Name,
+		CpuStats:    cpuStatsToGRPC(s.CPUStats),
+		PrecpuStats: cpuStatsToGRPC(s.PrecpuStats),
+	}
+	if m := s.MemoryStats; m != nil {
+		stats.MemoryStats = &v1.MemoryStats{
+			Usage:    m.Usage,
+			MaxUsage: m.MaxUsage,
+			Limit:    m.Limit,
+			Failcnt:  m.Failcnt,
+			High:     m.High,
+			Stats:    m.Stats,
+			Pressure: pressureToGRPC(m.Pressure),
+		}
+	}
+	if b := s.BlkioStats; b != nil {
+		stats.BlkioStats = &v1.BlkioStats{
+			IoServiceBytesRecursive: blkioEntriesToGRPC(b.IoServiceBytesRecursive),
+			IoServicedRecursive:     blkioEntriesToGRPC(b.IoServicedRecursive),
+			IoQueueRecursive:        blkioEntriesToGRPC(b.IoQueueRecursive),
+			IoServiceTimeRecursive:  blkioEntriesToGRPC(b.IoServiceTimeRecursive),
+			IoWaitTimeRecursive:     blkioEntriesToGRPC(b.IoWaitTimeRecursive),
+			IoMergedRecursive:       blkioEntriesToGRPC(b.IoMergedRecursive),
+			IoTimeRecursive:         blkioEntriesToGRPC(b.IoTimeRecursive),
+			SectorsRecursive:        blkioEntriesToGRPC(b.SectorsRecursive),
+			Pressure:                pressureToGRPC(b.Pressure),
+		}
+	}
+	if len(s.Networks) > 0 {
+		stats.Networks = make(map[string]*v1.NetworkStats, len(s.Networks))
+		for name, n := range s.Networks {
+			stats.Networks[name] = &v1.NetworkStats{
+				RxBytes:    n.RxBytes,
+				RxPackets:  n.RxPackets,
+				RxErrors:   n.RxErrors,
+				RxDropped:  n.RxDropped,
+				TxBytes:    n.TxBytes,
+				TxPackets:  n.TxPackets,
+				TxErrors:   n.TxErrors,
+				TxDropped:  n.TxDropped,
+				EndpointId: n.EndpointID,
+				InstanceId: n.InstanceID,
+			}
+		}
+	}
+	if p := s.PidsStats; p != nil {
+		stats.PidsStats = &v1.PidsStats{Current: p.Current, Limit: p.Limit}
+	}
+	return stats
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/alibaba/pouch/apis/grpc/v1"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/httputils"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// grpcNetworkService implements v1.NetworkServiceServer on NetworkMgr.
+type grpcNetworkService struct {
+	*Server
+}
+
+func (s *grpcNetworkService) Create(ctx context.Context, req *v1.CreateNetworkRequest) (*v1.CreateNetworkResponse, error) {
+	config := networkCreateConfigFromGRPC(req.Config)
+
+	logCreateOptions(ctx, "network", config)
+
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return nil, httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	network, err := s.NetworkMgr.Create(ctx, *config)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateNetworkResponse{Id: network.ID}, nil
+}
+
+func (s *grpcNetworkService) List(ctx context.Context, req *v1.ListNetworksRequest) (*v1.ListNetworksResponse, error) {
+	networks, err := s.NetworkMgr.List(ctx, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ListNetworksResponse{}
+	for _, net := range networks {
+		r := buildNetworkResource(net)
+		resp.Networks = append(resp.Networks, &v1.NetworkSummary{
+			Id:     r.ID,
+			Name:   r.Name,
+			Driver: r.Driver,
+			Scope:  r.Scope,
+			Labels: r.Labels,
+		})
+	}
+	return resp, nil
+}
+
+func (s *grpcNetworkService) Inspect(ctx context.Context, req *v1.InspectNetworkRequest) (*v1.InspectNetworkResponse, error) {
+	network, err := s.NetworkMgr.Get(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.InspectNetworkResponse{Network: networkToGRPC(buildNetworkInspectResp(network))}, nil
+}
+
+func (s *grpcNetworkService) Remove(ctx context.Context, req *v1.RemoveNetworkRequest) (*v1.RemoveNetworkResponse, error) {
+	if err := s.NetworkMgr.Remove(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	return &v1.RemoveNetworkResponse{}, nil
+}
+
+func (s *grpcNetworkService) Connect(ctx context.Context, req *v1.ConnectNetworkRequest) (*v1.ConnectNetworkResponse, error) {
+	connectConfig := &types.NetworkConnect{
+		Container:      req.Container,
+		EndpointConfig: endpointSettingsFromGRPC(req.EndpointConfig),
+	}
+	if err := connectConfig.Validate(strfmt.NewFormats()); err != nil {
+		return nil, httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	if err := s.ContainerMgr.Connect(ctx, connectConfig.Container, req.Network, connectConfig.EndpointConfig); err != nil {
+		return nil, err
+	}
+	return &v1.ConnectNetworkResponse{}, nil
+}
+
+func (s *grpcNetworkService) Disconnect(ctx context.Context, req *v1.DisconnectNetworkRequest) (*v1.DisconnectNetworkResponse, error) {
+	if err := s.ContainerMgr.Disconnect(ctx, req.Container, req.Network, req.Force); err != nil {
+		return nil, err
+	}
+	return &v1.DisconnectNetworkResponse{}, nil
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/grpc/v1"
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// grpcSystemService implements v1.SystemServiceServer on SystemMgr.
+type grpcSystemService struct {
+	*Server
+}
+
+func (s *grpcSystemService) Version(ctx context.Context, req *v1.VersionRequest) (*v1.VersionResponse, error) {
+	version, err := s.SystemMgr.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.VersionResponse{
+		Version:       version.Version,
+		ApiVersion:    version.APIVersion,
+		MinApiVersion: version.MinAPIVersion,
+		GitCommit:     version.GitCommit,
+		GoVersion:     version.GoVersion,
+		Os:            version.Os,
+		Arch:          version.Arch,
+		KernelVersion: version.KernelVersion,
+		BuildTime:     version.BuildTime,
+	}, nil
+}
+
+func (s *grpcSystemService) Events(req *v1.EventsRequest, stream v1.SystemService_EventsServer) error {
+	return s.streamEvents(stream.Context(), req.Since, req.Until, req.Filters, func(ev *types.EventsMessage) error {
+		event := &v1.Event{
+			Type:     string(ev.Type),
+			Action:   ev.Action,
+			Id:       ev.ID,
+			TimeNano: ev.TimeNano,
+		}
+		if ev.Actor != nil {
+			if event.Id == "" {
+				event.Id = ev.Actor.ID
+			}
+			event.Attributes = ev.Actor.Attributes
+		}
+		return stream.Send(event)
+	})
+}
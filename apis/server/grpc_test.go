@@ -0,0 +1,448 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/grpc/v1"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/admission"
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/streams"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockGRPCSystemMgr struct {
+	mgr.SystemMgr
+	events []types.EventsMessage
+}
+
+func (m *mockGRPCSystemMgr) Version() (types.SystemVersion, error) {
+	return types.SystemVersion{Version: "1.0.0", APIVersion: "1.25"}, nil
+}
+
+func (m *mockGRPCSystemMgr) SubscribeToEvents(ctx context.Context, since, until time.Time, ef filters.Args) ([]types.EventsMessage, <-chan *types.EventsMessage, <-chan error) {
+	errq := make(chan error)
+	close(errq)
+	return m.events, nil, errq
+}
+
+type mockGRPCContainerMgr struct {
+	mgr.ContainerMgr
+	block   chan struct{}
+	created *types.ContainerCreateConfig
+	resized []types.ResizeOptions
+}
+
+func (m *mockGRPCContainerMgr) Create(ctx context.Context, name string, config *types.ContainerCreateConfig) (*types.ContainerCreateResp, error) {
+	m.created = config
+	return &types.ContainerCreateResp{ID: "c1", Name: name}, nil
+}
+
+func (m *mockGRPCContainerMgr) CreateExec(ctx context.Context, name string, config *types.ExecCreateConfig) (string, error) {
+	if name != "foo" {
+		return "", errors.Wrapf(errtypes.ErrNotfound, "container %s", name)
+	}
+	return "e1", nil
+}
+
+func (m *mockGRPCContainerMgr) InspectExec(ctx context.Context, execid string) (*types.ContainerExecInspect, error) {
+	return &types.ContainerExecInspect{
+		ID:            execid,
+		OpenStdin:     true,
+		ExitCode:      3,
+		ProcessConfig: &types.ProcessConfig{},
+	}, nil
+}
+
+// StartExec echoes stdin to stdout, and the size of tty to stderr.
+func (m *mockGRPCContainerMgr) StartExec(ctx context.Context, execid string, cfg *streams.AttachConfig, timeout int) error {
+	_, err := io.Copy(cfg.Stdout, cfg.Stdin)
+	for _, opts := range m.resized {
+		fmt.Fprintf(cfg.Stderr, "%dx%d", opts.Height, opts.Width)
+	}
+	return err
+}
+
+func (m *mockGRPCContainerMgr) ResizeExec(ctx context.Context, execid string, opts types.ResizeOptions) error {
+	m.resized = append(m.resized, opts)
+	return nil
+}
+
+func (m *mockGRPCContainerMgr) Get(ctx context.Context, name string) (*mgr.Container, error) {
+	return nil, errors.Wrapf(errtypes.ErrNotfound, "container %s", name)
+}
+
+// StreamStats writes the stats of two reads as REST API.
+func (m *mockGRPCContainerMgr) StreamStats(ctx context.Context, name string, config *mgr.ContainerStatsConfig) error {
+	enc := json.NewEncoder(config.OutStream)
+	for i := uint64(1); i <= 2; i++ {
+		if err := enc.Encode(&types.ContainerStats{
+			ID:          "c1",
+			Name:        name,
+			CPUStats:    &types.CPUStats{CPUUsage: &types.CPUUsage{TotalUsage: i * 100}},
+			MemoryStats: &types.MemoryStats{Usage: i, Stats: map[string]uint64{"cache": 1}},
+			Networks:    map[string]types.NetworkStats{"eth0": {RxBytes: i}},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockGRPCContainerMgr) Pause(ctx context.Context, name string) error {
+	<-m.block
+	return nil
+}
+
+// startGRPCServer serves gRPC API of s on a unix socket, and returns the
+// connection to it.
+func startGRPCServer(t *testing.T, s *Server) (*grpc.ClientConn, func()) {
+	dir, err := ioutil.TempDir("", "grpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "pouchd-grpc.sock")
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.grpcServer = s.newGRPCServer()
+	go s.grpcServer.Serve(l)
+
+	conn, err := grpc.Dial(path, grpc.WithInsecure(), grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+		return net.DialTimeout("unix", addr, timeout)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return conn, func() {
+		conn.Close()
+		s.grpcServer.Stop()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestGRPCError(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{errors.Wrap(errtypes.ErrNotfound, "container foo"), codes.NotFound},
+		{errors.Wrap(errtypes.ErrAlreadyExisted, "container foo"), codes.AlreadyExists},
+		{errors.Wrap(errtypes.ErrConflict, "container foo"), codes.FailedPrecondition},
+		{errors.Wrap(errtypes.ErrInUse, "volume foo"), codes.FailedPrecondition},
+		{errors.Wrap(errtypes.ErrInvalidParam, "memory"), codes.InvalidArgument},
+		{httputils.NewHTTPError(fmt.Errorf("bad json"), http.StatusBadRequest), codes.InvalidArgument},
+		{errors.Wrap(context.Canceled, "pull"), codes.Canceled},
+		{fmt.Errorf("unknown"), codes.Unknown},
+		{status.Error(codes.Unavailable, "closing"), codes.Unavailable},
+	} {
+		assert.Equal(t, tc.code, status.Code(grpcError(tc.err)), tc.err.Error())
+	}
+}
+
+func TestGRPCServer(t *testing.T) {
+	s := &Server{
+		Config: &config.Config{},
+		SystemMgr: &mockGRPCSystemMgr{events: []types.EventsMessage{
+			{Type: types.EventTypeContainer, Action: "start", Actor: &types.EventsActor{ID: "c1", Attributes: map[string]string{"name": "foo"}}, TimeNano: 1},
+			{Type: types.EventTypeContainer, Action: "stop", Actor: &types.EventsActor{ID: "c1"}, TimeNano: 2},
+		}},
+		ContainerMgr: &mockGRPCContainerMgr{},
+	}
+	conn, stop := startGRPCServer(t, s)
+	defer stop()
+	ctx := context.Background()
+
+	version, err := v1.NewSystemServiceClient(conn).Version(ctx, &v1.VersionRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", version.Version)
+	assert.Equal(t, "1.25", version.ApiVersion)
+
+	// errors of managers are converted into status.
+	containers := v1.NewContainerServiceClient(conn)
+	_, err = containers.Inspect(ctx, &v1.InspectContainerRequest{Name: "foo"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// the results of REST API are converted into the typed messages.
+	exec, err := containers.InspectExec(ctx, &v1.InspectExecRequest{Id: "e1"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "e1", exec.Exec.Id)
+		assert.True(t, exec.Exec.OpenStdin)
+		assert.Equal(t, int64(3), exec.Exec.ExitCode)
+		assert.NotNil(t, exec.Exec.ProcessConfig)
+	}
+
+	statsStream, err := containers.Stats(ctx, &v1.ContainerStatsRequest{Name: "foo", Stream: true})
+	assert.NoError(t, err)
+	var stats []*v1.ContainerStats
+	for {
+		st, err := statsStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		if err != nil {
+			break
+		}
+		stats = append(stats, st)
+	}
+	if assert.Len(t, stats, 2) {
+		assert.Equal(t, "foo", stats[0].Name)
+		assert.Equal(t, uint64(200), stats[1].CpuStats.CpuUsage.TotalUsage)
+		assert.Equal(t, map[string]uint64{"cache": 1}, stats[1].MemoryStats.Stats)
+		assert.Equal(t, uint64(2), stats[1].Networks["eth0"].RxBytes)
+	}
+
+	// config is converted into the type of REST API.
+	created, err := containers.Create(ctx, &v1.CreateContainerRequest{
+		Name: "foo",
+		Config: &v1.ContainerConfig{
+			Image:        "busybox",
+			ExposedPorts: []string{"80/tcp"},
+			StopTimeout:  &v1.Int64Value{Value: 0},
+		},
+		HostConfig: &v1.HostConfig{
+			PortBindings: map[string]*v1.PortBindings{
+				"80/tcp": {Bindings: []*v1.PortBinding{{HostPort: "8080"}}},
+			},
+			Resources: &v1.Resources{Memory: 1024},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "c1", created.Id)
+		config := s.ContainerMgr.(*mockGRPCContainerMgr).created
+		assert.Equal(t, "busybox", config.Image)
+		assert.Equal(t, map[string]interface{}{"80/tcp": map[string]interface{}{}}, config.ExposedPorts)
+		assert.Equal(t, int64(0), *config.StopTimeout)
+		assert.Equal(t, types.PortMap{"80/tcp": {{HostPort: "8080"}}}, config.HostConfig.PortBindings)
+		assert.Equal(t, int64(1024), config.HostConfig.Memory)
+		assert.NotNil(t, config.NetworkingConfig)
+	}
+
+	// config is validated the same as REST API.
+	_, err = containers.Create(ctx, &v1.CreateContainerRequest{Name: "foo", Config: &v1.ContainerConfig{}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = containers.Create(ctx, &v1.CreateContainerRequest{
+		Name:   "foo",
+		Config: &v1.ContainerConfig{Image: "busybox", StopTimeout: &v1.Int64Value{Value: -1}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	events, err := v1.NewSystemServiceClient(conn).Events(ctx, &v1.EventsRequest{})
+	assert.NoError(t, err)
+
+	var got []*v1.Event
+	for {
+		ev, err := events.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		if err != nil {
+			break
+		}
+		got = append(got, ev)
+	}
+	if assert.Len(t, got, 2) {
+		assert.Equal(t, "container", got[0].Type)
+		assert.Equal(t, "start", got[0].Action)
+		assert.Equal(t, "c1", got[0].Id)
+		assert.Equal(t, map[string]string{"name": "foo"}, got[0].Attributes)
+		assert.Equal(t, int64(2), got[1].TimeNano)
+	}
+}
+
+func TestGRPCAdmission(t *testing.T) {
+	block := make(chan struct{})
+	admitted := make(chan struct{}, 1)
+	observe := func(class admission.Class, stats admission.Stats) {
+		if stats.Inflight == 1 {
+			select {
+			case admitted <- struct{}{}:
+			default:
+			}
+		}
+	}
+
+	s := &Server{
+		Config:       &config.Config{},
+		SystemMgr:    &mockGRPCSystemMgr{},
+		ContainerMgr: &mockGRPCContainerMgr{block: block},
+		admission:    admission.New(admission.Config{Mutating: admission.Limit{MaxInflight: 1}}, observe),
+	}
+	conn, stop := startGRPCServer(t, s)
+	defer stop()
+	ctx := context.Background()
+	containers := v1.NewContainerServiceClient(conn)
+
+	done := make(chan error)
+	go func() {
+		_, err := containers.Pause(ctx, &v1.PauseContainerRequest{Name: "foo"})
+		done <- err
+	}()
+	<-admitted
+
+	_, err := containers.Unpause(ctx, &v1.UnpauseContainerRequest{Name: "foo"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the read requests are not limited.
+	_, err = containers.Inspect(ctx, &v1.InspectContainerRequest{Name: "foo"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	close(block)
+	assert.NoError(t, <-done)
+}
+
+func TestGRPCExec(t *testing.T) {
+	s := &Server{
+		Config:       &config.Config{},
+		ContainerMgr: &mockGRPCContainerMgr{},
+	}
+	conn, stop := startGRPCServer(t, s)
+	defer stop()
+	ctx := context.Background()
+	containers := v1.NewContainerServiceClient(conn)
+
+	stream, err := containers.Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, stream.Send(&v1.ExecRequest{
+		Container: "foo",
+		Config:    &v1.ExecConfig{Cmd: []string{"cat"}, AttachStdin: true},
+		Input:     &v1.StreamInput{Stdin: []byte("hello ")},
+	}))
+	assert.NoError(t, stream.Send(&v1.ExecRequest{Input: &v1.StreamInput{
+		Stdin:  []byte("world"),
+		Resize: &v1.TerminalSize{Height: 24, Width: 80},
+	}}))
+	assert.NoError(t, stream.Send(&v1.ExecRequest{Input: &v1.StreamInput{CloseStdin: true}}))
+
+	var (
+		id     string
+		stdout string
+		stderr string
+		exit   *v1.ExecExit
+	)
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		if resp.Id != "" {
+			id = resp.Id
+		}
+		if out := resp.Output; out != nil {
+			if out.Source == "stdout" {
+				stdout += string(out.Data)
+			} else {
+				stderr += string(out.Data)
+			}
+		}
+		if resp.Exit != nil {
+			exit = resp.Exit
+		}
+	}
+
+	assert.Equal(t, "e1", id)
+	assert.Equal(t, "hello world", stdout)
+	assert.Equal(t, "24x80", stderr)
+	if assert.NotNil(t, exit) {
+		assert.Equal(t, int64(3), exit.ExitCode)
+	}
+
+	// the error of creating exec process is returned as status.
+	stream, err = containers.Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, stream.Send(&v1.ExecRequest{Container: "bar", Config: &v1.ExecConfig{Cmd: []string{"cat"}}}))
+	_, err = stream.Recv()
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAddGRPCAuditTargets(t *testing.T) {
+	record := &audit.Record{}
+	addGRPCAuditTargets(record, &v1.CreateExecRequest{
+		Name:   "foo",
+		Config: &v1.ExecConfig{Cmd: []string{"sh"}, Env: []string{"PASSWORD=123"}},
+	})
+	assert.Equal(t, map[string]string{"name": "foo"}, record.Targets)
+	assert.Equal(t, `{"config":{"cmd":["sh"],"env":["PASSWORD=<redacted>"]},"name":"foo"}`, string(record.Body))
+
+	// the requests without config are not recorded as body.
+	record = &audit.Record{}
+	addGRPCAuditTargets(record, &v1.StartContainerRequest{Name: "foo"})
+	assert.Equal(t, map[string]string{"name": "foo"}, record.Targets)
+	assert.Nil(t, record.Body)
+}
+
+func TestContainerToGRPC(t *testing.T) {
+	sizeRw := int64(0)
+	container := containerToGRPC(&types.ContainerJSON{
+		ID:    "c1",
+		State: &types.ContainerState{Status: types.StatusRunning, Running: true, Pid: 10},
+		Config: &types.ContainerConfig{
+			Image:        "busybox",
+			ExposedPorts: map[string]interface{}{"80/tcp": struct{}{}, "443/tcp": struct{}{}},
+		},
+		HostConfig: &types.HostConfig{
+			PortBindings: types.PortMap{"80/tcp": {{HostPort: "8080"}}},
+			Resources:    types.Resources{Memory: 1024},
+		},
+		NetworkSettings: &types.NetworkSettings{
+			Networks: map[string]*types.EndpointSettings{
+				"bridge": {IPAddress: "192.168.5.2", IPPrefixLen: 24},
+			},
+		},
+		Mounts: []types.MountPoint{{Source: "/data", Destination: "/data", RW: true}},
+		SizeRw: &sizeRw,
+	})
+
+	assert.Equal(t, "c1", container.Id)
+	assert.Equal(t, "running", container.State.Status)
+	assert.Equal(t, int64(10), container.State.Pid)
+	assert.Equal(t, []string{"443/tcp", "80/tcp"}, container.Config.ExposedPorts)
+	assert.Equal(t, "8080", container.HostConfig.PortBindings["80/tcp"].Bindings[0].HostPort)
+	assert.Equal(t, int64(1024), container.HostConfig.Resources.Memory)
+	assert.Equal(t, "192.168.5.2", container.NetworkSettings.Networks["bridge"].IpAddress)
+	assert.True(t, container.Mounts[0].Rw)
+	// the optional sizes keep nil apart from zero.
+	assert.Equal(t, &v1.Int64Value{Value: 0}, container.SizeRw)
+	assert.Nil(t, container.SizeRootFs)
+	assert.Nil(t, container.GraphDriver)
+
+	// the configs converted back are the same as REST API.
+	config := containerConfigFromGRPC(container.Config)
+	assert.Equal(t, map[string]interface{}{"80/tcp": map[string]interface{}{}, "443/tcp": map[string]interface{}{}}, config.ExposedPorts)
+	hostConfig := hostConfigFromGRPC(container.HostConfig)
+	assert.Equal(t, types.PortMap{"80/tcp": {{HostPort: "8080"}}}, hostConfig.PortBindings)
+}
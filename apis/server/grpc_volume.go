@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/grpc/v1"
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// grpcVolumeService implements v1.VolumeServiceServer on VolumeMgr.
+type grpcVolumeService struct {
+	*Server
+}
+
+// grpcVolume converts the details of volume into gRPC message.
+func grpcVolume(info *types.VolumeInfo) *v1.Volume {
+	volume := &v1.Volume{
+		Name:       info.Name,
+		Driver:     info.Driver,
+		Mountpoint: info.Mountpoint,
+		CreatedAt:  info.CreatedAt,
+		Labels:     info.Labels,
+		Status:     make(map[string]string, len(info.Status)),
+	}
+	for k, v := range info.Status {
+		volume.Status[k] = fmt.Sprintf("%v", v)
+	}
+	return volume
+}
+
+func (s *grpcVolumeService) Create(ctx context.Context, req *v1.CreateVolumeRequest) (*v1.CreateVolumeResponse, error) {
+	config := volumeCreateConfigFromGRPC(req.Config)
+
+	logCreateOptions(ctx, "volume", config)
+
+	if err := s.preVolumeCreate(ctx, config); err != nil {
+		return nil, err
+	}
+
+	if err := validateVolumeCreateConfig(config); err != nil {
+		return nil, err
+	}
+
+	volume, err := s.VolumeMgr.Create(ctx, config.Name, config.Driver, config.DriverOpts, config.Labels)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateVolumeResponse{Volume: grpcVolume(buildVolumeInfo(volume))}, nil
+}
+
+func (s *grpcVolumeService) List(ctx context.Context, req *v1.ListVolumesRequest) (*v1.ListVolumesResponse, error) {
+	filter, err := filters.FromParam(req.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes, err := s.VolumeMgr.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ListVolumesResponse{}
+	for _, volume := range volumes {
+		resp.Volumes = append(resp.Volumes, grpcVolume(buildVolumeInfo(volume)))
+	}
+	return resp, nil
+}
+
+func (s *grpcVolumeService) Inspect(ctx context.Context, req *v1.InspectVolumeRequest) (*v1.InspectVolumeResponse, error) {
+	volume, err := s.VolumeMgr.Get(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.InspectVolumeResponse{Volume: grpcVolume(buildVolumeInfo(volume))}, nil
+}
+
+func (s *grpcVolumeService) Remove(ctx context.Context, req *v1.RemoveVolumeRequest) (*v1.RemoveVolumeResponse, error) {
+	if err := s.VolumeMgr.Remove(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	return &v1.RemoveVolumeResponse{}, nil
+}
@@ -123,6 +123,17 @@ func (s *Server) searchImages(ctx context.Context, rw http.ResponseWriter, req *
 func (s *Server) removeImage(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	name := mux.Vars(req)["name"]
 
+	if err := s.removeImageIfUnused(ctx, name, httputils.BoolValue(req, "force")); err != nil {
+		return err
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// removeImageIfUnused deletes an image by reference, the image used by
+// containers is only removed by force.
+func (s *Server) removeImageIfUnused(ctx context.Context, name string, isForce bool) error {
 	image, err := s.ImageMgr.GetImage(ctx, name)
 	if err != nil {
 		return err
@@ -139,8 +150,6 @@ func (s *Server) removeImage(ctx context.Context, rw http.ResponseWriter, req *h
 		metrics.ImageActionsTimer.WithLabelValues(label).Observe(time.Since(start).Seconds())
 	}(time.Now())
 
-	isForce := httputils.BoolValue(req, "force")
-
 	isImageIDPrefix := func(imageID string, name string) bool {
 		if strings.HasPrefix(imageID, name) || strings.HasPrefix(digest.Digest(imageID).Hex(), name) {
 			return true
@@ -170,7 +179,6 @@ func (s *Server) removeImage(ctx context.Context, rw http.ResponseWriter, req *h
 	}
 
 	metrics.ImageSuccessActionsCounter.WithLabelValues(label).Inc()
-	rw.WriteHeader(http.StatusNoContent)
 	return nil
 }
 
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/httputils"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gorilla/mux"
+)
+
+func (s *Server) createPod(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	config := &types.PodCreateConfig{}
+	// decode request body
+	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	logCreateOptions(ctx, "pod", config)
+
+	// validate request body
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	resp, err := s.PodMgr.Create(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusCreated, resp)
+}
+
+func (s *Server) listPods(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	pods, err := s.PodMgr.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusOK, pods)
+}
+
+func (s *Server) getPod(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	pod, err := s.PodMgr.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusOK, pod)
+}
+
+func (s *Server) startPod(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	if err := s.PodMgr.Start(ctx, name); err != nil {
+		return err
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) stopPod(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	var t int
+	if v := req.FormValue("t"); v != "" {
+		var err error
+		if t, err = strconv.Atoi(v); err != nil {
+			return httputils.NewHTTPError(err, http.StatusBadRequest)
+		}
+	}
+
+	name := mux.Vars(req)["name"]
+
+	if err := s.PodMgr.Stop(ctx, name, int64(t)); err != nil {
+		return err
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) removePod(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	if err := s.PodMgr.Remove(ctx, name, httputils.BoolValue(req, "force")); err != nil {
+		return err
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -12,10 +12,12 @@ import (
 
 	serverTypes "github.com/alibaba/pouch/apis/server/types"
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/audit"
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/randomid"
+	"github.com/alibaba/pouch/pkg/trace"
 	"github.com/alibaba/pouch/pkg/utils"
 
 	"github.com/gorilla/mux"
@@ -37,6 +39,8 @@ func initRoute(s *Server) *mux.Router {
 
 		// daemon, we still list this API into system manager.
 		{Method: http.MethodPost, Path: "/daemon/update", HandlerFunc: s.updateDaemon},
+		{Method: http.MethodPost, Path: "/daemon/fsck", HandlerFunc: s.fsckDaemon, MinVersion: "1.25"},
+		{Method: http.MethodGet, Path: "/daemon/backup", HandlerFunc: withCancelHandler(s.backupDaemon), MinVersion: "1.25"},
 
 		// container
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/checkpoints", HandlerFunc: withCancelHandler(s.createContainerCheckpoint)},
@@ -46,13 +50,17 @@ func initRoute(s *Server) *mux.Router {
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/start", HandlerFunc: s.startContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/stop", HandlerFunc: s.stopContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/attach", HandlerFunc: s.attachContainer},
+		{Method: http.MethodGet, Path: "/containers/{name:.*}/attach/ws", HandlerFunc: s.attachContainerWebSocket, MinVersion: "1.25"},
 		{Method: http.MethodGet, Path: "/containers/json", HandlerFunc: s.getContainers},
 		{Method: http.MethodGet, Path: "/containers/{name:.*}/json", HandlerFunc: s.getContainer},
 		{Method: http.MethodDelete, Path: "/containers/{name:.*}", HandlerFunc: s.removeContainers},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/exec", HandlerFunc: s.createContainerExec},
 		{Method: http.MethodGet, Path: "/exec/{name:.*}/json", HandlerFunc: s.getExecInfo},
 		{Method: http.MethodPost, Path: "/exec/{name:.*}/start", HandlerFunc: s.startContainerExec},
+		{Method: http.MethodGet, Path: "/exec/{name:.*}/start/ws", HandlerFunc: s.startContainerExecWebSocket, MinVersion: "1.25"},
 		{Method: http.MethodPost, Path: "/exec/{name:.*}/resize", HandlerFunc: s.resizeExec},
+		{Method: http.MethodPost, Path: "/exec/{name:.*}/kill", HandlerFunc: s.killExec, MinVersion: "1.25"},
+		{Method: http.MethodGet, Path: "/containers/{name:.*}/execs", HandlerFunc: s.listContainerExecs, MinVersion: "1.25"},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/rename", HandlerFunc: s.renameContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/restart", HandlerFunc: s.restartContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/pause", HandlerFunc: s.pauseContainer},
@@ -93,6 +101,20 @@ func initRoute(s *Server) *mux.Router {
 		{Method: http.MethodPost, Path: "/networks/{id:.*}/connect", HandlerFunc: s.connectToNetwork},
 		{Method: http.MethodPost, Path: "/networks/{id:.*}/disconnect", HandlerFunc: s.disconnectNetwork},
 
+		// pod
+		{Method: http.MethodGet, Path: "/pods/json", HandlerFunc: s.listPods, MinVersion: "1.25"},
+		{Method: http.MethodPost, Path: "/pods/create", HandlerFunc: s.createPod, MinVersion: "1.25"},
+		{Method: http.MethodGet, Path: "/pods/{name:.*}/json", HandlerFunc: s.getPod, MinVersion: "1.25"},
+		{Method: http.MethodPost, Path: "/pods/{name:.*}/start", HandlerFunc: s.startPod, MinVersion: "1.25"},
+		{Method: http.MethodPost, Path: "/pods/{name:.*}/stop", HandlerFunc: s.stopPod, MinVersion: "1.25"},
+		{Method: http.MethodDelete, Path: "/pods/{name:.*}", HandlerFunc: s.removePod, MinVersion: "1.25"},
+
+		// secret
+		{Method: http.MethodGet, Path: "/secrets", HandlerFunc: s.listSecrets, MinVersion: "1.25"},
+		{Method: http.MethodPost, Path: "/secrets/create", HandlerFunc: s.createSecret, MinVersion: "1.25"},
+		{Method: http.MethodGet, Path: "/secrets/{name:.*}", HandlerFunc: s.getSecret, MinVersion: "1.25"},
+		{Method: http.MethodDelete, Path: "/secrets/{name:.*}", HandlerFunc: s.removeSecret, MinVersion: "1.25"},
+
 		// metrics
 		{Method: http.MethodGet, Path: "/metrics", HandlerFunc: s.metrics},
 
@@ -117,8 +139,12 @@ func initRoute(s *Server) *mux.Router {
 	// register API
 	for _, h := range handlers {
 		if h != nil {
-			r.Path(versionMatcher + h.Path).Methods(h.Method).Handler(filter(h.HandlerFunc, s))
-			r.Path(h.Path).Methods(h.Method).Handler(filter(h.HandlerFunc, s))
+			// the version is checked before the request is admitted.
+			spec := *h
+			spec.HandlerFunc = s.withAdmission(h, h.HandlerFunc)
+			handler := withAPIVersion(&spec)
+			r.Path(versionMatcher + h.Path).Methods(h.Method).Handler(filter(handler, s))
+			r.Path(h.Path).Methods(h.Method).Handler(filter(handler, s))
 		}
 	}
 
@@ -180,8 +206,9 @@ func filter(handler serverTypes.Handler, s *Server) http.HandlerFunc {
 		ctx, cancel := context.WithCancel(pctx)
 		defer cancel()
 
+		requestID := randomid.Generate()[:10]
 		ctx = log.NewContext(ctx, map[string]interface{}{
-			"RequestID": randomid.Generate()[:10],
+			"RequestID": requestID,
 		})
 
 		if flyingReqDecider(req) {
@@ -189,10 +216,40 @@ func filter(handler serverTypes.Handler, s *Server) http.HandlerFunc {
 			defer atomic.AddInt32(&s.FlyingReq, -1)
 		}
 
+		// err is the error returned by handler, which is recorded in audit log.
+		var err error
+
+		ctx, span := trace.StartSpan(trace.ExtractHTTP(ctx, req.Header), req.Method+" "+routeTemplate(req),
+			trace.WithKind(trace.SpanKindServer),
+			trace.WithAttribute("http.method", req.Method),
+			trace.WithAttribute("http.target", req.URL.RequestURI()),
+			trace.WithAttribute("pouch.request_id", requestID),
+		)
+		if span != nil {
+			ctx = log.AddFields(ctx, map[string]interface{}{"TraceID": span.SpanContext().TraceID.String()})
+		}
+		defer func() {
+			span.Finish(err)
+		}()
+		if s.auditLogger != nil && s.auditLogger.ShouldAudit(req.Method) {
+			record := s.newAuditRecord(ctx, req, requestID)
+			ctx = audit.WithRecord(ctx, record)
+
+			aw := &auditResponseWriter{ResponseWriter: w}
+			w = aw
+			defer func() {
+				record.Finish(aw.Status(), err)
+				if logErr := s.auditLogger.Log(record); logErr != nil {
+					log.With(ctx).Errorf("failed to write audit log: %v", logErr)
+				}
+			}()
+		}
+
 		s.lock.RLock()
 		if len(s.ManagerWhiteList) > 0 && req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
 			if _, isManager := s.ManagerWhiteList[req.TLS.PeerCertificates[0].Subject.CommonName]; !isManager {
 				s.lock.RUnlock()
+				err = fmt.Errorf("tls verified error")
 				w.WriteHeader(http.StatusForbidden)
 				w.Write([]byte("tls verified error."))
 				return
@@ -225,7 +282,7 @@ func filter(handler serverTypes.Handler, s *Server) http.HandlerFunc {
 		}
 
 		// Start to handle request.
-		err := handler(ctx, w, req)
+		err = handler(ctx, w, req)
 		if err == nil {
 			return
 		}
@@ -235,7 +292,18 @@ func filter(handler serverTypes.Handler, s *Server) http.HandlerFunc {
 	}
 }
 
-var routeGroupToWait = []string{"/containers/", "/volumes/", "/networks/"}
+// routeTemplate returns the path template of the route which matches req,
+// such as /containers/{name:.*}/start, it's the name of span of request.
+func routeTemplate(req *http.Request) string {
+	if route := mux.CurrentRoute(req); route != nil {
+		if tpl, err := route.GetPathTemplate(); err == nil {
+			return strings.TrimPrefix(tpl, versionMatcher)
+		}
+	}
+	return req.URL.Path
+}
+
+var routeGroupToWait = []string{"/containers/", "/volumes/", "/networks/", "/pods/"}
 
 func flyingReqDecider(req *http.Request) bool {
 	for _, r := range routeGroupToWait {
@@ -255,14 +323,22 @@ func EncodeResponse(rw http.ResponseWriter, statusCode int, data interface{}) er
 
 // HandleErrorResponse handles err from daemon side and constructs response for client side.
 func HandleErrorResponse(w http.ResponseWriter, err error) {
-	var (
-		code   int
-		errMsg string
-	)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(errorStatusCode(err))
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
 
+	resp := types.Error{
+		Message: err.Error(),
+		Kind:    errtypes.Kind(err),
+	}
+	enc.Encode(resp)
+}
+
+// errorStatusCode returns the http status code of error returned by handler.
+func errorStatusCode(err error) int {
 	// By default, daemon side returns code 500 if error happens.
-	code = http.StatusInternalServerError
-	errMsg = err.Error()
+	code := http.StatusInternalServerError
 
 	httpErr, ok := err.(httputils.HTTPError)
 	if ok {
@@ -271,21 +347,12 @@ func HandleErrorResponse(w http.ResponseWriter, err error) {
 		code = http.StatusNotFound
 	} else if errtypes.IsInvalidParam(err) {
 		code = http.StatusBadRequest
-	} else if errtypes.IsAlreadyExisted(err) {
+	} else if errtypes.IsAlreadyExisted(err) || errtypes.IsConflict(err) || errtypes.IsInUse(err) {
 		code = http.StatusConflict
 	} else if errtypes.IsNotModified(err) {
 		code = http.StatusNotModified
 	} else if errtypes.IsInvalidAuthorization(err) {
 		code = http.StatusForbidden
 	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(code)
-	enc := json.NewEncoder(w)
-	enc.SetEscapeHTML(false)
-
-	resp := types.Error{
-		Message: errMsg,
-	}
-	enc.Encode(resp)
+	return code
 }
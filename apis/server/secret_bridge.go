@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/httputils"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gorilla/mux"
+)
+
+func (s *Server) createSecret(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	config := &types.SecretCreateConfig{}
+	// decode request body
+	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	// NOTE: never log the value of secret.
+	logCreateOptions(ctx, "secret", &types.SecretCreateConfig{
+		Name:   config.Name,
+		Labels: config.Labels,
+	})
+
+	// validate request body
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	resp, err := s.SecretMgr.Create(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusCreated, resp)
+}
+
+func (s *Server) listSecrets(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	secrets, err := s.SecretMgr.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusOK, secrets)
+}
+
+func (s *Server) getSecret(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	secret, err := s.SecretMgr.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusOK, secret)
+}
+
+func (s *Server) removeSecret(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	if err := s.SecretMgr.Remove(ctx, name); err != nil {
+		return err
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -14,9 +14,14 @@ import (
 	"github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/hookplugins"
+	"github.com/alibaba/pouch/pkg/admission"
+	"github.com/alibaba/pouch/pkg/audit"
 	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/netutils"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
 )
 
 // Server is a http server which serves restful api to client.
@@ -27,17 +32,27 @@ type Server struct {
 	ImageMgr         mgr.ImageMgr
 	VolumeMgr        mgr.VolumeMgr
 	NetworkMgr       mgr.NetworkMgr
+	PodMgr           mgr.PodMgr
+	SecretMgr        mgr.SecretMgr
 	StreamRouter     stream.Router
 	listeners        []net.Listener
 	ContainerPlugin  hookplugins.ContainerPlugin
+	VolumePlugin     hookplugins.VolumePlugin
 	APIPlugin        hookplugins.APIPlugin
 	ManagerWhiteList map[string]struct{}
 	lock             sync.RWMutex
 	FlyingReq        int32
+	auditLogger      *audit.Logger
+	admission        *admission.Controller
+	grpcServer       *grpc.Server
 }
 
 // Start setup route table and listen to specified address which currently only supports unix socket and tcp address.
 func (s *Server) Start(readyCh chan bool) (err error) {
+	if s.Config.AdmissionControl.Enabled() {
+		s.admission = admission.New(s.Config.AdmissionControl, observeAdmission)
+	}
+
 	router := initRoute(s)
 	errCh := make(chan error)
 
@@ -49,6 +64,14 @@ func (s *Server) Start(readyCh chan bool) (err error) {
 		}
 	}()
 
+	if s.Config.Audit.Enable {
+		s.auditLogger, err = audit.NewLogger(s.Config.Audit)
+		if err != nil {
+			readyCh <- false
+			return errors.Wrap(err, "failed to create audit logger")
+		}
+	}
+
 	var tlsConfig *tls.Config
 	if s.Config.TLS.Key != "" && s.Config.TLS.Cert != "" {
 		tlsConfig, err = httputils.GenTLSConfig(s.Config.TLS.Key, s.Config.TLS.Cert, s.Config.TLS.CA)
@@ -59,28 +82,53 @@ func (s *Server) Start(readyCh chan bool) (err error) {
 		if s.Config.TLS.VerifyRemote {
 			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
 		}
-		SetupManagerWhitelist(s)
 	}
+	// listeners may have their own TLS configs.
+	SetupManagerWhitelist(s)
 
 	for _, one := range s.Config.Listen {
-		l, err := netutils.GetListener(one, tlsConfig)
+		ls, err := netutils.GetListeners(one, tlsConfig)
 		if err != nil {
 			readyCh <- false
 			return err
 		}
-		log.With(nil).Infof("start to listen to: %s", one)
-		s.listeners = append(s.listeners, l)
-
-		go func(l net.Listener) {
-			s := &http.Server{
-				Handler:           router,
-				ErrorLog:          stdlog.New(stdFilterLogWriter, "", 0),
-				ReadTimeout:       time.Minute * 10,
-				ReadHeaderTimeout: time.Minute * 10,
-				IdleTimeout:       time.Minute * 10,
-			}
-			errCh <- s.Serve(l)
-		}(l)
+
+		for _, l := range ls {
+			log.With(nil).Infof("start to listen to: %s (%s %s)", one, l.Addr().Network(), l.Addr())
+			s.listeners = append(s.listeners, l)
+
+			go func(l net.Listener) {
+				s := &http.Server{
+					Handler:           router,
+					ErrorLog:          stdlog.New(stdFilterLogWriter, "", 0),
+					ReadTimeout:       time.Minute * 10,
+					ReadHeaderTimeout: time.Minute * 10,
+					IdleTimeout:       time.Minute * 10,
+					ConnContext:       withPeerCred,
+				}
+				errCh <- s.Serve(l)
+			}(l)
+		}
+	}
+
+	if len(s.Config.GRPCListen) > 0 {
+		s.grpcServer = s.newGRPCServer()
+	}
+	for _, one := range s.Config.GRPCListen {
+		ls, err := netutils.GetListenersWithNextProtos(one, tlsConfig, grpcNextProtos)
+		if err != nil {
+			readyCh <- false
+			return err
+		}
+
+		for _, l := range ls {
+			log.With(nil).Infof("start to listen to gRPC API: %s (%s %s)", one, l.Addr().Network(), l.Addr())
+			s.listeners = append(s.listeners, l)
+
+			go func(l net.Listener) {
+				errCh <- s.grpcServer.Serve(l)
+			}(l)
+		}
 	}
 
 	// the http server has set up, send Ready
@@ -127,5 +175,13 @@ func (s *Server) Stop() error {
 		log.With(nil).Errorf("stop pouch server after waited 60 seconds, on going request %d", atomic.LoadInt32(&s.FlyingReq))
 	}
 
+	// the streams of gRPC API, such as events, are closed after draining.
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+
+	if s.auditLogger != nil {
+		return s.auditLogger.Close()
+	}
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/alibaba/pouch/apis/filters"
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/utils"
@@ -56,6 +57,19 @@ func (s *Server) updateDaemon(ctx context.Context, rw http.ResponseWriter, req *
 	return s.SystemMgr.UpdateDaemon(cfg)
 }
 
+func (s *Server) fsckDaemon(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
+	opts := &mgr.FsckOptions{
+		Repair: httputils.BoolValue(req, "repair"),
+		DryRun: httputils.BoolValue(req, "dryrun"),
+	}
+
+	report, err := s.ContainerMgr.Fsck(ctx, opts)
+	if err != nil {
+		return err
+	}
+	return EncodeResponse(rw, http.StatusOK, report)
+}
+
 func (s *Server) auth(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
 	auth := types.AuthConfig{}
 
@@ -88,12 +102,20 @@ func (s *Server) events(ctx context.Context, rw http.ResponseWriter, req *http.R
 	output.Flush()
 	enc := json.NewEncoder(output)
 
+	return s.streamEvents(ctx, req.FormValue("since"), req.FormValue("until"), req.FormValue("filters"), func(ev *types.EventsMessage) error {
+		return enc.Encode(ev)
+	})
+}
+
+// streamEvents sends the past events and subscribes the new events between
+// since and until to send, until the client goes away.
+func (s *Server) streamEvents(ctx context.Context, sinceParam, untilParam, filtersParam string, send func(*types.EventsMessage) error) error {
 	// parse the since and until parameters
-	since, err := eventTime(req.FormValue("since"))
+	since, err := eventTime(sinceParam)
 	if err != nil {
 		return err
 	}
-	until, err := eventTime(req.FormValue("until"))
+	until, err := eventTime(untilParam)
 	if err != nil {
 		return err
 	}
@@ -104,7 +126,7 @@ func (s *Server) events(ctx context.Context, rw http.ResponseWriter, req *http.R
 	)
 	if !until.IsZero() {
 		if until.Before(since) {
-			return fmt.Errorf("until time (%s) cannot be after since (%s)", req.FormValue("until"), req.FormValue("since"))
+			return fmt.Errorf("until time (%s) cannot be after since (%s)", untilParam, sinceParam)
 		}
 
 		now := time.Now()
@@ -115,15 +137,15 @@ func (s *Server) events(ctx context.Context, rw http.ResponseWriter, req *http.R
 		}
 	}
 
-	ef, err := filters.FromParam(req.FormValue("filters"))
+	ef, err := filters.FromParam(filtersParam)
 	if err != nil {
 		return err
 	}
 
 	// send past events
 	buffered, eventq, errq := s.SystemMgr.SubscribeToEvents(ctx, since, until, ef)
-	for _, ev := range buffered {
-		if err := enc.Encode(ev); err != nil {
+	for i := range buffered {
+		if err := send(&buffered[i]); err != nil {
 			return err
 		}
 	}
@@ -137,7 +159,7 @@ func (s *Server) events(ctx context.Context, rw http.ResponseWriter, req *http.R
 	for {
 		select {
 		case ev := <-eventq:
-			if err := enc.Encode(ev); err != nil {
+			if err := send(ev); err != nil {
 				log.With(ctx).Errorf("encode events got an error: %v", err)
 				return err
 			}
@@ -170,3 +192,13 @@ func eventTime(formTime string) (time.Time, error) {
 	}
 	return time.Unix(t, tNano), nil
 }
+
+func (s *Server) backupDaemon(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
+	opts := &mgr.BackupOptions{
+		VolumeData: httputils.BoolValue(req, "volumedata"),
+		RWLayers:   httputils.BoolValue(req, "rwlayers"),
+	}
+
+	rw.Header().Set("Content-Type", "application/x-tar")
+	return s.ContainerMgr.Backup(ctx, opts, newWriteFlusher(rw))
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/trace"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterTracing(t *testing.T) {
+	// the spans posted to OTLP endpoint.
+	type span struct {
+		TraceID      string `json:"traceId"`
+		ParentSpanID string `json:"parentSpanId"`
+		Name         string `json:"name"`
+		Kind         int    `json:"kind"`
+		Status       struct {
+			Code int `json:"code"`
+		} `json:"status"`
+	}
+	var spans []span
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var data struct {
+			ResourceSpans []struct {
+				ScopeSpans []struct {
+					Spans []span `json:"spans"`
+				} `json:"scopeSpans"`
+			} `json:"resourceSpans"`
+		}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&data))
+		for _, rs := range data.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+	}))
+	defer collector.Close()
+
+	assert.NoError(t, trace.Init(trace.Config{Endpoint: collector.URL, SampleRatio: 1}))
+
+	var traceID string
+	r := mux.NewRouter()
+	r.Path(versionMatcher + "/containers/{name:.*}/start").Methods(http.MethodPost).Handler(filter(func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// the managers start their spans as children of request.
+		sc := trace.SpanContextFromContext(ctx)
+		assert.True(t, sc.IsValid())
+		traceID = sc.TraceID.String()
+		return errors.Wrap(errtypes.ErrNotfound, "container foo")
+	}, &Server{}))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1.24/containers/foo/start", nil)
+	req.Header.Set(trace.TraceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.NoError(t, trace.Shutdown(context.Background()))
+
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", traceID)
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "POST /containers/{name:.*}/start", spans[0].Name)
+		assert.Equal(t, traceID, spans[0].TraceID)
+		assert.Equal(t, "00f067aa0ba902b7", spans[0].ParentSpanID)
+		assert.Equal(t, int(trace.SpanKindServer), spans[0].Kind)
+		assert.Equal(t, 2, spans[0].Status.Code)
+	}
+}
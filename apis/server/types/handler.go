@@ -10,6 +10,9 @@ type HandlerSpec struct {
 	Method      string
 	Path        string
 	HandlerFunc Handler
+	// MinVersion is the api version the API is introduced in, it's not
+	// served to the requests of older versions.
+	MinVersion string
 }
 
 // Handler is the http request handler.
@@ -23,3 +26,27 @@ func NewHandlerSpec(method, path string, handler Handler) *HandlerSpec {
 		HandlerFunc: handler,
 	}
 }
+
+// StreamingAPIs are the paths of APIs which hold the connection to stream
+// data or hijack it, they are limited separately from the short requests by
+// admission control, and they can't be proxied by buffering.
+var StreamingAPIs = map[string]bool{
+	"/events":                         true,
+	"/daemon/backup":                  true,
+	"/containers/{name:.*}/attach":    true,
+	"/containers/{name:.*}/attach/ws": true,
+	"/containers/{name:.*}/logs":      true,
+	"/containers/{name:.*}/stats":     true,
+	"/containers/{name:.*}/wait":      true,
+	"/containers/{name:.*}/archive":   true,
+	"/exec/{name:.*}/start":           true,
+	"/exec/{name:.*}/start/ws":        true,
+	"/images/create":                  true,
+	"/images/load":                    true,
+	"/images/save":                    true,
+	"/images/{name:.*}/push":          true,
+	// the streams of CRI served by the stream router of CRI.
+	"/exec/{token}":        true,
+	"/attach/{token}":      true,
+	"/portforward/{token}": true,
+}
@@ -8,11 +8,13 @@ import (
 	"github.com/alibaba/pouch/apis/filters"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/randomid"
 	volumetypes "github.com/alibaba/pouch/storage/volume/types"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 )
 
 func (s *Server) createVolume(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
@@ -24,56 +26,62 @@ func (s *Server) createVolume(ctx context.Context, rw http.ResponseWriter, req *
 
 	logCreateOptions(ctx, "volume", config)
 
-	// validate request body
-	if err := config.Validate(strfmt.NewFormats()); err != nil {
-		return httputils.NewHTTPError(err, http.StatusBadRequest)
-	}
-
-	name := config.Name
-	driver := config.Driver
-	options := config.DriverOpts
-	labels := config.Labels
-
-	if name == "" {
-		name = randomid.Generate()
+	// set pre volume create hook plugin
+	if err := s.preVolumeCreate(ctx, config); err != nil {
+		return err
 	}
 
-	if driver == "" {
-		driver = volumetypes.DefaultBackend
+	// validate request body
+	if err := validateVolumeCreateConfig(config); err != nil {
+		return err
 	}
 
-	volume, err := s.VolumeMgr.Create(ctx, name, driver, options, labels)
+	volume, err := s.VolumeMgr.Create(ctx, config.Name, config.Driver, config.DriverOpts, config.Labels)
 	if err != nil {
 		return err
 	}
 
-	status := map[string]interface{}{}
-	for k, v := range volume.Options() {
-		if k != "" && v != "" {
-			status[k] = v
-		}
-	}
-	status["size"] = volume.Size()
+	respVolume := buildVolumeInfo(volume)
+	respVolume.Name = config.Name
+	respVolume.Driver = config.Driver
+	respVolume.Labels = config.Labels
+	respVolume.CreatedAt = volume.CreationTimestamp.Format("2006-1-2 15:04:05")
 
-	respVolume := types.VolumeInfo{
-		Name:       name,
-		Driver:     driver,
-		Labels:     config.Labels,
-		Mountpoint: volume.Path(),
-		Status:     status,
-		CreatedAt:  volume.CreationTimestamp.Format("2006-1-2 15:04:05"),
+	return EncodeResponse(rw, http.StatusCreated, respVolume)
+}
+
+// preVolumeCreate invokes the pre-volume-create hook of volume plugin if exist.
+func (s *Server) preVolumeCreate(ctx context.Context, config *types.VolumeCreateConfig) error {
+	if s.VolumePlugin == nil {
+		return nil
 	}
 
-	return EncodeResponse(rw, http.StatusCreated, respVolume)
+	log.With(ctx).Infof("invoke volume pre-create hook in plugin")
+	if err := s.VolumePlugin.PreVolumeCreate(ctx, config); err != nil {
+		return errors.Wrapf(err, "failed to execute pre-volume-create plugin point")
+	}
+	return nil
 }
 
-func (s *Server) getVolume(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
-	name := mux.Vars(req)["name"]
-	volume, err := s.VolumeMgr.Get(ctx, name)
-	if err != nil {
-		return err
+// validateVolumeCreateConfig validates the config to create volume, and fills
+// the default name and driver.
+func validateVolumeCreateConfig(config *types.VolumeCreateConfig) error {
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	if config.Name == "" {
+		config.Name = randomid.Generate()
 	}
 
+	if config.Driver == "" {
+		config.Driver = volumetypes.DefaultBackend
+	}
+	return nil
+}
+
+// buildVolumeInfo builds the details of volume.
+func buildVolumeInfo(volume *volumetypes.Volume) *types.VolumeInfo {
 	status := map[string]interface{}{}
 	for k, v := range volume.Options() {
 		if k != "" && v != "" {
@@ -82,7 +90,7 @@ func (s *Server) getVolume(ctx context.Context, rw http.ResponseWriter, req *htt
 	}
 	status["size"] = volume.Size()
 
-	respVolume := types.VolumeInfo{
+	return &types.VolumeInfo{
 		Name:       volume.Name,
 		Driver:     volume.Driver(),
 		Mountpoint: volume.Path(),
@@ -90,8 +98,16 @@ func (s *Server) getVolume(ctx context.Context, rw http.ResponseWriter, req *htt
 		Labels:     volume.Labels,
 		Status:     status,
 	}
+}
 
-	return EncodeResponse(rw, http.StatusOK, respVolume)
+func (s *Server) getVolume(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
+	name := mux.Vars(req)["name"]
+	volume, err := s.VolumeMgr.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusOK, buildVolumeInfo(volume))
 }
 
 func (s *Server) listVolume(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
@@ -107,23 +123,7 @@ func (s *Server) listVolume(ctx context.Context, rw http.ResponseWriter, req *ht
 
 	respVolumes := types.VolumeListResp{Volumes: []*types.VolumeInfo{}, Warnings: nil}
 	for _, volume := range volumes {
-		status := map[string]interface{}{}
-		for k, v := range volume.Options() {
-			if k != "" && v != "" {
-				status[k] = v
-			}
-		}
-		status["size"] = volume.Size()
-
-		respVolume := &types.VolumeInfo{
-			Name:       volume.Name,
-			Driver:     volume.Driver(),
-			Mountpoint: volume.Path(),
-			CreatedAt:  volume.CreateTime(),
-			Labels:     volume.Labels,
-			Status:     status,
-		}
-		respVolumes.Volumes = append(respVolumes.Volumes, respVolume)
+		respVolumes.Volumes = append(respVolumes.Volumes, buildVolumeInfo(volume))
 	}
 	return EncodeResponse(rw, http.StatusOK, respVolumes)
 }
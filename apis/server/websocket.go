@@ -0,0 +1,136 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"k8s.io/apiserver/pkg/util/wsstream"
+)
+
+// The websocket endpoints multiplex the streams as channels, every message
+// begins with one byte which indicates the channel number, see the protocol
+// "channel.k8s.io" and "base64.channel.k8s.io" for detail.
+const (
+	wsStdinChannel = iota
+	wsStdoutChannel
+	wsStderrChannel
+	wsErrorChannel
+	wsResizeChannel
+)
+
+// wsProtocols is the supported websocket subprotocols.
+var wsProtocols = []string{"", wsstream.ChannelWebSocketProtocol, wsstream.Base64ChannelWebSocketProtocol}
+
+// wsStreams holds the streams of the websocket connection.
+type wsStreams struct {
+	conn *wsstream.Conn
+
+	stdin  io.ReadCloser
+	stdout io.Writer
+	stderr io.Writer
+	// errStream is used to send the error message to client since the
+	// http status can't be changed after upgraded.
+	errStream io.Writer
+	// resize is used to receive the resize control message from client,
+	// the message is the json of types.ResizeOptions.
+	resize io.Reader
+}
+
+// openWebSocketConnection upgrades the request into websocket connection
+// and returns the multiplexed streams.
+func openWebSocketConnection(rw http.ResponseWriter, req *http.Request) (*wsStreams, error) {
+	if !wsstream.IsWebSocketRequest(req) {
+		return nil, httputils.NewHTTPError(fmt.Errorf("request is not a websocket upgrade request"), http.StatusBadRequest)
+	}
+
+	// NOTE: wsstream.Conn.Open blocks forever if the handshake fails, so
+	// the subprotocol must be checked before upgrading.
+	if err := checkWebSocketProtocol(req); err != nil {
+		return nil, httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	channels := []wsstream.ChannelType{
+		wsStdinChannel:  wsstream.ReadChannel,
+		wsStdoutChannel: wsstream.WriteChannel,
+		wsStderrChannel: wsstream.WriteChannel,
+		wsErrorChannel:  wsstream.WriteChannel,
+		wsResizeChannel: wsstream.ReadChannel,
+	}
+
+	conn := wsstream.NewConn(wsstream.NewDefaultChannelProtocols(channels))
+	_, rwcs, err := conn.Open(rw, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wsStreams{
+		conn:      conn,
+		stdin:     rwcs[wsStdinChannel],
+		stdout:    rwcs[wsStdoutChannel],
+		stderr:    rwcs[wsStderrChannel],
+		errStream: rwcs[wsErrorChannel],
+		resize:    rwcs[wsResizeChannel],
+	}, nil
+}
+
+// checkWebSocketProtocol checks the requested subprotocols contain one of
+// the supported subprotocols.
+func checkWebSocketProtocol(req *http.Request) error {
+	var requested []string
+	for _, v := range req.Header["Sec-Websocket-Protocol"] {
+		for _, p := range strings.Split(v, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				requested = append(requested, p)
+			}
+		}
+	}
+
+	if len(requested) == 0 {
+		return nil
+	}
+
+	for _, p := range requested {
+		for _, supported := range wsProtocols {
+			if p == supported {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("requested websocket protocols %v are not supported, supports %v", requested, wsProtocols[1:])
+}
+
+// handleResize reads the resize control messages and applies them by
+// resizeFn until the resize channel is closed.
+func (ws *wsStreams) handleResize(resizeFn func(opts types.ResizeOptions) error) {
+	decoder := json.NewDecoder(ws.resize)
+	for {
+		opts := types.ResizeOptions{}
+		if err := decoder.Decode(&opts); err != nil {
+			if err != io.EOF {
+				log.With(nil).Warnf("failed to decode resize message from websocket: %v", err)
+			}
+			return
+		}
+
+		if err := resizeFn(opts); err != nil {
+			log.With(nil).Warnf("failed to resize tty by websocket message %+v: %v", opts, err)
+		}
+	}
+}
+
+// writeError sends the error message to the error channel.
+func (ws *wsStreams) writeError(err error) {
+	ws.errStream.Write([]byte(err.Error()))
+}
+
+// Close closes the websocket connection.
+func (ws *wsStreams) Close() error {
+	return ws.conn.Close()
+}
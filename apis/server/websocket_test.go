@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/streams"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+)
+
+type mockWebSocketAttach struct {
+	mgr.ContainerMgr
+	resized chan types.ResizeOptions
+}
+
+func (m *mockWebSocketAttach) Get(ctx context.Context, name string) (*mgr.Container, error) {
+	if name != "foo" {
+		return nil, errors.New("no such container")
+	}
+	return &mgr.Container{ID: "foo"}, nil
+}
+
+func (m *mockWebSocketAttach) Resize(ctx context.Context, name string, opts types.ResizeOptions) error {
+	m.resized <- opts
+	return nil
+}
+
+func (m *mockWebSocketAttach) AttachContainerIO(ctx context.Context, name string, cfg *streams.AttachConfig) error {
+	cfg.Stderr.Write([]byte("stderr"))
+
+	// echo the stdin to stdout until the stdin closed.
+	data := make([]byte, 32)
+	n, err := cfg.Stdin.Read(data)
+	if err != nil {
+		return err
+	}
+	cfg.Stdout.Write(data[:n])
+	return errors.New("attach done")
+}
+
+func TestAttachContainerWebSocket(t *testing.T) {
+	m := &mockWebSocketAttach{resized: make(chan types.ResizeOptions, 1)}
+	s := &Server{ContainerMgr: m}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/containers/{name:.*}/attach/ws", func(rw http.ResponseWriter, req *http.Request) {
+		if err := s.attachContainerWebSocket(context.Background(), rw, req); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	// plain http request should be rejected before upgrading.
+	resp, err := http.Get(srv.URL + "/containers/foo/attach/ws")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + "/containers/foo/attach/ws?stdin=1"
+	config, err := websocket.NewConfig(wsURL, srv.URL)
+	assert.NoError(t, err)
+	config.Protocol = []string{"channel.k8s.io"}
+
+	conn, err := websocket.DialConfig(config)
+	assert.NoError(t, err)
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	var msg []byte
+	assert.NoError(t, websocket.Message.Receive(conn, &msg))
+	assert.Equal(t, append([]byte{wsStderrChannel}, "stderr"...), msg)
+
+	assert.NoError(t, websocket.Message.Send(conn, append([]byte{wsResizeChannel}, `{"Height":40,"Width":120}`...)))
+	select {
+	case opts := <-m.resized:
+		assert.Equal(t, types.ResizeOptions{Height: 40, Width: 120}, opts)
+	case <-time.After(10 * time.Second):
+		t.Fatal("resize message has not been handled")
+	}
+
+	assert.NoError(t, websocket.Message.Send(conn, append([]byte{wsStdinChannel}, "hello"...)))
+	assert.NoError(t, websocket.Message.Receive(conn, &msg))
+	assert.Equal(t, append([]byte{wsStdoutChannel}, "hello"...), msg)
+
+	assert.NoError(t, websocket.Message.Receive(conn, &msg))
+	assert.Equal(t, append([]byte{wsErrorChannel}, "attach done"...), msg)
+
+	// the connection is closed by server after attach done.
+	_, err = ioutil.ReadAll(conn)
+	assert.NoError(t, err)
+}
+
+func TestCheckWebSocketProtocol(t *testing.T) {
+	for _, tc := range []struct {
+		protocols []string
+		expectErr bool
+	}{
+		{nil, false},
+		{[]string{"channel.k8s.io"}, false},
+		{[]string{"foo, base64.channel.k8s.io"}, false},
+		{[]string{"foo", "bar"}, true},
+	} {
+		req := &http.Request{Header: http.Header{}}
+		for _, p := range tc.protocols {
+			req.Header.Add("Sec-WebSocket-Protocol", p)
+		}
+		err := checkWebSocketProtocol(req)
+		assert.Equal(t, tc.expectErr, err != nil, "protocols %v", tc.protocols)
+	}
+}
@@ -38,6 +38,9 @@ type BlkioStats struct {
 	// io wait time recursive
 	IoWaitTimeRecursive []*BlkioStatEntry `json:"io_wait_time_recursive"`
 
+	// pressure
+	Pressure *PressureStats `json:"pressure,omitempty"`
+
 	// sectors recursive
 	SectorsRecursive []*BlkioStatEntry `json:"sectors_recursive"`
 }
@@ -74,6 +77,10 @@ func (m *BlkioStats) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePressure(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSectorsRecursive(formats); err != nil {
 		res = append(res, err)
 	}
@@ -259,6 +266,24 @@ func (m *BlkioStats) validateIoWaitTimeRecursive(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *BlkioStats) validatePressure(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Pressure) { // not required
+		return nil
+	}
+
+	if m.Pressure != nil {
+		if err := m.Pressure.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("pressure")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *BlkioStats) validateSectorsRecursive(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.SectorsRecursive) { // not required
@@ -28,10 +28,16 @@ type ContainerExecInspect struct {
 	// Required: true
 	DetachKeys string `json:"DetachKeys"`
 
+	// Whether the exec process has exited
+	Exited bool `json:"Exited,omitempty"`
+
 	// The last exit code of this container
 	// Required: true
 	ExitCode int64 `json:"ExitCode"`
 
+	// The time when the exec process exited
+	FinishedAt string `json:"FinishedAt,omitempty"`
+
 	// The ID of this exec
 	// Required: true
 	ID string `json:"ID"`
@@ -55,6 +61,9 @@ type ContainerExecInspect struct {
 	// running
 	// Required: true
 	Running bool `json:"Running"`
+
+	// The time when the exec process started
+	StartedAt string `json:"StartedAt,omitempty"`
 }
 
 // Validate validates this container exec inspect
@@ -18,6 +18,12 @@ import (
 // - `label=key` or `label=key=value`
 // - `network=container-network`
 // - `volume=volume-id`
+// - `ancestor=image`
+// - `before=container` or `since=container`
+// - `exited=exit-code`
+// - `health=health-status`
+// - `publish=port`
+// - `runtime=runtime`
 //
 // swagger:model ContainerListOptions
 type ContainerListOptions struct {
@@ -36,6 +42,9 @@ type ContainerListOptions struct {
 
 	// since
 	Since string `json:"Since,omitempty"`
+
+	// size
+	Size bool `json:"Size,omitempty"`
 }
 
 // Validate validates this container list options
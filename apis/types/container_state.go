@@ -16,6 +16,12 @@ import (
 // swagger:model ContainerState
 type ContainerState struct {
 
+	// Whether this container is crash looping, which means the container keeps exiting
+	// soon after being restarted by the restart policy. It is reset once the container
+	// runs stably.
+	//
+	CrashLooping bool `json:"CrashLooping,omitempty"`
+
 	// Whether this container is dead.
 	// Required: true
 	Dead bool `json:"Dead"`
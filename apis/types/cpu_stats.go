@@ -21,6 +21,9 @@ type CPUStats struct {
 	// onine CPUs
 	OnlineCpus uint32 `json:"online_cpus,omitempty"`
 
+	// pressure
+	Pressure *PressureStats `json:"pressure,omitempty"`
+
 	// System CPU Usage
 	SyetemCPUUsage uint64 `json:"syetem_cpu_usage,omitempty"`
 
@@ -36,6 +39,10 @@ func (m *CPUStats) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePressure(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateThrottlingData(formats); err != nil {
 		res = append(res, err)
 	}
@@ -64,6 +71,24 @@ func (m *CPUStats) validateCPUUsage(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *CPUStats) validatePressure(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Pressure) { // not required
+		return nil
+	}
+
+	if m.Pressure != nil {
+		if err := m.Pressure.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("pressure")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *CPUStats) validateThrottlingData(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ThrottlingData) { // not required
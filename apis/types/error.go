@@ -14,6 +14,9 @@ import (
 // swagger:model Error
 type Error struct {
 
+	// kind of error, such as NotFound, Conflict and InUse
+	Kind string `json:"kind,omitempty"`
+
 	// message
 	Message string `json:"message,omitempty"`
 }
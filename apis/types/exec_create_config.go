@@ -47,6 +47,9 @@ type ExecCreateConfig struct {
 
 	// User that will run the command
 	User string `json:"User,omitempty"`
+
+	// The working directory of exec command, the working directory of container is used if empty
+	WorkingDir string `json:"WorkingDir,omitempty"`
 }
 
 // Validate validates this exec create config
@@ -116,6 +116,9 @@ type HostConfig struct {
 	//
 	PidMode string `json:"PidMode,omitempty"`
 
+	// The name or ID of the pod which the container joins. The container shares the namespaces and cgroup parent of the pod, so the network, IPC and PID mode, port bindings and cgroup parent can't be set.
+	Pod string `json:"Pod,omitempty"`
+
 	// A map of exposed container ports and the host port they should map to.
 	PortBindings PortMap `json:"PortBindings,omitempty"`
 
@@ -147,6 +150,9 @@ type HostConfig struct {
 	// The runtime type used in containerd.
 	RuntimeType string `json:"RuntimeType,omitempty"`
 
+	// The secrets mounted into the container. The secrets are mounted into a tmpfs of the container when it starts.
+	Secrets []*SecretReference `json:"Secrets"`
+
 	// A list of string values to customize labels for MLS systems, such as SELinux.
 	SecurityOpt []string `json:"SecurityOpt"`
 
@@ -231,6 +237,8 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 		PidMode string `json:"PidMode,omitempty"`
 
+		Pod string `json:"Pod,omitempty"`
+
 		PortBindings PortMap `json:"PortBindings,omitempty"`
 
 		Privileged bool `json:"Privileged"`
@@ -251,6 +259,8 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 		RuntimeType string `json:"RuntimeType,omitempty"`
 
+		Secrets []*SecretReference `json:"Secrets"`
+
 		SecurityOpt []string `json:"SecurityOpt"`
 
 		ShmSize *int64 `json:"ShmSize,omitempty"`
@@ -319,6 +329,8 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 	m.PidMode = dataAO0.PidMode
 
+	m.Pod = dataAO0.Pod
+
 	m.PortBindings = dataAO0.PortBindings
 
 	m.Privileged = dataAO0.Privileged
@@ -339,6 +351,8 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 	m.RuntimeType = dataAO0.RuntimeType
 
+	m.Secrets = dataAO0.Secrets
+
 	m.SecurityOpt = dataAO0.SecurityOpt
 
 	m.ShmSize = dataAO0.ShmSize
@@ -418,6 +432,8 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 		PidMode string `json:"PidMode,omitempty"`
 
+		Pod string `json:"Pod,omitempty"`
+
 		PortBindings PortMap `json:"PortBindings,omitempty"`
 
 		Privileged bool `json:"Privileged"`
@@ -438,6 +454,8 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 		RuntimeType string `json:"RuntimeType,omitempty"`
 
+		Secrets []*SecretReference `json:"Secrets"`
+
 		SecurityOpt []string `json:"SecurityOpt"`
 
 		ShmSize *int64 `json:"ShmSize,omitempty"`
@@ -503,6 +521,8 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 	dataAO0.PidMode = m.PidMode
 
+	dataAO0.Pod = m.Pod
+
 	dataAO0.PortBindings = m.PortBindings
 
 	dataAO0.Privileged = m.Privileged
@@ -523,6 +543,8 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 	dataAO0.RuntimeType = m.RuntimeType
 
+	dataAO0.Secrets = m.Secrets
+
 	dataAO0.SecurityOpt = m.SecurityOpt
 
 	dataAO0.ShmSize = m.ShmSize
@@ -588,6 +610,10 @@ func (m *HostConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSecrets(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateShmSize(formats); err != nil {
 		res = append(res, err)
 	}
@@ -770,6 +796,31 @@ func (m *HostConfig) validateRichMode(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HostConfig) validateSecrets(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Secrets) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Secrets); i++ {
+		if swag.IsZero(m.Secrets[i]) { // not required
+			continue
+		}
+
+		if m.Secrets[i] != nil {
+			if err := m.Secrets[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Secrets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *HostConfig) validateShmSize(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ShmSize) { // not required
@@ -6,6 +6,7 @@ package types
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"github.com/go-openapi/errors"
 	strfmt "github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -17,12 +18,20 @@ type MemoryStats struct {
 	// number of times memory usage hits limits.
 	Failcnt uint64 `json:"failcnt,omitempty"`
 
+	// memory usage throttle limit, it is memory.high of cgroup v2.
+	// A "High" of 0 means that there is no throttle limit.
+	//
+	High uint64 `json:"high,omitempty"`
+
 	// xxx
 	Limit uint64 `json:"limit,omitempty"`
 
 	// maximum usage ever recorded.
 	MaxUsage uint64 `json:"max_usage,omitempty"`
 
+	// pressure
+	Pressure *PressureStats `json:"pressure,omitempty"`
+
 	// all the stats exported via memory.stat.
 	Stats map[string]uint64 `json:"stats,omitempty"`
 
@@ -32,6 +41,33 @@ type MemoryStats struct {
 
 // Validate validates this memory stats
 func (m *MemoryStats) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePressure(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MemoryStats) validatePressure(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Pressure) { // not required
+		return nil
+	}
+
+	if m.Pressure != nil {
+		if err := m.Pressure.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("pressure")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
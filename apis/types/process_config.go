@@ -35,6 +35,9 @@ type ProcessConfig struct {
 	// user
 	// Required: true
 	User string `json:"user"`
+
+	// working dir
+	WorkingDir string `json:"workingDir,omitempty"`
 }
 
 // Validate validates this process config
@@ -32,6 +32,9 @@ type SystemVersion struct {
 	// Operating system kernel version
 	KernelVersion string `json:"KernelVersion,omitempty"`
 
+	// The oldest Api Version held by daemon, the requests of older versions are rejected
+	MinAPIVersion string `json:"MinAPIVersion,omitempty"`
+
 	// Operating system type of underlying system
 	Os string `json:"Os,omitempty"`
 
@@ -29,6 +29,10 @@ type Options struct {
 	// save the cache in local. I think we should remove this option
 	// after we remove the cache.
 	PostImageExportFunc func(context.Context, map[string]string) error
+
+	// ContainerRunner runs the build step container. If it is nil, the
+	// build step container will be created by containerd directly.
+	ContainerRunner ContainerRunner
 }
 
 // Server wrappers buildkit to provide builder functionality.
@@ -44,10 +48,6 @@ type Server struct {
 // 1. supports network mode in containerd worker.
 // 2. supports cpu/memory limitation in containerd worker.
 // 3. supports registry cache.
-// 4. use runC/PouchContainer's container mgr to run the container
-//	container for build is not created by PouchContainer's ContainerMgr
-//	and the exit event will be filed to PouchContainer which has no idea
-//	about this. The error log will be annoying.
 func New(opts *Options) (*Server, error) {
 	sessionMgr, err := session.NewManager()
 	if err != nil {
@@ -62,10 +62,16 @@ func New(opts *Options) (*Server, error) {
 	}
 
 	// initialize containerd worker
-	w, err := initializeContainerdWorker(
-		&opts.Config,
-		withWorkerSessionManager(sessionMgr),
-	)
+	workerOpts := []workerOptFunc{withWorkerSessionManager(sessionMgr)}
+	if opts.ContainerRunner != nil {
+		workerOpts = append(workerOpts, withWorkerExecutor(
+			opts.ContainerRunner,
+			filepath.Join(opts.Config.Root, "executor"),
+			opts.Config.ContainerdWorker.DiskQuota,
+		))
+	}
+
+	w, err := initializeContainerdWorker(&opts.Config, workerOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -16,6 +16,9 @@ type Config struct {
 		Address     string
 		Namespace   string
 		Snapshotter string
+
+		// DiskQuota limits the rootfs size of the build step container.
+		DiskQuota string
 	}
 }
 
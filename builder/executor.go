@@ -0,0 +1,140 @@
+package builder
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	buildtypes "github.com/alibaba/pouch/builder/types"
+
+	"github.com/containerd/containerd/contrib/seccomp"
+	containerdoci "github.com/containerd/containerd/oci"
+	"github.com/moby/buildkit/cache"
+	"github.com/moby/buildkit/executor"
+	"github.com/moby/buildkit/executor/oci"
+	"github.com/moby/buildkit/identity"
+	"github.com/moby/buildkit/snapshot"
+	"github.com/moby/buildkit/solver/pb"
+	"github.com/moby/buildkit/util/network"
+	"github.com/moby/buildkit/util/system"
+	"github.com/pkg/errors"
+)
+
+// ContainerRunner runs the container for the build step.
+//
+// It is implemented by PouchContainer's ContainerMgr so that the build step
+// container shares the same runtime, cgroup parent, disk quota, hook plugins
+// and IO handling with the normal container.
+type ContainerRunner interface {
+	RunBuildContainer(ctx context.Context, cfg *buildtypes.BuildContainerConfig) error
+}
+
+// containerExecutor implements the buildkit's executor.Executor interface
+// by ContainerRunner.
+type containerExecutor struct {
+	runner           ContainerRunner
+	root             string
+	diskQuota        string
+	networkProviders map[pb.NetMode]network.Provider
+}
+
+// newContainerExecutor returns executor backed by ContainerRunner.
+func newContainerExecutor(runner ContainerRunner, root string, diskQuota string) executor.Executor {
+	// clean up old hosts/resolv.conf file. ignore errors
+	os.RemoveAll(filepath.Join(root, "hosts"))
+	os.RemoveAll(filepath.Join(root, "resolv.conf"))
+
+	return &containerExecutor{
+		runner:           runner,
+		root:             root,
+		diskQuota:        diskQuota,
+		networkProviders: network.Default(),
+	}
+}
+
+// Exec runs the build step in the container created by ContainerRunner.
+func (w *containerExecutor) Exec(ctx context.Context, meta executor.Meta, root cache.Mountable, mounts []executor.Mount, stdin io.ReadCloser, stdout, stderr io.WriteCloser) error {
+	id := identity.NewID()
+
+	resolvConf, err := oci.GetResolvConf(ctx, w.root)
+	if err != nil {
+		return err
+	}
+
+	hostsFile, clean, err := oci.GetHostsFile(ctx, w.root, meta.ExtraHosts)
+	if err != nil {
+		return err
+	}
+	if clean != nil {
+		defer clean()
+	}
+
+	mountable, err := root.Mount(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer mountable.Release()
+
+	rootMounts, err := mountable.Mount()
+	if err != nil {
+		return err
+	}
+
+	// ContainerMgr creates the container with the mounted rootfs, which
+	// is the same to the container taken over by PouchContainer.
+	lm := snapshot.LocalMounterWithMounts(rootMounts)
+	rootfs, err := lm.Mount()
+	if err != nil {
+		return errors.Wrap(err, "failed to mount rootfs for build container")
+	}
+	defer lm.Unmount()
+
+	uid, gid, sgids, err := parseUser(ctx, rootfs, meta.User)
+	if err != nil {
+		return err
+	}
+
+	provider, ok := w.networkProviders[meta.NetMode]
+	if !ok {
+		return errors.Errorf("unknown network mode %s", meta.NetMode)
+	}
+	namespace, err := provider.New()
+	if err != nil {
+		return err
+	}
+	defer namespace.Close()
+
+	opts := []containerdoci.SpecOpts{oci.WithUIDGID(uid, gid, sgids)}
+	if meta.ReadonlyRootFS {
+		opts = append(opts, containerdoci.WithRootFSReadonly())
+	}
+	if system.SeccompSupported() {
+		opts = append(opts, seccomp.WithDefaultProfile())
+	}
+
+	spec, cleanup, err := oci.GenerateSpec(ctx, meta, mounts, id, resolvConf, hostsFile, namespace, opts...)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	return w.runner.RunBuildContainer(ctx, &buildtypes.BuildContainerConfig{
+		ID:        id,
+		Spec:      spec,
+		RootFS:    rootfs,
+		DiskQuota: w.diskQuota,
+		Stdin:     stdin,
+		Stdout:    stdout,
+		Stderr:    stderr,
+	})
+}
+
+// parseUser returns the uid, gid and additional gids of the user.
+func parseUser(ctx context.Context, rootfs, user string) (uint32, uint32, []uint32, error) {
+	uid, gid, err := oci.ParseUIDGID(user)
+	if err == nil {
+		return uid, gid, nil, nil
+	}
+	return oci.GetUser(ctx, rootfs, user)
+}
@@ -0,0 +1,30 @@
+// Package types defines the types shared by builder and the container
+// manager which runs the build steps, so that builder doesn't depend on the
+// container manager.
+package types
+
+import (
+	"io"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// BuildContainerConfig contains the configuration to run one build step.
+type BuildContainerConfig struct {
+	// ID is the unique identifier of the build step container.
+	ID string
+
+	// Spec is the runtime-spec generated by builder. The root path,
+	// cgroups path and hooks will be filled by ContainerManager.
+	Spec *specs.Spec
+
+	// RootFS is the mounted rootfs of the build step container.
+	RootFS string
+
+	// DiskQuota limits the size of the rootfs if it is not empty.
+	DiskQuota string
+
+	Stdin  io.ReadCloser
+	Stdout io.WriteCloser
+	Stderr io.WriteCloser
+}
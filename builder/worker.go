@@ -23,6 +23,19 @@ func withWorkerSessionManager(mgr *session.Manager) workerOptFunc {
 	}
 }
 
+// withWorkerExecutor replaces the containerd executor with the executor
+// backed by ContainerRunner.
+func withWorkerExecutor(runner ContainerRunner, root, diskQuota string) workerOptFunc {
+	return func(opt *workerOpt) error {
+		if runner == nil {
+			return fmt.Errorf("the container runner is required")
+		}
+
+		opt.Executor = newContainerExecutor(runner, root, diskQuota)
+		return nil
+	}
+}
+
 func initializeContainerdWorker(cfg *Config, opts ...workerOptFunc) (*base.Worker, error) {
 	wopt, err := workerctrd.NewWorkerOpt(
 		cfg.Root,
@@ -0,0 +1,647 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/client"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/spf13/cobra"
+)
+
+// appDescription is used to describe app command in detail and auto generate command doc.
+var appDescription = "Manage a multi-container application declared in a YAML spec. " +
+	"The networks, volumes and containers in the spec are created with the project name " +
+	"as prefix and labeled with the project name, so that they can be reconciled and cleaned up later."
+
+// AppCommand use to implement 'app' command.
+type AppCommand struct {
+	baseCommand
+
+	file    string
+	project string
+}
+
+// Init initializes AppCommand command.
+func (a *AppCommand) Init(c *Cli) {
+	a.cli = c
+	a.cmd = &cobra.Command{
+		Use:   "app [command]",
+		Short: "Manage multi-container applications",
+		Long:  appDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fmt.Errorf("command 'pouch app %s' does not exist.\nPlease execute `pouch app --help` for more help", args[0])
+		},
+	}
+	a.addFlags()
+
+	c.AddCommand(a, &AppUpCommand{app: a})
+	c.AddCommand(a, &AppDownCommand{app: a})
+	c.AddCommand(a, &AppPsCommand{app: a})
+	c.AddCommand(a, &AppLogsCommand{app: a})
+}
+
+// addFlags adds flags for specific command.
+func (a *AppCommand) addFlags() {
+	flagSet := a.cmd.PersistentFlags()
+	flagSet.StringVarP(&a.file, "file", "f", "", "Specify the application spec file (default pouch-app.yml)")
+	flagSet.StringVarP(&a.project, "project-name", "p", "", "Specify the project name (default the name in spec or the directory name of spec file)")
+}
+
+// loadSpec loads the application spec by the flags.
+func (a *AppCommand) loadSpec() (*AppSpec, error) {
+	file := a.file
+	if file == "" {
+		file = defaultAppFile()
+	}
+	return loadAppSpec(file, a.project)
+}
+
+// listProjectContainers returns the containers of the project, keyed by
+// the container name in spec.
+func listProjectContainers(ctx context.Context, apiClient client.CommonAPIClient, project string) (map[string]*types.Container, error) {
+	containers, err := apiClient.ContainerList(ctx, types.ContainerListOptions{
+		All:    true,
+		Filter: map[string][]string{"label": {appProjectLabel + "=" + project}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers of project %s: %v", project, err)
+	}
+
+	result := make(map[string]*types.Container, len(containers))
+	for _, c := range containers {
+		result[c.Labels[appContainerLabel]] = c
+	}
+	return result, nil
+}
+
+// appUpDescription is used to describe app up command in detail and auto generate command doc.
+var appUpDescription = "Reconcile the application to the state declared in spec. " +
+	"The missing networks and volumes are created, the containers whose config changed are recreated, " +
+	"and all the containers are started in the order of their dependencies."
+
+// AppUpCommand use to implement 'app up' command.
+type AppUpCommand struct {
+	baseCommand
+	app *AppCommand
+
+	removeOrphans bool
+	forceRecreate bool
+	noStart       bool
+}
+
+// Init initializes AppUpCommand command.
+func (a *AppUpCommand) Init(c *Cli) {
+	a.cli = c
+	a.cmd = &cobra.Command{
+		Use:   "up [OPTIONS]",
+		Short: "Create and start the application",
+		Long:  appUpDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.runAppUp(args)
+		},
+		Example: appUpExample(),
+	}
+	a.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (a *AppUpCommand) addFlags() {
+	flagSet := a.cmd.Flags()
+	flagSet.BoolVar(&a.removeOrphans, "remove-orphans", false, "Remove containers of the project which are not defined in spec")
+	flagSet.BoolVar(&a.forceRecreate, "force-recreate", false, "Recreate containers even if their config has not changed")
+	flagSet.BoolVar(&a.noStart, "no-start", false, "Create the containers without starting them")
+}
+
+// runAppUp is the entry of AppUpCommand command.
+func (a *AppUpCommand) runAppUp(args []string) error {
+	spec, err := a.app.loadSpec()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	apiClient := a.cli.Client()
+
+	for _, name := range spec.networkNames() {
+		config := spec.networkConfig(name)
+		if _, err := apiClient.NetworkInspect(ctx, config.Name); err == nil {
+			continue
+		} else if !client.IsNotFound(err) {
+			return fmt.Errorf("failed to inspect network %s: %v", config.Name, err)
+		}
+
+		if _, err := apiClient.NetworkCreate(ctx, config); err != nil {
+			return fmt.Errorf("failed to create network %s: %v", config.Name, err)
+		}
+		fmt.Printf("Network %s created\n", config.Name)
+	}
+
+	for _, name := range spec.volumeNames() {
+		config := spec.volumeConfig(name)
+		if _, err := apiClient.VolumeInspect(ctx, config.Name); err == nil {
+			continue
+		} else if !client.IsNotFound(err) {
+			return fmt.Errorf("failed to inspect volume %s: %v", config.Name, err)
+		}
+
+		if _, err := apiClient.VolumeCreate(ctx, config); err != nil {
+			return fmt.Errorf("failed to create volume %s: %v", config.Name, err)
+		}
+		fmt.Printf("Volume %s created\n", config.Name)
+	}
+
+	existing, err := listProjectContainers(ctx, apiClient, spec.Name)
+	if err != nil {
+		return err
+	}
+
+	order, _ := spec.containerOrder()
+	for _, name := range order {
+		if err := a.reconcileContainer(ctx, apiClient, spec, name, existing[name]); err != nil {
+			return err
+		}
+		delete(existing, name)
+	}
+
+	for name, c := range existing {
+		if !a.removeOrphans {
+			fmt.Printf("Found orphan container %s of project %s, use --remove-orphans to remove it\n", name, spec.Name)
+			continue
+		}
+
+		if err := apiClient.ContainerRemove(ctx, c.ID, &types.ContainerRemoveOptions{Force: true}); err != nil {
+			return fmt.Errorf("failed to remove orphan container %s: %v", name, err)
+		}
+		fmt.Printf("Orphan container %s removed\n", name)
+	}
+	return nil
+}
+
+// reconcileContainer makes the container match the spec, the container is
+// recreated if its config hash changed.
+func (a *AppUpCommand) reconcileContainer(ctx context.Context, apiClient client.CommonAPIClient, spec *AppSpec, name string, current *types.Container) error {
+	config, err := spec.containerConfig(name)
+	if err != nil {
+		return err
+	}
+	containerName := spec.resourceName(name)
+
+	if current != nil && !a.forceRecreate && current.Labels[appConfigHashLabel] == config.Labels[appConfigHashLabel] {
+		if a.noStart || current.State == string(types.StatusRunning) {
+			fmt.Printf("Container %s is up-to-date\n", containerName)
+			return nil
+		}
+		return a.startContainer(ctx, apiClient, containerName)
+	}
+
+	if current != nil {
+		if err := apiClient.ContainerRemove(ctx, current.ID, &types.ContainerRemoveOptions{Force: true}); err != nil {
+			return fmt.Errorf("failed to remove container %s to recreate: %v", containerName, err)
+		}
+	}
+
+	if err := pullMissingImage(ctx, apiClient, config.Image, false); err != nil {
+		return err
+	}
+
+	if _, err := apiClient.ContainerCreate(ctx, config.ContainerConfig, config.HostConfig, config.NetworkingConfig, containerName); err != nil {
+		return fmt.Errorf("failed to create container %s: %v", containerName, err)
+	}
+
+	if current != nil {
+		fmt.Printf("Container %s recreated\n", containerName)
+	} else {
+		fmt.Printf("Container %s created\n", containerName)
+	}
+
+	if a.noStart {
+		return nil
+	}
+	return a.startContainer(ctx, apiClient, containerName)
+}
+
+// startContainer starts the container of application.
+func (a *AppUpCommand) startContainer(ctx context.Context, apiClient client.CommonAPIClient, name string) error {
+	if err := apiClient.ContainerStart(ctx, name, types.ContainerStartOptions{}); err != nil {
+		return fmt.Errorf("failed to start container %s: %v", name, err)
+	}
+	fmt.Printf("Container %s started\n", name)
+	return nil
+}
+
+// appUpExample shows examples in app up command, and is used in auto-generated cli docs.
+func appUpExample() string {
+	return `$ cat pouch-app.yml
+name: shop
+containers:
+  web:
+    image: registry.hub.docker.com/library/nginx:alpine
+    ports: ["8080:80"]
+    networks: [front]
+    depends_on: [cache]
+    restart: always
+  cache:
+    image: registry.hub.docker.com/library/redis:alpine
+    volumes: ["data:/data"]
+    networks: [front]
+networks:
+  front:
+volumes:
+  data:
+$ pouch app up
+Network shop_front created
+Volume shop_data created
+Container shop_cache created
+Container shop_cache started
+Container shop_web created
+Container shop_web started`
+}
+
+// AppDownCommand use to implement 'app down' command.
+type AppDownCommand struct {
+	baseCommand
+	app *AppCommand
+
+	volumes bool
+}
+
+// Init initializes AppDownCommand command.
+func (a *AppDownCommand) Init(c *Cli) {
+	a.cli = c
+	a.cmd = &cobra.Command{
+		Use:   "down [OPTIONS]",
+		Short: "Stop and remove the application",
+		Long:  "Stop and remove the containers and networks of the application, the volumes are kept unless --volumes is specified.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.runAppDown(args)
+		},
+		Example: appDownExample(),
+	}
+	a.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (a *AppDownCommand) addFlags() {
+	a.cmd.Flags().BoolVarP(&a.volumes, "volumes", "v", false, "Remove the volumes declared in spec")
+}
+
+// runAppDown is the entry of AppDownCommand command.
+func (a *AppDownCommand) runAppDown(args []string) error {
+	spec, err := a.app.loadSpec()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	apiClient := a.cli.Client()
+
+	existing, err := listProjectContainers(ctx, apiClient, spec.Name)
+	if err != nil {
+		return err
+	}
+
+	// stop the containers in the reverse order of dependencies, and the
+	// orphan ones come first.
+	order, _ := spec.containerOrder()
+	var names []string
+	for name := range existing {
+		if _, ok := spec.Containers[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for i := len(order) - 1; i >= 0; i-- {
+		names = append(names, order[i])
+	}
+
+	for _, name := range names {
+		c, ok := existing[name]
+		if !ok {
+			continue
+		}
+
+		if c.State == string(types.StatusRunning) {
+			if err := apiClient.ContainerStop(ctx, c.ID, ""); err != nil {
+				return fmt.Errorf("failed to stop container %s: %v", c.Names[0], err)
+			}
+		}
+
+		if err := apiClient.ContainerRemove(ctx, c.ID, &types.ContainerRemoveOptions{Force: true}); err != nil {
+			return fmt.Errorf("failed to remove container %s: %v", c.Names[0], err)
+		}
+		fmt.Printf("Container %s removed\n", c.Names[0])
+	}
+
+	for _, name := range spec.networkNames() {
+		netName := spec.resourceName(name)
+		if err := apiClient.NetworkRemove(ctx, netName); err != nil {
+			if client.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to remove network %s: %v", netName, err)
+		}
+		fmt.Printf("Network %s removed\n", netName)
+	}
+
+	if !a.volumes {
+		return nil
+	}
+
+	for _, name := range spec.volumeNames() {
+		volName := spec.resourceName(name)
+		if err := apiClient.VolumeRemove(ctx, volName); err != nil {
+			if client.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to remove volume %s: %v", volName, err)
+		}
+		fmt.Printf("Volume %s removed\n", volName)
+	}
+	return nil
+}
+
+// appDownExample shows examples in app down command, and is used in auto-generated cli docs.
+func appDownExample() string {
+	return `$ pouch app down -v
+Container shop_web removed
+Container shop_cache removed
+Network shop_front removed
+Volume shop_data removed`
+}
+
+// AppPsCommand use to implement 'app ps' command.
+type AppPsCommand struct {
+	baseCommand
+	app *AppCommand
+
+	quiet bool
+}
+
+// Init initializes AppPsCommand command.
+func (a *AppPsCommand) Init(c *Cli) {
+	a.cli = c
+	a.cmd = &cobra.Command{
+		Use:   "ps [OPTIONS]",
+		Short: "List containers of the application",
+		Long:  "List containers of the application, including the stopped ones.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.runAppPs(args)
+		},
+		Example: appPsExample(),
+	}
+	a.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (a *AppPsCommand) addFlags() {
+	a.cmd.Flags().BoolVarP(&a.quiet, "quiet", "q", false, "Only show container IDs")
+}
+
+// runAppPs is the entry of AppPsCommand command.
+func (a *AppPsCommand) runAppPs(args []string) error {
+	spec, err := a.app.loadSpec()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	apiClient := a.cli.Client()
+
+	existing, err := listProjectContainers(ctx, apiClient, spec.Name)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(existing))
+	for name := range existing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if a.quiet {
+		for _, name := range names {
+			fmt.Println(utils.TruncateID(existing[name].ID))
+		}
+		return nil
+	}
+
+	display := a.cli.NewTableDisplay()
+	display.AddRow([]string{"Name", "Container", "ID", "Status", "Image", "Up-to-date"})
+	for _, name := range names {
+		c := existing[name]
+
+		upToDate := "no"
+		if _, ok := spec.Containers[name]; !ok {
+			upToDate = "orphan"
+		} else if config, err := spec.containerConfig(name); err == nil && config.Labels[appConfigHashLabel] == c.Labels[appConfigHashLabel] {
+			upToDate = "yes"
+		}
+
+		display.AddRow([]string{name, strings.TrimPrefix(c.Names[0], "/"), utils.TruncateID(c.ID), c.Status, c.Image, upToDate})
+	}
+	display.Flush()
+	return nil
+}
+
+// appPsExample shows examples in app ps command, and is used in auto-generated cli docs.
+func appPsExample() string {
+	return `$ pouch app ps
+Name    Container    ID       Status         Image                                            Up-to-date
+cache   shop_cache   4d3c2a   Up 5 minutes   registry.hub.docker.com/library/redis:alpine     yes
+web     shop_web     9a8b7c   Up 5 minutes   registry.hub.docker.com/library/nginx:alpine     no`
+}
+
+// AppLogsCommand use to implement 'app logs' command.
+type AppLogsCommand struct {
+	baseCommand
+	app *AppCommand
+
+	follow     bool
+	tail       string
+	timestamps bool
+}
+
+// Init initializes AppLogsCommand command.
+func (a *AppLogsCommand) Init(c *Cli) {
+	a.cli = c
+	a.cmd = &cobra.Command{
+		Use:   "logs [OPTIONS] [CONTAINER...]",
+		Short: "Print logs of the application containers",
+		Long:  "Print logs of the application containers, every line is prefixed by the container name in spec.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.runAppLogs(args)
+		},
+		Example: appLogsExample(),
+	}
+	a.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (a *AppLogsCommand) addFlags() {
+	flagSet := a.cmd.Flags()
+	flagSet.BoolVar(&a.follow, "follow", false, "Follow log output")
+	flagSet.StringVar(&a.tail, "tail", "all", "Number of lines to show from the end of the logs of each container")
+	flagSet.BoolVarP(&a.timestamps, "timestamps", "t", false, "Show timestamps")
+}
+
+// runAppLogs is the entry of AppLogsCommand command.
+func (a *AppLogsCommand) runAppLogs(args []string) error {
+	spec, err := a.app.loadSpec()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	apiClient := a.cli.Client()
+
+	existing, err := listProjectContainers(ctx, apiClient, spec.Name)
+	if err != nil {
+		return err
+	}
+
+	names := args
+	if len(names) == 0 {
+		for name := range existing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	width := 0
+	for _, name := range names {
+		if _, ok := existing[name]; !ok {
+			return fmt.Errorf("container %s of project %s does not exist", name, spec.Name)
+		}
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		errs []string
+	)
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string, c *types.Container) {
+			defer wg.Done()
+
+			if err := a.printLogs(ctx, apiClient, c, fmt.Sprintf("%-*s | ", width, name)); err != nil {
+				lock.Lock()
+				errs = append(errs, fmt.Sprintf("%s: %v", name, err))
+				lock.Unlock()
+			}
+		}(name, existing[name])
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to get logs: \n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// printLogs prints the logs of container with prefix on every line.
+func (a *AppLogsCommand) printLogs(ctx context.Context, apiClient client.CommonAPIClient, c *types.Container, prefix string) error {
+	body, err := apiClient.ContainerLogs(ctx, c.ID, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     a.follow,
+		Tail:       a.tail,
+		Timestamps: a.timestamps,
+	})
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	info, err := apiClient.ContainerGet(ctx, c.ID)
+	if err != nil {
+		return err
+	}
+
+	stdout := newPrefixWriter(os.Stdout, prefix)
+	stderr := newPrefixWriter(os.Stderr, prefix)
+	if info.Config.Tty {
+		_, err = io.Copy(stdout, body)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, body)
+	}
+
+	stdout.Flush()
+	stderr.Flush()
+	return err
+}
+
+// appLogsExample shows examples in app logs command, and is used in auto-generated cli docs.
+func appLogsExample() string {
+	return `$ pouch app logs --tail 1
+cache | 1:M 19 Oct 2026 08:00:00.000 * Ready to accept connections
+web   | 172.18.0.1 - - [19/Oct/2026:08:00:01 +0000] "GET / HTTP/1.1" 200 612`
+}
+
+// outputLock serializes the lines written by prefix writers.
+var outputLock sync.Mutex
+
+// prefixWriter writes the data line by line with a prefix.
+type prefixWriter struct {
+	out    io.Writer
+	prefix string
+	buf    []byte
+}
+
+// newPrefixWriter creates a prefixWriter.
+func newPrefixWriter(out io.Writer, prefix string) *prefixWriter {
+	return &prefixWriter{out: out, prefix: prefix}
+}
+
+// Write implements io.Writer, only the complete lines are written, the
+// rest is kept until the next newline arrives or Flush is called.
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		idx := bytes.IndexByte(w.buf, '\n')
+		if idx < 0 {
+			return len(p), nil
+		}
+
+		if err := w.writeLine(w.buf[:idx+1]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[idx+1:]
+	}
+}
+
+// Flush writes the incomplete line left in buffer.
+func (w *prefixWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	err := w.writeLine(append(w.buf, '\n'))
+	w.buf = nil
+	return err
+}
+
+func (w *prefixWriter) writeLine(line []byte) error {
+	outputLock.Lock()
+	defer outputLock.Unlock()
+
+	_, err := w.out.Write(append([]byte(w.prefix), line...))
+	return err
+}
@@ -0,0 +1,510 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/opts"
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/mattn/go-shellwords"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// appProjectLabel is the label of the project which the resources belong to.
+	appProjectLabel = "pouch.app.project"
+	// appContainerLabel is the label of the container name in the application spec.
+	appContainerLabel = "pouch.app.container"
+	// appConfigHashLabel is the label of the hash of the container's config,
+	// the container will be recreated if the hash changed.
+	appConfigHashLabel = "pouch.app.config-hash"
+)
+
+// appNameRegexp is the valid format of the project name and the names of
+// containers, networks and volumes in application spec.
+var appNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// AppSpec is the declarative spec of a multi-container application.
+type AppSpec struct {
+	// Name is the project name, all the resources created are prefixed
+	// by the project name and labeled with it.
+	Name       string                       `yaml:"name"`
+	Containers map[string]*AppContainerSpec `yaml:"containers"`
+	Networks   map[string]*AppNetworkSpec   `yaml:"networks"`
+	Volumes    map[string]*AppVolumeSpec    `yaml:"volumes"`
+}
+
+// AppContainerSpec is the spec of a container in application.
+type AppContainerSpec struct {
+	Image       string            `yaml:"image"`
+	Command     stringOrSlice     `yaml:"command"`
+	Entrypoint  stringOrSlice     `yaml:"entrypoint"`
+	Environment mapOrSlice        `yaml:"environment"`
+	Labels      mapOrSlice        `yaml:"labels"`
+	WorkingDir  string            `yaml:"working_dir"`
+	User        string            `yaml:"user"`
+	Hostname    string            `yaml:"hostname"`
+	Tty         bool              `yaml:"tty"`
+	StdinOpen   bool              `yaml:"stdin_open"`
+	Privileged  bool              `yaml:"privileged"`
+	Ports       []string          `yaml:"ports"`
+	Volumes     []string          `yaml:"volumes"`
+	Networks    []string          `yaml:"networks"`
+	DependsOn   []string          `yaml:"depends_on"`
+	Restart     string            `yaml:"restart"`
+	Memory      string            `yaml:"memory"`
+	CPUShares   int64             `yaml:"cpu_shares"`
+	Sysctls     map[string]string `yaml:"sysctls"`
+}
+
+// AppNetworkSpec is the spec of a network in application.
+type AppNetworkSpec struct {
+	Driver   string            `yaml:"driver"`
+	Subnet   string            `yaml:"subnet"`
+	Gateway  string            `yaml:"gateway"`
+	Internal bool              `yaml:"internal"`
+	Options  map[string]string `yaml:"options"`
+	Labels   mapOrSlice        `yaml:"labels"`
+}
+
+// AppVolumeSpec is the spec of a volume in application.
+type AppVolumeSpec struct {
+	Driver     string            `yaml:"driver"`
+	DriverOpts map[string]string `yaml:"driver_opts"`
+	Labels     mapOrSlice        `yaml:"labels"`
+}
+
+// stringOrSlice accepts either a string which is split like shell words or
+// a list of strings.
+type stringOrSlice []string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (s *stringOrSlice) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err == nil {
+		words, err := shellwords.Parse(str)
+		if err != nil {
+			return err
+		}
+		*s = words
+		return nil
+	}
+
+	var slice []string
+	if err := unmarshal(&slice); err != nil {
+		return fmt.Errorf("expect a string or a list of strings")
+	}
+	*s = slice
+	return nil
+}
+
+// mapOrSlice accepts either a map or a list of "key=value" strings.
+type mapOrSlice map[string]string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (m *mapOrSlice) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var kv map[string]string
+	if err := unmarshal(&kv); err == nil {
+		*m = kv
+		return nil
+	}
+
+	var slice []string
+	if err := unmarshal(&slice); err != nil {
+		return fmt.Errorf("expect a map or a list of key=value strings")
+	}
+
+	kv = make(map[string]string, len(slice))
+	for _, item := range slice {
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) == 1 {
+			kv[parts[0]] = ""
+		} else {
+			kv[parts[0]] = parts[1]
+		}
+	}
+	*m = kv
+	return nil
+}
+
+// toSlice converts the map into sorted "key=value" strings.
+func (m mapOrSlice) toSlice() []string {
+	slice := make([]string, 0, len(m))
+	for k, v := range m {
+		slice = append(slice, k+"="+v)
+	}
+	sort.Strings(slice)
+	return slice
+}
+
+// loadAppSpec loads the application spec from file, the project name
+// defaults to the name of the directory where the file is.
+func loadAppSpec(file, project string) (*AppSpec, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := parseAppSpec(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse application spec %s: %v", file, err)
+	}
+
+	if project != "" {
+		spec.Name = project
+	}
+	if spec.Name == "" {
+		abs, err := filepath.Abs(file)
+		if err != nil {
+			return nil, err
+		}
+		spec.Name = filepath.Base(filepath.Dir(abs))
+	}
+
+	if err := spec.validate(); err != nil {
+		return nil, fmt.Errorf("invalid application spec %s: %v", file, err)
+	}
+	return spec, nil
+}
+
+// parseAppSpec parses the application spec from yaml data.
+func parseAppSpec(data []byte) (*AppSpec, error) {
+	spec := &AppSpec{}
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// validate checks the application spec.
+func (spec *AppSpec) validate() error {
+	if !appNameRegexp.MatchString(spec.Name) {
+		return fmt.Errorf("invalid project name %q", spec.Name)
+	}
+
+	if len(spec.Containers) == 0 {
+		return fmt.Errorf("no container defined")
+	}
+
+	for name := range spec.Networks {
+		if !appNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid network name %q", name)
+		}
+	}
+
+	for name := range spec.Volumes {
+		if !appNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid volume name %q", name)
+		}
+	}
+
+	for name, c := range spec.Containers {
+		if !appNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid container name %q", name)
+		}
+
+		if c == nil || c.Image == "" {
+			return fmt.Errorf("container %s: image is required", name)
+		}
+
+		for _, dep := range c.DependsOn {
+			if _, ok := spec.Containers[dep]; !ok {
+				return fmt.Errorf("container %s: depends on undefined container %s", name, dep)
+			}
+		}
+
+		for _, n := range c.Networks {
+			if _, ok := spec.Networks[n]; !ok {
+				return fmt.Errorf("container %s: network %s is not defined in networks", name, n)
+			}
+		}
+
+		if _, err := spec.containerConfig(name); err != nil {
+			return fmt.Errorf("container %s: %v", name, err)
+		}
+	}
+
+	_, err := spec.containerOrder()
+	return err
+}
+
+// containerOrder returns the container names sorted by the dependencies,
+// the dependencies come first.
+func (spec *AppSpec) containerOrder() ([]string, error) {
+	names := make([]string, 0, len(spec.Containers))
+	for name := range spec.Containers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var (
+		order = make([]string, 0, len(names))
+		state = make(map[string]int, len(names))
+		visit func(name string, path []string) error
+	)
+
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("circular dependency between containers: %s", strings.Join(append(path, name), " -> "))
+		}
+
+		state[name] = visiting
+		deps := append([]string{}, spec.Containers[name].DependsOn...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		order = append(order, name)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// resourceName returns the name of the resource created in daemon.
+func (spec *AppSpec) resourceName(name string) string {
+	return spec.Name + "_" + name
+}
+
+// projectLabels returns the labels attached to all resources of project.
+func (spec *AppSpec) projectLabels(extra map[string]string) map[string]string {
+	labels := make(map[string]string, len(extra)+1)
+	for k, v := range extra {
+		labels[k] = v
+	}
+	labels[appProjectLabel] = spec.Name
+	return labels
+}
+
+// containerConfig converts the container spec into the create config,
+// the config hash label is set with the hash of the config.
+func (spec *AppSpec) containerConfig(name string) (*types.ContainerCreateConfig, error) {
+	c := spec.Containers[name]
+
+	env, err := opts.ParseEnvs(c.Environment.toSlice())
+	if err != nil {
+		return nil, err
+	}
+
+	restartPolicy, err := opts.ParseRestartPolicy(c.Restart)
+	if err != nil {
+		return nil, err
+	}
+	if err := opts.ValidateRestartPolicy(restartPolicy); err != nil {
+		return nil, err
+	}
+
+	memory, err := opts.ParseMemory(c.Memory)
+	if err != nil {
+		return nil, err
+	}
+
+	portBindings, err := opts.ParsePortBinding(c.Ports)
+	if err != nil {
+		return nil, err
+	}
+	if err := opts.ValidatePortBinding(portBindings); err != nil {
+		return nil, err
+	}
+
+	exposedPorts, err := opts.ParseExposedPorts(c.Ports, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	binds, err := spec.binds(c.Volumes)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := spec.projectLabels(c.Labels)
+	labels[appContainerLabel] = name
+
+	config := &types.ContainerCreateConfig{
+		ContainerConfig: types.ContainerConfig{
+			Image:        c.Image,
+			Cmd:          c.Command,
+			Entrypoint:   c.Entrypoint,
+			Env:          env,
+			Labels:       labels,
+			WorkingDir:   c.WorkingDir,
+			User:         c.User,
+			Hostname:     strfmt.Hostname(c.Hostname),
+			Tty:          c.Tty,
+			OpenStdin:    c.StdinOpen,
+			ExposedPorts: exposedPorts,
+		},
+		HostConfig: &types.HostConfig{
+			Binds:         binds,
+			PortBindings:  portBindings,
+			RestartPolicy: restartPolicy,
+			Privileged:    c.Privileged,
+			Sysctls:       c.Sysctls,
+			Resources: types.Resources{
+				Memory:    memory,
+				CPUShares: c.CPUShares,
+			},
+		},
+		NetworkingConfig: &types.NetworkingConfig{
+			EndpointsConfig: map[string]*types.EndpointSettings{},
+		},
+	}
+
+	// the container can be reached by its name in the spec on all the
+	// networks it joins.
+	for i, n := range c.Networks {
+		netName := spec.resourceName(n)
+		if i == 0 {
+			config.HostConfig.NetworkMode = netName
+		}
+		config.NetworkingConfig.EndpointsConfig[netName] = &types.EndpointSettings{
+			Aliases: []string{name},
+		}
+	}
+
+	hash, err := appConfigHash(config)
+	if err != nil {
+		return nil, err
+	}
+	config.Labels[appConfigHashLabel] = hash
+
+	return config, nil
+}
+
+// binds converts the volumes of container spec into binds, the named
+// volume defined in spec is replaced by the volume created for project.
+func (spec *AppSpec) binds(volumes []string) ([]string, error) {
+	binds := make([]string, 0, len(volumes))
+	for _, v := range volumes {
+		parts := strings.Split(v, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid volume %q, expect source:destination[:mode]", v)
+		}
+
+		if !filepath.IsAbs(parts[0]) {
+			if _, ok := spec.Volumes[parts[0]]; !ok {
+				return nil, fmt.Errorf("volume %s is not defined in volumes", parts[0])
+			}
+			parts[0] = spec.resourceName(parts[0])
+		}
+		binds = append(binds, strings.Join(parts, ":"))
+	}
+	return binds, nil
+}
+
+// appConfigHash returns the hash of container create config.
+func appConfigHash(config *types.ContainerCreateConfig) (string, error) {
+	// json encoding sorts the keys of maps, so the result is stable.
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// networkConfig converts the network spec into the create config.
+func (spec *AppSpec) networkConfig(name string) *types.NetworkCreateConfig {
+	n := spec.Networks[name]
+	if n == nil {
+		n = &AppNetworkSpec{}
+	}
+
+	config := &types.NetworkCreateConfig{
+		Name: spec.resourceName(name),
+		NetworkCreate: types.NetworkCreate{
+			CheckDuplicate: true,
+			Driver:         n.Driver,
+			Internal:       n.Internal,
+			Options:        n.Options,
+			Labels:         spec.projectLabels(n.Labels),
+		},
+	}
+	if config.Driver == "" {
+		config.Driver = "bridge"
+	}
+
+	if n.Subnet != "" || n.Gateway != "" {
+		config.IPAM = &types.IPAM{
+			Config: []types.IPAMConfig{{Subnet: n.Subnet, Gateway: n.Gateway}},
+		}
+	}
+	return config
+}
+
+// volumeConfig converts the volume spec into the create config.
+func (spec *AppSpec) volumeConfig(name string) *types.VolumeCreateConfig {
+	v := spec.Volumes[name]
+	if v == nil {
+		v = &AppVolumeSpec{}
+	}
+
+	config := &types.VolumeCreateConfig{
+		Name:       spec.resourceName(name),
+		Driver:     v.Driver,
+		DriverOpts: v.DriverOpts,
+		Labels:     spec.projectLabels(v.Labels),
+	}
+	if config.Driver == "" {
+		config.Driver = "local"
+	}
+	return config
+}
+
+// networkNames returns the sorted names of networks in spec.
+func (spec *AppSpec) networkNames() []string {
+	names := make([]string, 0, len(spec.Networks))
+	for name := range spec.Networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// volumeNames returns the sorted names of volumes in spec.
+func (spec *AppSpec) volumeNames() []string {
+	names := make([]string, 0, len(spec.Volumes))
+	for name := range spec.Volumes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// defaultAppFile returns the default application spec file.
+func defaultAppFile() string {
+	for _, f := range []string{"pouch-app.yml", "pouch-app.yaml"} {
+		if _, err := os.Stat(f); err == nil {
+			return f
+		}
+	}
+	return "pouch-app.yml"
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testAppSpec = `
+name: shop
+containers:
+  web:
+    image: nginx:alpine
+    command: nginx -g "daemon off;"
+    ports: ["8080:80"]
+    networks: [front, back]
+    depends_on: [api]
+    restart: always
+    environment:
+      MODE: prod
+  api:
+    image: busybox
+    depends_on: [cache]
+    environment: ["A=b", "C"]
+    networks: [back]
+  cache:
+    image: redis:alpine
+    volumes: ["data:/data", "/etc/localtime:/etc/localtime:ro"]
+    networks: [back]
+networks:
+  front:
+  back:
+    subnet: 172.30.0.0/24
+volumes:
+  data:
+`
+
+func TestParseAppSpec(t *testing.T) {
+	spec, err := parseAppSpec([]byte(testAppSpec))
+	assert.NoError(t, err)
+	assert.NoError(t, spec.validate())
+
+	assert.Equal(t, stringOrSlice{"nginx", "-g", "daemon off;"}, spec.Containers["web"].Command)
+	assert.Equal(t, mapOrSlice{"A": "b", "C": ""}, spec.Containers["api"].Environment)
+
+	order, err := spec.containerOrder()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cache", "api", "web"}, order)
+
+	config, err := spec.containerConfig("web")
+	assert.NoError(t, err)
+	assert.Equal(t, "shop_front", config.HostConfig.NetworkMode)
+	assert.Equal(t, 2, len(config.NetworkingConfig.EndpointsConfig))
+	assert.Equal(t, []string{"web"}, config.NetworkingConfig.EndpointsConfig["shop_back"].Aliases)
+	assert.Equal(t, "always", config.HostConfig.RestartPolicy.Name)
+	assert.Equal(t, "shop", config.Labels[appProjectLabel])
+	assert.Equal(t, "web", config.Labels[appContainerLabel])
+	assert.NotEmpty(t, config.Labels[appConfigHashLabel])
+
+	config, err = spec.containerConfig("cache")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"shop_data:/data", "/etc/localtime:/etc/localtime:ro"}, config.HostConfig.Binds)
+
+	network := spec.networkConfig("back")
+	assert.Equal(t, "shop_back", network.Name)
+	assert.Equal(t, "bridge", network.Driver)
+	assert.Equal(t, "172.30.0.0/24", network.IPAM.Config[0].Subnet)
+	assert.Equal(t, "shop", network.Labels[appProjectLabel])
+
+	volume := spec.volumeConfig("data")
+	assert.Equal(t, "shop_data", volume.Name)
+	assert.Equal(t, "local", volume.Driver)
+}
+
+func TestAppConfigHashChanged(t *testing.T) {
+	spec, err := parseAppSpec([]byte(testAppSpec))
+	assert.NoError(t, err)
+
+	before, err := spec.containerConfig("web")
+	assert.NoError(t, err)
+
+	again, err := spec.containerConfig("web")
+	assert.NoError(t, err)
+	assert.Equal(t, before.Labels[appConfigHashLabel], again.Labels[appConfigHashLabel])
+
+	spec.Containers["web"].Environment["MODE"] = "dev"
+	after, err := spec.containerConfig("web")
+	assert.NoError(t, err)
+	assert.NotEqual(t, before.Labels[appConfigHashLabel], after.Labels[appConfigHashLabel])
+}
+
+func TestValidateAppSpec(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		spec string
+		err  string
+	}{
+		{
+			name: "unknown field",
+			spec: "name: a\ncontainers:\n  web:\n    image: busybox\n    imag: x\n",
+			err:  "field imag not found",
+		},
+		{
+			name: "missing image",
+			spec: "name: a\ncontainers:\n  web:\n    tty: true\n",
+			err:  "container web: image is required",
+		},
+		{
+			name: "undefined dependency",
+			spec: "name: a\ncontainers:\n  web:\n    image: busybox\n    depends_on: [db]\n",
+			err:  "container web: depends on undefined container db",
+		},
+		{
+			name: "undefined network",
+			spec: "name: a\ncontainers:\n  web:\n    image: busybox\n    networks: [front]\n",
+			err:  "container web: network front is not defined in networks",
+		},
+		{
+			name: "undefined volume",
+			spec: "name: a\ncontainers:\n  web:\n    image: busybox\n    volumes: [\"data:/data\"]\n",
+			err:  "container web: volume data is not defined in volumes",
+		},
+		{
+			name: "invalid restart policy",
+			spec: "name: a\ncontainers:\n  web:\n    image: busybox\n    restart: sometimes\n",
+			err:  "container web: invalid restart policy: sometimes",
+		},
+		{
+			name: "circular dependency",
+			spec: "name: a\ncontainers:\n  a:\n    image: busybox\n    depends_on: [b]\n  b:\n    image: busybox\n    depends_on: [a]\n",
+			err:  "circular dependency between containers: a -> b -> a",
+		},
+	} {
+		spec, err := parseAppSpec([]byte(tc.spec))
+		if err == nil {
+			err = spec.validate()
+		}
+		if assert.Error(t, err, tc.name) {
+			assert.Contains(t, err.Error(), tc.err, tc.name)
+		}
+	}
+}
+
+func TestPrefixWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	w := newPrefixWriter(out, "web | ")
+
+	w.Write([]byte("hello\nwor"))
+	assert.Equal(t, "web | hello\n", out.String())
+
+	w.Write([]byte("ld\n\nend"))
+	assert.Equal(t, "web | hello\nweb | world\nweb | \n", out.String())
+
+	assert.NoError(t, w.Flush())
+	assert.Equal(t, "web | hello\nweb | world\nweb | \nweb | end\n", out.String())
+}
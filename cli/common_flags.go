@@ -31,7 +31,7 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 	flagSet.Int64Var(&c.cpuquota, "cpu-quota", 0, "Limit CPU CFS (Completely Fair Scheduler) quota, range is in [1000,∞)")
 
 	// device related options
-	flagSet.StringSliceVarP(&c.devices, "device", "", nil, "Add a host device to the container")
+	flagSet.StringSliceVarP(&c.devices, "device", "", nil, "Add a host device or a CDI device (vendor.com/class=name) to the container")
 
 	flagSet.BoolVar(&c.enableLxcfs, "enableLxcfs", false, "Enable lxcfs for the container, only effective when enable-lxcfs switched on in Pouchd")
 	flagSet.StringVar(&c.entrypoint, "entrypoint", "", "Overwrite the default ENTRYPOINT of the image")
@@ -61,6 +61,8 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 	flagSet.Int64Var(&c.oomScoreAdj, "oom-score-adj", -500, "Tune host's OOM preferences (-1000 to 1000)")
 
 	flagSet.StringVar(&c.name, "name", "", "Specify name of container")
+	flagSet.StringArrayVar(&c.secrets, "secret", nil, "Mount a secret into container, in the format name[,target=<path>,uid=<uid>,gid=<gid>,mode=<mode>]")
+	flagSet.StringVar(&c.pod, "pod", "", "Create container in the pod, sharing the network, ipc and cgroup parent of pod")
 	flagSet.StringVar(&c.specificID, "specific-id", "", "Specify id of container, length of id should be 64, characters of id should be in '0123456789abcdef'")
 
 	// network
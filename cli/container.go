@@ -62,6 +62,8 @@ type container struct {
 	pidMode       string
 	utsMode       string
 	sysctls       []string
+	pod           string
+	secrets       []string
 
 	// set network options
 	networks    []string
@@ -145,6 +147,11 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 		return nil, err
 	}
 
+	secrets, err := opts.ParseSecrets(c.secrets)
+	if err != nil {
+		return nil, err
+	}
+
 	sysctls, err := opts.ParseSysctls(c.sysctls)
 	if err != nil {
 		return nil, err
@@ -173,6 +180,10 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 	if err != nil {
 		return nil, err
 	}
+	// container in pod shares the network of pod
+	if c.pod != "" && len(c.networks) == 0 {
+		networkMode = ""
+	}
 
 	if err := opts.SetEndpointIPAddress(networkingConfig, networkMode, c.ip, c.ipv6); err != nil {
 		return nil, err
@@ -277,6 +288,8 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 			IpcMode:         c.ipcMode,
 			PidMode:         c.pidMode,
 			UTSMode:         c.utsMode,
+			Pod:             c.pod,
+			Secrets:         secrets,
 			GroupAdd:        c.groupAdd,
 			Sysctls:         sysctls,
 			SecurityOpt:     c.securityOpt,
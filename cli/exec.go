@@ -32,6 +32,8 @@ type ExecCommand struct {
 	User        string
 	Envs        []string
 	Privileged  bool
+	Workdir     string
+	DetachKeys  string
 }
 
 // Init initializes ExecCommand command.
@@ -48,6 +50,9 @@ func (e *ExecCommand) Init(c *Cli) {
 		Example: execExample(),
 	}
 	e.addFlags()
+
+	c.AddCommand(e, &ExecListCommand{})
+	c.AddCommand(e, &ExecKillCommand{})
 }
 
 // addFlags adds flags for specific command.
@@ -60,6 +65,8 @@ func (e *ExecCommand) addFlags() {
 	flagSet.StringVarP(&e.User, "user", "u", "", "Username or UID (format: <name|uid>[:<group|gid>])")
 	flagSet.StringArrayVarP(&e.Envs, "env", "e", []string{}, "Set environment variables")
 	flagSet.BoolVar(&e.Privileged, "privileged", false, "Give extended privileges to the exec process")
+	flagSet.StringVarP(&e.Workdir, "workdir", "w", "", "Working directory inside the container")
+	flagSet.StringVar(&e.DetachKeys, "detach-keys", "", "Override the key sequence for detaching the exec process")
 }
 
 // runExec is the entry of ExecCommand command.
@@ -81,6 +88,8 @@ func (e *ExecCommand) runExec(args []string) error {
 		Privileged:   e.Privileged,
 		User:         e.User,
 		Env:          e.Envs,
+		WorkingDir:   e.Workdir,
+		DetachKeys:   e.DetachKeys,
 	}
 
 	if err := checkTty(createExecConfig.AttachStdin, createExecConfig.Tty, os.Stdin.Fd()); err != nil {
@@ -117,6 +126,11 @@ func (e *ExecCommand) runExec(args []string) error {
 		return err
 	}
 
+	// detached by the detach keys, the exec process keeps running.
+	if execInfo.Running {
+		return nil
+	}
+
 	code := execInfo.ExitCode
 	if code != 0 {
 		return ExitError{Code: int(code)}
@@ -233,5 +247,8 @@ func execExample() string {
 PID   USER     TIME  COMMAND
     1 root      0:00 /bin/sh
    38 root      0:00 ps
+
+$ pouch exec -w /tmp 25bf50 pwd
+/tmp
 `
 }
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// execListDescription is used to describe exec ls command in detail and auto generate command doc.
+var execListDescription = "List the running and recently exited exec processes of a container, " +
+	"the exited ones are kept until their exit codes have been seen for a while."
+
+// ExecListCommand is used to implement 'exec ls' command.
+type ExecListCommand struct {
+	baseCommand
+
+	flagQuiet   bool
+	flagNoTrunc bool
+	flagFormat  string
+}
+
+// execFormat is the fields of exec process which can be used in the format
+// of exec ls command.
+type execFormat struct {
+	ID         string
+	Command    string
+	User       string
+	WorkingDir string
+	Status     string
+	ExitCode   string
+	StartedAt  string
+	FinishedAt string
+}
+
+// execFormatHeader is the header of the table format of exec ls command.
+var execFormatHeader = map[string]string{
+	"ID":         "EXEC ID",
+	"Command":    "COMMAND",
+	"User":       "USER",
+	"WorkingDir": "WORKDIR",
+	"Status":     "STATUS",
+	"ExitCode":   "EXIT CODE",
+	"StartedAt":  "STARTED AT",
+	"FinishedAt": "FINISHED AT",
+}
+
+// Init initializes ExecListCommand command.
+func (e *ExecListCommand) Init(c *Cli) {
+	e.cli = c
+	e.cmd = &cobra.Command{
+		Use:     "ls [OPTIONS] CONTAINER",
+		Aliases: []string{"list"},
+		Short:   "List exec processes of a container",
+		Long:    execListDescription,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return e.runExecList(args)
+		},
+		Example: execListExample(),
+	}
+	e.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (e *ExecListCommand) addFlags() {
+	flagSet := e.cmd.Flags()
+	flagSet.BoolVarP(&e.flagQuiet, "quiet", "q", false, "Only show exec IDs")
+	flagSet.BoolVar(&e.flagNoTrunc, "no-trunc", false, "Do not truncate output")
+	flagSet.StringVar(&e.flagFormat, "format", "", "Format the output using the given go template, use 'table' directive to output with header, or 'json' to output json")
+}
+
+// runExecList is the entry of ExecListCommand command.
+func (e *ExecListCommand) runExecList(args []string) error {
+	ctx := context.Background()
+	apiClient := e.cli.Client()
+
+	execs, err := apiClient.ContainerExecList(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to list exec processes: %v", err)
+	}
+
+	if e.flagQuiet {
+		for _, ex := range execs {
+			fmt.Println(e.execID(ex.ID))
+		}
+		return nil
+	}
+
+	format := e.flagFormat
+	if format == "" {
+		format = "table {{.ID}}\t{{.Command}}\t{{.Status}}\t{{.StartedAt}}"
+	}
+
+	elements := make([]interface{}, 0, len(execs))
+	for _, ex := range execs {
+		elements = append(elements, e.toExecFormat(ex))
+	}
+	return formatList(os.Stdout, e.cli.padding, format, execFormatHeader, elements)
+}
+
+// execID returns the exec ID to display.
+func (e *ExecListCommand) execID(id string) string {
+	if e.flagNoTrunc {
+		return id
+	}
+	return utils.TruncateID(id)
+}
+
+// toExecFormat converts the exec process to the fields used in output.
+func (e *ExecListCommand) toExecFormat(ex *types.ContainerExecInspect) *execFormat {
+	ef := &execFormat{
+		ID:         e.execID(ex.ID),
+		StartedAt:  formatExecTime(ex.StartedAt),
+		FinishedAt: formatExecTime(ex.FinishedAt),
+	}
+
+	if ex.ProcessConfig != nil {
+		ef.Command = strings.TrimSpace(ex.ProcessConfig.Entrypoint + " " + strings.Join(ex.ProcessConfig.Arguments, " "))
+		ef.User = ex.ProcessConfig.User
+		ef.WorkingDir = ex.ProcessConfig.WorkingDir
+	}
+
+	switch {
+	case ex.Running:
+		ef.Status = "running"
+	case ex.Exited:
+		ef.Status = fmt.Sprintf("exited (%d)", ex.ExitCode)
+		ef.ExitCode = strconv.FormatInt(ex.ExitCode, 10)
+	default:
+		ef.Status = "created"
+	}
+
+	return ef
+}
+
+// formatExecTime formats the time of exec process as the time ago.
+func formatExecTime(t string) string {
+	if t == "" {
+		return ""
+	}
+
+	parsed, err := time.Parse(utils.TimeLayout, t)
+	if err != nil {
+		return t
+	}
+
+	ago, err := utils.FormatTimeInterval(parsed.Unix(), 0)
+	if err != nil {
+		return t
+	}
+	return ago + " ago"
+}
+
+// execListExample shows examples in exec ls command, and is used in auto-generated cli docs.
+func execListExample() string {
+	return `$ pouch exec ls 25bf50
+EXEC ID        COMMAND      STATUS        STARTED AT
+8f1e2b3c4d5a   sleep 1000   running       10 seconds ago
+0a9b8c7d6e5f   ls /nop      exited (1)    1 minute ago`
+}
+
+// execKillDescription is used to describe exec kill command in detail and auto generate command doc.
+var execKillDescription = "Send a signal to the running exec process, SIGKILL is sent by default."
+
+// ExecKillCommand is used to implement 'exec kill' command.
+type ExecKillCommand struct {
+	baseCommand
+
+	signal string
+}
+
+// Init initializes ExecKillCommand command.
+func (e *ExecKillCommand) Init(c *Cli) {
+	e.cli = c
+	e.cmd = &cobra.Command{
+		Use:   "kill [OPTIONS] EXEC_ID [EXEC_ID...]",
+		Short: "Kill one or more running exec processes",
+		Long:  execKillDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return e.runExecKill(args)
+		},
+		Example: execKillExample(),
+	}
+	e.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (e *ExecKillCommand) addFlags() {
+	e.cmd.Flags().StringVarP(&e.signal, "signal", "s", "SIGKILL", "Signal to send to the exec process")
+}
+
+// runExecKill is the entry of ExecKillCommand command.
+func (e *ExecKillCommand) runExecKill(args []string) error {
+	ctx := context.Background()
+	apiClient := e.cli.Client()
+
+	var errs []string
+	for _, id := range args {
+		if err := apiClient.ContainerExecKill(ctx, id, e.signal); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		fmt.Printf("%s\n", id)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to kill exec processes: \n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// execKillExample shows examples in exec kill command, and is used in auto-generated cli docs.
+func execKillExample() string {
+	return `$ pouch exec kill -s SIGTERM 8f1e2b3c4d5a
+8f1e2b3c4d5a`
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alibaba/pouch/pkg/utils/templates"
+)
+
+const (
+	// tableFormatPrefix is the directive of format to output elements in
+	// a table with header, such as `table {{.ID}}\t{{.Name}}`.
+	tableFormatPrefix = "table"
+
+	// jsonFormat is the shortcut of format to output each element as a
+	// json object per line.
+	jsonFormat = "json"
+)
+
+// formatList writes the elements of list command by the go template format.
+//
+// The format starting with `table` outputs the elements in a table, and the
+// header of table is built by executing the template on the header map, so
+// that header should contain the names of all the fields of element. The
+// format `json` outputs each element as a json object per line.
+func formatList(out io.Writer, padding int, format string, header map[string]string, elements []interface{}) error {
+	format = strings.TrimSpace(format)
+
+	if format == jsonFormat {
+		enc := json.NewEncoder(out)
+		enc.SetEscapeHTML(false)
+		for _, e := range elements {
+			if err := enc.Encode(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	isTable := strings.HasPrefix(format, tableFormatPrefix)
+	if isTable {
+		format = strings.TrimSpace(strings.TrimPrefix(format, tableFormatPrefix))
+	}
+
+	// the escaped tab and newline are passed from shell as is.
+	format = strings.NewReplacer(`\t`, "\t", `\n`, "\n").Replace(format)
+
+	tmpl, err := templates.Parse(format)
+	if err != nil {
+		return fmt.Errorf("failed to parse format %q: %v", format, err)
+	}
+	tmpl = tmpl.Option("missingkey=zero")
+
+	w := out
+	if isTable {
+		tw := tabwriter.NewWriter(out, 0, 0, padding, ' ', 0)
+		defer tw.Flush()
+		w = tw
+
+		if err := executeFormat(w, tmpl.Execute, header); err != nil {
+			return err
+		}
+	}
+
+	for _, e := range elements {
+		if err := executeFormat(w, tmpl.Execute, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeFormat executes the template on data and writes it as a line.
+func executeFormat(w io.Writer, execute func(io.Writer, interface{}) error, data interface{}) error {
+	buf := bytes.NewBuffer(nil)
+	if err := execute(buf, data); err != nil {
+		return fmt.Errorf("failed to execute format: %v", err)
+	}
+
+	buf.WriteString("\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type formatElement struct {
+	ID     string
+	Name   string
+	Labels map[string]string
+}
+
+func TestFormatList(t *testing.T) {
+	header := map[string]string{
+		"ID":     "ID",
+		"Name":   "NAME",
+		"Labels": "LABELS",
+	}
+	elements := []interface{}{
+		formatElement{ID: "a1", Name: "foo", Labels: map[string]string{"k": "v"}},
+		formatElement{ID: "b2", Name: "barbaz"},
+	}
+
+	for _, tc := range []struct {
+		format   string
+		expected string
+	}{
+		{
+			format:   "{{.ID}}: {{.Name}}",
+			expected: "a1: foo\nb2: barbaz\n",
+		},
+		{
+			format:   `table {{.ID}}\t{{.Name}}`,
+			expected: "ID   NAME\na1   foo\nb2   barbaz\n",
+		},
+		{
+			format:   "table {{.Name}}\t{{.ID}}",
+			expected: "NAME     ID\nfoo      a1\nbarbaz   b2\n",
+		},
+		{
+			format:   `{{index .Labels "k"}}`,
+			expected: "v\n\n",
+		},
+		{
+			format:   "json",
+			expected: "{\"ID\":\"a1\",\"Name\":\"foo\",\"Labels\":{\"k\":\"v\"}}\n{\"ID\":\"b2\",\"Name\":\"barbaz\",\"Labels\":null}\n",
+		},
+	} {
+		out := bytes.NewBuffer(nil)
+		assert.NoError(t, formatList(out, 3, tc.format, header, elements), tc.format)
+		assert.Equal(t, tc.expected, out.String(), tc.format)
+	}
+
+	assert.Error(t, formatList(bytes.NewBuffer(nil), 3, "{{.ID", header, elements))
+	assert.Error(t, formatList(bytes.NewBuffer(nil), 3, "{{.Unknown}}", header, elements))
+}
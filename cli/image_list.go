@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/alibaba/pouch/apis/filters"
 	"github.com/alibaba/pouch/apis/types"
@@ -40,6 +41,24 @@ type ImagesCommand struct {
 	flagDigest  bool
 	flagNoTrunc bool
 	flagFilter  []string
+	flagFormat  string
+}
+
+// imageFormat is the fields of image which can be used in the format of
+// images command.
+type imageFormat struct {
+	ID     string
+	Name   string
+	Digest string
+	Size   string
+}
+
+// imageFormatHeader is the header of the table format of images command.
+var imageFormatHeader = map[string]string{
+	"ID":     "IMAGE ID",
+	"Name":   "IMAGE NAME",
+	"Digest": "DIGEST",
+	"Size":   "SIZE",
 }
 
 // Init initialize images command.
@@ -66,6 +85,7 @@ func (i *ImagesCommand) addFlags() {
 	flagSet.BoolVar(&i.flagDigest, "digest", false, "Show images with digest")
 	flagSet.BoolVar(&i.flagNoTrunc, "no-trunc", false, "Do not truncate output")
 	flagSet.StringSliceVarP(&i.flagFilter, "filter", "f", []string{}, "Filter output based on conditions provided, filter support reference, since, before")
+	flagSet.StringVar(&i.flagFormat, "format", "", "Format the output using the given go template, use 'table' directive to output with header, or 'json' to output json")
 }
 
 // runImages is the entry of images container command.
@@ -94,6 +114,21 @@ func (i *ImagesCommand) runImages(args []string) error {
 		return nil
 	}
 
+	if i.flagFormat != "" {
+		elements := []interface{}{}
+		for _, img := range imageList {
+			for _, dimg := range imageInfoToDisplayImages(img, i.flagNoTrunc) {
+				elements = append(elements, imageFormat{
+					ID:     dimg.id,
+					Name:   dimg.name,
+					Digest: dimg.digest,
+					Size:   dimg.size.String(),
+				})
+			}
+		}
+		return formatList(os.Stdout, i.cli.padding, i.flagFormat, imageFormatHeader, elements)
+	}
+
 	display := i.cli.NewTableDisplay()
 	if i.flagDigest {
 		display.AddRow([]string{"IMAGE ID", "IMAGE NAME", "DIGEST", "SIZE"})
@@ -208,6 +243,10 @@ IMAGE ID       IMAGE NAME                                           DIGEST
 2cb0d9787c4d   registry.hub.docker.com/library/hello-world:latest   sha256:4b8ff392a12ed9ea17784bd3c9a8b1fa3299cac44aca35a85c90c5e3c7afacdc   6.30 KB
 4ab4c602aa5e   registry.hub.docker.com/library/hello-world:linux    sha256:d5c7d767f5ba807f9b363aa4db87d75ab030404a670880e16aedff16f605484b   5.25 KB
 
+$ pouch images --format "{{.Name}} {{.Size}}"
+docker.io/library/busybox:latest 703.14 KB
+docker.io/library/nginx:latest 42.39 MB
+
 $ pouch images --no-trunc
 IMAGE ID                                                                  IMAGE NAME                                           SIZE
 sha256:2cb0d9787c4dd17ef9eb03e512923bc4db10add190d3f84af63b744e353a9b34   registry.hub.docker.com/library/hello-world:latest   6.30 KB
@@ -58,6 +58,10 @@ func main() {
 	cli.AddCommand(base, &BuildCommand{})
 	cli.AddCommand(base, &CopyCommand{})
 	cli.AddCommand(base, &PortCommand{})
+	cli.AddCommand(base, &AppCommand{})
+	cli.AddCommand(base, &PodCommand{})
+	cli.AddCommand(base, &SecretCommand{})
+	cli.AddCommand(base, &SystemCommand{})
 
 	// add generate doc command
 	cli.AddCommand(base, &GenDocCommand{})
@@ -317,6 +317,25 @@ var networkListDescription = "List networks in pouchd. " +
 // NetworkListCommand is used to implement 'network list' command.
 type NetworkListCommand struct {
 	baseCommand
+
+	format string
+}
+
+// networkFormat is the fields of network which can be used in the format of
+// network list command.
+type networkFormat struct {
+	ID     string
+	Name   string
+	Driver string
+	Scope  string
+}
+
+// networkFormatHeader is the header of the table format of network list command.
+var networkFormatHeader = map[string]string{
+	"ID":     "NETWORK ID",
+	"Name":   "NAME",
+	"Driver": "DRIVER",
+	"Scope":  "SCOPE",
 }
 
 // Init initializes NetworkListCommand command.
@@ -340,7 +359,7 @@ func (n *NetworkListCommand) Init(c *Cli) {
 
 // addFlags adds flags for specific command.
 func (n *NetworkListCommand) addFlags() {
-	//TODO add flags
+	n.cmd.Flags().StringVar(&n.format, "format", "", "Format the output using the given go template, use 'table' directive to output with header, or 'json' to output json")
 }
 
 // runNetworkList is the entry of NetworkListCommand command.
@@ -354,6 +373,19 @@ func (n *NetworkListCommand) runNetworkList(args []string) error {
 		return err
 	}
 
+	if n.format != "" {
+		elements := make([]interface{}, 0, len(respNetworkResource))
+		for _, network := range respNetworkResource {
+			elements = append(elements, networkFormat{
+				ID:     network.ID[:10],
+				Name:   network.Name,
+				Driver: network.Driver,
+				Scope:  network.Scope,
+			})
+		}
+		return formatList(os.Stdout, n.cli.padding, n.format, networkFormatHeader, elements)
+	}
+
 	display := n.cli.NewTableDisplay()
 	display.AddRow([]string{"NETWORK ID", "NAME", "DRIVER", "SCOPE"})
 	for _, network := range respNetworkResource {
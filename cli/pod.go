@@ -0,0 +1,448 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/opts"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/cli/inspect"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// podDescription defines the pod command description and auto generate command doc.
+var podDescription = "Manage the pods in pouchd. " +
+	"A pod is a group of containers sharing the network, ipc and optionally pid namespaces held by an infra container, " +
+	"the containers in pod are placed under the cgroup parent of pod, and the port mappings are set on pod."
+
+// PodCommand is used to implement 'pod' command.
+type PodCommand struct {
+	baseCommand
+}
+
+// Init initializes PodCommand command.
+func (p *PodCommand) Init(c *Cli) {
+	p.cli = c
+
+	p.cmd = &cobra.Command{
+		Use:   "pod [command]",
+		Short: "Manage pouch pods",
+		Long:  podDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fmt.Errorf("command 'pouch pod %s' does not exist.\nPlease execute `pouch pod --help` for more help", args[0])
+		},
+	}
+
+	c.AddCommand(p, &PodCreateCommand{})
+	c.AddCommand(p, &PodStartCommand{})
+	c.AddCommand(p, &PodStopCommand{})
+	c.AddCommand(p, &PodRemoveCommand{})
+	c.AddCommand(p, &PodListCommand{})
+	c.AddCommand(p, &PodInspectCommand{})
+}
+
+// podCreateDescription is used to describe pod create command in detail and auto generate command doc.
+var podCreateDescription = "Create a pod with its infra container. " +
+	"Containers are created in the pod by 'pouch create --pod' or 'pouch run --pod'."
+
+// PodCreateCommand is used to implement 'pod create' command.
+type PodCreateCommand struct {
+	baseCommand
+
+	name         string
+	hostname     string
+	infraImage   string
+	cgroupParent string
+	network      string
+	sharePID     bool
+	ports        []string
+	labels       []string
+}
+
+// Init initializes PodCreateCommand command.
+func (p *PodCreateCommand) Init(c *Cli) {
+	p.cli = c
+
+	p.cmd = &cobra.Command{
+		Use:   "create [OPTIONS]",
+		Short: "Create a pod",
+		Long:  podCreateDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return p.runPodCreate(args)
+		},
+		Example: podCreateExample(),
+	}
+
+	p.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (p *PodCreateCommand) addFlags() {
+	flagSet := p.cmd.Flags()
+
+	flagSet.StringVar(&p.name, "name", "", "Specify name of pod")
+	flagSet.StringVar(&p.hostname, "hostname", "", "Set hostname of pod, the name of pod by default")
+	flagSet.StringVar(&p.infraImage, "infra-image", "", "Set image of the infra container, the sandbox image of daemon by default")
+	flagSet.StringVar(&p.cgroupParent, "cgroup-parent", "", "Set cgroup parent of pod, the containers in pod are placed under it")
+	flagSet.StringVar(&p.network, "net", "", "Set network of pod, bridge by default")
+	flagSet.BoolVar(&p.sharePID, "share-pid", false, "Share pid namespace between the containers in pod")
+	flagSet.StringSliceVarP(&p.ports, "publish", "p", nil, "Set pod ports mapping")
+	flagSet.StringSliceVarP(&p.labels, "label", "l", nil, "Set labels for pod")
+}
+
+// runPodCreate is the entry of PodCreateCommand command.
+func (p *PodCreateCommand) runPodCreate(args []string) error {
+	portBindings, err := opts.ParsePortBinding(p.ports)
+	if err != nil {
+		return err
+	}
+	if err := opts.ValidatePortBinding(portBindings); err != nil {
+		return err
+	}
+
+	config := &types.PodCreateConfig{
+		Name:         p.name,
+		Hostname:     p.hostname,
+		InfraImage:   p.infraImage,
+		CgroupParent: p.cgroupParent,
+		NetworkMode:  p.network,
+		SharePID:     p.sharePID,
+		PortBindings: portBindings,
+		Labels:       opts.ParseLabels(p.labels),
+	}
+
+	ctx := context.Background()
+	apiClient := p.cli.Client()
+	resp, err := apiClient.PodCreate(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s: %s\n", resp.Name, resp.ID)
+	return nil
+}
+
+// podCreateExample shows examples in pod create command, and is used in auto-generated cli docs.
+func podCreateExample() string {
+	return `$ pouch pod create --name web -p 8080:80 --share-pid
+web: 3d3c8d2e6a73b1a8e4d4ee7d87d6c6f9b2a5e5cbb0fda6b8a1fd3f0e2d6a9a77
+$ pouch run -d --pod web --name nginx nginx:alpine
+$ pouch run -d --pod web --name sidecar busybox top`
+}
+
+// podStartDescription is used to describe pod start command in detail and auto generate command doc.
+var podStartDescription = "Start one or more pods, the infra container is started first and then the containers in pod."
+
+// PodStartCommand is used to implement 'pod start' command.
+type PodStartCommand struct {
+	baseCommand
+}
+
+// Init initializes PodStartCommand command.
+func (p *PodStartCommand) Init(c *Cli) {
+	p.cli = c
+
+	p.cmd = &cobra.Command{
+		Use:   "start POD [POD...]",
+		Short: "Start one or more pods",
+		Long:  podStartDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return p.runPodStart(args)
+		},
+		Example: podStartExample(),
+	}
+}
+
+// runPodStart is the entry of PodStartCommand command.
+func (p *PodStartCommand) runPodStart(args []string) error {
+	ctx := context.Background()
+	apiClient := p.cli.Client()
+
+	var errs []string
+	for _, name := range args {
+		if err := apiClient.PodStart(ctx, name); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		fmt.Printf("%s\n", name)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to start pods: \n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// podStartExample shows examples in pod start command, and is used in auto-generated cli docs.
+func podStartExample() string {
+	return `$ pouch pod start web
+web`
+}
+
+// podStopDescription is used to describe pod stop command in detail and auto generate command doc.
+var podStopDescription = "Stop one or more pods, the containers in pod are stopped first and then the infra container."
+
+// PodStopCommand is used to implement 'pod stop' command.
+type PodStopCommand struct {
+	baseCommand
+
+	timeout int
+}
+
+// Init initializes PodStopCommand command.
+func (p *PodStopCommand) Init(c *Cli) {
+	p.cli = c
+
+	p.cmd = &cobra.Command{
+		Use:   "stop [OPTIONS] POD [POD...]",
+		Short: "Stop one or more pods",
+		Long:  podStopDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return p.runPodStop(args)
+		},
+		Example: podStopExample(),
+	}
+
+	p.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (p *PodStopCommand) addFlags() {
+	p.cmd.Flags().IntVarP(&p.timeout, "time", "t", 10, "Seconds to wait for stop before killing the containers")
+}
+
+// runPodStop is the entry of PodStopCommand command.
+func (p *PodStopCommand) runPodStop(args []string) error {
+	ctx := context.Background()
+	apiClient := p.cli.Client()
+
+	var errs []string
+	for _, name := range args {
+		if err := apiClient.PodStop(ctx, name, strconv.Itoa(p.timeout)); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		fmt.Printf("%s\n", name)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to stop pods: \n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// podStopExample shows examples in pod stop command, and is used in auto-generated cli docs.
+func podStopExample() string {
+	return `$ pouch pod stop -t 5 web
+web`
+}
+
+// podRemoveDescription is used to describe pod remove command in detail and auto generate command doc.
+var podRemoveDescription = "Remove one or more pods with their infra containers. " +
+	"A pod which is running or has containers can only be removed with --force, which removes the containers in pod as well."
+
+// PodRemoveCommand is used to implement 'pod remove' command.
+type PodRemoveCommand struct {
+	baseCommand
+
+	force bool
+}
+
+// Init initializes PodRemoveCommand command.
+func (p *PodRemoveCommand) Init(c *Cli) {
+	p.cli = c
+
+	p.cmd = &cobra.Command{
+		Use:     "remove [OPTIONS] POD [POD...]",
+		Aliases: []string{"rm"},
+		Short:   "Remove one or more pods",
+		Long:    podRemoveDescription,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return p.runPodRemove(args)
+		},
+		Example: podRemoveExample(),
+	}
+
+	p.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (p *PodRemoveCommand) addFlags() {
+	p.cmd.Flags().BoolVarP(&p.force, "force", "f", false, "Force to remove the running pod with its containers")
+}
+
+// runPodRemove is the entry of PodRemoveCommand command.
+func (p *PodRemoveCommand) runPodRemove(args []string) error {
+	ctx := context.Background()
+	apiClient := p.cli.Client()
+
+	var errs []string
+	for _, name := range args {
+		if err := apiClient.PodRemove(ctx, name, p.force); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		fmt.Printf("%s\n", name)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to remove pods: \n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// podRemoveExample shows examples in pod remove command, and is used in auto-generated cli docs.
+func podRemoveExample() string {
+	return `$ pouch pod rm -f web
+web`
+}
+
+// podListDescription is used to describe pod list command in detail and auto generate command doc.
+var podListDescription = "List the pods in pouchd, with the state of infra container and the number of containers in pod."
+
+// PodListCommand is used to implement 'pod list' command.
+type PodListCommand struct {
+	baseCommand
+
+	quiet bool
+}
+
+// Init initializes PodListCommand command.
+func (p *PodListCommand) Init(c *Cli) {
+	p.cli = c
+
+	p.cmd = &cobra.Command{
+		Use:     "list [OPTIONS]",
+		Aliases: []string{"ls"},
+		Short:   "List pods",
+		Long:    podListDescription,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return p.runPodList(args)
+		},
+		Example: podListExample(),
+	}
+
+	p.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (p *PodListCommand) addFlags() {
+	p.cmd.Flags().BoolVarP(&p.quiet, "quiet", "q", false, "Only show pod names")
+}
+
+// runPodList is the entry of PodListCommand command.
+func (p *PodListCommand) runPodList(args []string) error {
+	ctx := context.Background()
+	apiClient := p.cli.Client()
+
+	pods, err := apiClient.PodList(ctx)
+	if err != nil {
+		return err
+	}
+
+	if p.quiet {
+		for _, pod := range pods {
+			fmt.Println(pod.Name)
+		}
+		return nil
+	}
+
+	display := p.cli.NewTableDisplay()
+	display.AddRow([]string{"POD ID", "NAME", "STATUS", "CONTAINERS", "CGROUP PARENT", "CREATED"})
+	for _, pod := range pods {
+		display.AddRow([]string{
+			utils.TruncateID(pod.ID),
+			pod.Name,
+			pod.State,
+			strconv.Itoa(len(pod.Containers)),
+			pod.CgroupParent,
+			formatCreatedTime(pod.Created),
+		})
+	}
+	display.Flush()
+	return nil
+}
+
+// formatCreatedTime formats the created time of pod or secret into the interval from now.
+func formatCreatedTime(created string) string {
+	t, err := time.Parse(utils.TimeLayout, created)
+	if err != nil {
+		return created
+	}
+
+	interval, err := utils.FormatTimeInterval(t.Unix(), 0)
+	if err != nil {
+		return created
+	}
+	return interval + " ago"
+}
+
+// podListExample shows examples in pod list command, and is used in auto-generated cli docs.
+func podListExample() string {
+	return `$ pouch pod ls
+POD ID         NAME   STATUS    CONTAINERS   CGROUP PARENT                                                                   CREATED
+3d3c8d2e6a73   web    running   2            /default/pod-3d3c8d2e6a73b1a8e4d4ee7d87d6c6f9b2a5e5cbb0fda6b8a1fd3f0e2d6a9a77   2 minutes ago`
+}
+
+// podInspectDescription is used to describe pod inspect command in detail and auto generate command doc.
+var podInspectDescription = "Return detailed information of pods, including the containers in pod."
+
+// PodInspectCommand is used to implement 'pod inspect' command.
+type PodInspectCommand struct {
+	baseCommand
+
+	format string
+}
+
+// Init initializes PodInspectCommand command.
+func (p *PodInspectCommand) Init(c *Cli) {
+	p.cli = c
+
+	p.cmd = &cobra.Command{
+		Use:   "inspect [OPTIONS] POD [POD...]",
+		Short: "Get detailed information about one or more pods",
+		Long:  podInspectDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return p.runPodInspect(args)
+		},
+		Example: podInspectExample(),
+	}
+
+	p.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (p *PodInspectCommand) addFlags() {
+	p.cmd.Flags().StringVarP(&p.format, "format", "f", "", "Format the output using the given go template")
+}
+
+// runPodInspect is the entry of PodInspectCommand command.
+func (p *PodInspectCommand) runPodInspect(args []string) error {
+	ctx := context.Background()
+	apiClient := p.cli.Client()
+
+	getRefFunc := func(ref string) (interface{}, error) {
+		return apiClient.PodInspect(ctx, ref)
+	}
+
+	return inspect.Inspect(os.Stdout, args, p.format, getRefFunc)
+}
+
+// podInspectExample shows examples in pod inspect command, and is used in auto-generated cli docs.
+func podInspectExample() string {
+	return `$ pouch pod inspect -f "{{.InfraContainerID}}" web
+8a4c2b8e4de6f0d3b1c1b2c55a8a0c7f5ea8d6f3e2b91c07a5b5d2a1c0f9e3d4`
+}
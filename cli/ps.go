@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/alibaba/pouch/apis/types"
@@ -28,6 +30,43 @@ type PsCommand struct {
 	flagQuiet   bool
 	flagNoTrunc bool
 	flagFilter  []string
+	flagFormat  string
+	flagSize    bool
+}
+
+// containerFormat is the fields of container which can be used in the
+// format of ps command.
+type containerFormat struct {
+	ID        string
+	Name      string
+	Image     string
+	Command   string
+	Status    string
+	Created   string
+	CreatedAt string
+	Runtime   string
+	Ports     string
+	Networks  string
+	Mounts    string
+	Labels    map[string]string
+	Size      string
+}
+
+// containerFormatHeader is the header of the table format of ps command.
+var containerFormatHeader = map[string]string{
+	"ID":        "ID",
+	"Name":      "Name",
+	"Image":     "Image",
+	"Command":   "Command",
+	"Status":    "Status",
+	"Created":   "Created",
+	"CreatedAt": "Created At",
+	"Runtime":   "Runtime",
+	"Ports":     "Ports",
+	"Networks":  "Networks",
+	"Mounts":    "Mounts",
+	"Labels":    "Labels",
+	"Size":      "Size",
 }
 
 // Init initializes PsCommand command.
@@ -52,7 +91,9 @@ func (p *PsCommand) addFlags() {
 	flagSet.BoolVarP(&p.flagAll, "all", "a", false, "Show all containers (default shows just running)")
 	flagSet.BoolVarP(&p.flagQuiet, "quiet", "q", false, "Only show numeric IDs")
 	flagSet.BoolVar(&p.flagNoTrunc, "no-trunc", false, "Do not truncate output")
-	flagSet.StringSliceVarP(&p.flagFilter, "filter", "f", nil, "Filter output based on given conditions, support filter key [ id label name status ]")
+	flagSet.StringSliceVarP(&p.flagFilter, "filter", "f", nil, "Filter output based on given conditions, support filter key [ id label name status ancestor before since exited volume network health publish runtime ]")
+	flagSet.StringVar(&p.flagFormat, "format", "", "Format the output using the given go template, use 'table' directive to output with header, or 'json' to output json")
+	flagSet.BoolVarP(&p.flagSize, "size", "s", false, "Display total file sizes")
 }
 
 // runPs is the entry of PsCommand command.
@@ -70,6 +111,7 @@ func (p *PsCommand) runPs(args []string) error {
 	option := types.ContainerListOptions{
 		All:    p.flagAll,
 		Filter: filter,
+		Size:   p.flagSize || strings.Contains(p.flagFormat, ".Size"),
 	}
 	containers, err = apiClient.ContainerList(ctx, option)
 	if err != nil {
@@ -89,26 +131,110 @@ func (p *PsCommand) runPs(args []string) error {
 		return nil
 	}
 
+	if p.flagFormat != "" {
+		elements := make([]interface{}, 0, len(containers))
+		for _, c := range containers {
+			cf, err := p.toContainerFormat(c)
+			if err != nil {
+				return err
+			}
+			elements = append(elements, cf)
+		}
+		return formatList(os.Stdout, p.cli.padding, p.flagFormat, containerFormatHeader, elements)
+	}
+
 	display := p.cli.NewTableDisplay()
-	display.AddRow([]string{"Name", "ID", "Status", "Created", "Image", "Runtime"})
+	header := []string{"Name", "ID", "Status", "Created", "Image", "Runtime"}
+	if p.flagSize {
+		header = append(header, "Size")
+	}
+	display.AddRow(header)
 
 	for _, c := range containers {
-		created, err := utils.FormatTimeInterval(c.Created, 0)
+		cf, err := p.toContainerFormat(c)
 		if err != nil {
 			return err
 		}
 
-		id := c.ID[:6]
-		if p.flagNoTrunc {
-			id = c.ID
+		row := []string{cf.Name, cf.ID, cf.Status, cf.Created, cf.Image, cf.Runtime}
+		if p.flagSize {
+			row = append(row, cf.Size)
 		}
-
-		display.AddRow([]string{c.Names[0], id, c.Status, created + " ago", c.Image, c.HostConfig.Runtime})
+		display.AddRow(row)
 	}
 	display.Flush()
 	return nil
 }
 
+// toContainerFormat converts the container to the fields used in output.
+func (p *PsCommand) toContainerFormat(c *types.Container) (*containerFormat, error) {
+	created, err := utils.FormatTimeInterval(c.Created, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	id := c.ID[:6]
+	if p.flagNoTrunc {
+		id = c.ID
+	}
+
+	cf := &containerFormat{
+		ID:        id,
+		Image:     c.Image,
+		Command:   c.Command,
+		Status:    c.Status,
+		Created:   created + " ago",
+		CreatedAt: time.Unix(c.Created, 0).Format(time.RFC3339),
+		Labels:    c.Labels,
+	}
+
+	if len(c.Names) > 0 {
+		cf.Name = c.Names[0]
+	}
+
+	if c.HostConfig != nil {
+		cf.Runtime = c.HostConfig.Runtime
+
+		var ports []string
+		for port, bindings := range c.HostConfig.PortBindings {
+			for _, b := range bindings {
+				hostIP := b.HostIP
+				if hostIP == "" {
+					hostIP = "0.0.0.0"
+				}
+				ports = append(ports, fmt.Sprintf("%s:%s->%s", hostIP, b.HostPort, port))
+			}
+		}
+		sort.Strings(ports)
+		cf.Ports = strings.Join(ports, ", ")
+	}
+
+	if c.NetworkSettings != nil {
+		var networks []string
+		for name := range c.NetworkSettings.Networks {
+			networks = append(networks, name)
+		}
+		sort.Strings(networks)
+		cf.Networks = strings.Join(networks, ",")
+	}
+
+	var mounts []string
+	for _, m := range c.Mounts {
+		if m.Name != "" {
+			mounts = append(mounts, m.Name)
+		} else {
+			mounts = append(mounts, m.Source)
+		}
+	}
+	cf.Mounts = strings.Join(mounts, ",")
+
+	if p.flagSize || strings.Contains(p.flagFormat, ".Size") {
+		cf.Size = fmt.Sprintf("%s (virtual %s)", utils.FormatSize(c.SizeRw), utils.FormatSize(c.SizeRootFs))
+	}
+
+	return cf, nil
+}
+
 // psExample shows examples in ps command, and is used in auto-generated cli docs.
 func psExample() string {
 	return `$ pouch ps
@@ -136,6 +262,14 @@ Name   ID                                                                 Status
 foo2   692c77587b38f60bbd91d986ec3703848d72aea5030e320d4988eb02aa3f9d48   Up 1 minute   1 minute ago   docker.io/library/redis:alpine   runc
 foo    18592900006405ee64788bd108ef1de3d24dc3add73725891f4787d0f8e036f5   Up 1 minute   1 minute ago   docker.io/library/redis:alpine   runc
 
+$ pouch ps --format "table {{.ID}}\t{{.Name}}\t{{.Status}}"
+ID       Name   Status
+e42c68   2      Up 15 minutes
+a8c2ea   1      Up 16 minutes
+
+$ pouch ps -a --filter exited=0 --format json
+{"ID":"faf132","Name":"3","Image":"docker.io/library/busybox:latest",...}
+
 $ pouch ps --no-trunc -q
 692c77587b38f60bbd91d986ec3703848d72aea5030e320d4988eb02aa3f9d48
 18592900006405ee64788bd108ef1de3d24dc3add73725891f4787d0f8e036f5
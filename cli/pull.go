@@ -7,7 +7,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"text/tabwriter"
 	"time"
@@ -197,9 +196,7 @@ func pullMissingImage(ctx context.Context, apiClient client.CommonAPIClient, ima
 		if inspectError == nil {
 			return nil
 		}
-		if err, ok := inspectError.(client.RespError); !ok {
-			return inspectError
-		} else if err.Code() != http.StatusNotFound {
+		if !client.IsNotFound(inspectError) {
 			return inspectError
 		}
 	}
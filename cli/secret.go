@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/opts"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/cli/inspect"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// secretDescription defines the secret command description and auto generate command doc.
+var secretDescription = "Manage the secrets in pouchd. " +
+	"The value of secret is encrypted at rest with the key of pouchd, and is never returned by pouchd. " +
+	"A secret is mounted into container by 'pouch create --secret' or 'pouch run --secret', as a file in a tmpfs of container."
+
+// SecretCommand is used to implement 'secret' command.
+type SecretCommand struct {
+	baseCommand
+}
+
+// Init initializes SecretCommand command.
+func (s *SecretCommand) Init(c *Cli) {
+	s.cli = c
+
+	s.cmd = &cobra.Command{
+		Use:   "secret [command]",
+		Short: "Manage pouch secrets",
+		Long:  secretDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fmt.Errorf("command 'pouch secret %s' does not exist.\nPlease execute `pouch secret --help` for more help", args[0])
+		},
+	}
+
+	c.AddCommand(s, &SecretCreateCommand{})
+	c.AddCommand(s, &SecretListCommand{})
+	c.AddCommand(s, &SecretInspectCommand{})
+	c.AddCommand(s, &SecretRemoveCommand{})
+}
+
+// secretCreateDescription is used to describe secret create command in detail and auto generate command doc.
+var secretCreateDescription = "Create a secret from a file, or from stdin if the file is '-'."
+
+// SecretCreateCommand is used to implement 'secret create' command.
+type SecretCreateCommand struct {
+	baseCommand
+
+	labels []string
+}
+
+// Init initializes SecretCreateCommand command.
+func (s *SecretCreateCommand) Init(c *Cli) {
+	s.cli = c
+
+	s.cmd = &cobra.Command{
+		Use:   "create [OPTIONS] NAME FILE|-",
+		Short: "Create a secret from a file or stdin",
+		Long:  secretCreateDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return s.runSecretCreate(args)
+		},
+		Example: secretCreateExample(),
+	}
+
+	s.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (s *SecretCreateCommand) addFlags() {
+	s.cmd.Flags().StringSliceVarP(&s.labels, "label", "l", nil, "Set labels for secret")
+}
+
+// runSecretCreate is the entry of SecretCreateCommand command.
+func (s *SecretCreateCommand) runSecretCreate(args []string) error {
+	name, file := args[0], args[1]
+
+	var (
+		data []byte
+		err  error
+	)
+	if file == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(file)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read secret: %v", err)
+	}
+
+	ctx := context.Background()
+	apiClient := s.cli.Client()
+	resp, err := apiClient.SecretCreate(ctx, &types.SecretCreateConfig{
+		Name:   name,
+		Labels: opts.ParseLabels(s.labels),
+		Data:   data,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s: %s\n", resp.Name, resp.ID)
+	return nil
+}
+
+// secretCreateExample shows examples in secret create command, and is used in auto-generated cli docs.
+func secretCreateExample() string {
+	return `$ printf "passw0rd" | pouch secret create db-password -
+db-password: 6f8e4a7f0d2c5b1e9a3d7c6b5a4f3e2d1c0b9a8f7e6d5c4b3a2f1e0d9c8b7a6f
+$ pouch secret create -l env=prod tls-key ./server.key
+tls-key: 1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b2c`
+}
+
+// secretListDescription is used to describe secret list command in detail and auto generate command doc.
+var secretListDescription = "List the secrets in pouchd, the value of secret is never shown."
+
+// SecretListCommand is used to implement 'secret list' command.
+type SecretListCommand struct {
+	baseCommand
+
+	quiet bool
+}
+
+// Init initializes SecretListCommand command.
+func (s *SecretListCommand) Init(c *Cli) {
+	s.cli = c
+
+	s.cmd = &cobra.Command{
+		Use:     "list [OPTIONS]",
+		Aliases: []string{"ls"},
+		Short:   "List secrets",
+		Long:    secretListDescription,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return s.runSecretList(args)
+		},
+		Example: secretListExample(),
+	}
+
+	s.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (s *SecretListCommand) addFlags() {
+	s.cmd.Flags().BoolVarP(&s.quiet, "quiet", "q", false, "Only show secret names")
+}
+
+// runSecretList is the entry of SecretListCommand command.
+func (s *SecretListCommand) runSecretList(args []string) error {
+	ctx := context.Background()
+	apiClient := s.cli.Client()
+
+	secrets, err := apiClient.SecretList(ctx)
+	if err != nil {
+		return err
+	}
+
+	if s.quiet {
+		for _, secret := range secrets {
+			fmt.Println(secret.Name)
+		}
+		return nil
+	}
+
+	display := s.cli.NewTableDisplay()
+	display.AddRow([]string{"ID", "NAME", "SIZE", "CREATED"})
+	for _, secret := range secrets {
+		display.AddRow([]string{
+			utils.TruncateID(secret.ID),
+			secret.Name,
+			utils.FormatSize(secret.Size),
+			formatCreatedTime(secret.CreatedAt),
+		})
+	}
+	display.Flush()
+	return nil
+}
+
+// secretListExample shows examples in secret list command, and is used in auto-generated cli docs.
+func secretListExample() string {
+	return `$ pouch secret ls
+ID             NAME          SIZE      CREATED
+6f8e4a7f0d2c   db-password   8.00 B    2 minutes ago
+1b2c3d4e5f6a   tls-key       1.67 KB   1 minute ago`
+}
+
+// secretInspectDescription is used to describe secret inspect command in detail and auto generate command doc.
+var secretInspectDescription = "Return detailed information of secrets, the value of secret is never shown."
+
+// SecretInspectCommand is used to implement 'secret inspect' command.
+type SecretInspectCommand struct {
+	baseCommand
+
+	format string
+}
+
+// Init initializes SecretInspectCommand command.
+func (s *SecretInspectCommand) Init(c *Cli) {
+	s.cli = c
+
+	s.cmd = &cobra.Command{
+		Use:   "inspect [OPTIONS] SECRET [SECRET...]",
+		Short: "Get detailed information about one or more secrets",
+		Long:  secretInspectDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return s.runSecretInspect(args)
+		},
+		Example: secretInspectExample(),
+	}
+
+	s.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (s *SecretInspectCommand) addFlags() {
+	s.cmd.Flags().StringVarP(&s.format, "format", "f", "", "Format the output using the given go template")
+}
+
+// runSecretInspect is the entry of SecretInspectCommand command.
+func (s *SecretInspectCommand) runSecretInspect(args []string) error {
+	ctx := context.Background()
+	apiClient := s.cli.Client()
+
+	getRefFunc := func(ref string) (interface{}, error) {
+		return apiClient.SecretInspect(ctx, ref)
+	}
+
+	return inspect.Inspect(os.Stdout, args, s.format, getRefFunc)
+}
+
+// secretInspectExample shows examples in secret inspect command, and is used in auto-generated cli docs.
+func secretInspectExample() string {
+	return `$ pouch secret inspect -f "{{.Size}}" db-password
+8`
+}
+
+// secretRemoveDescription is used to describe secret remove command in detail and auto generate command doc.
+var secretRemoveDescription = "Remove one or more secrets, a secret used by containers can't be removed."
+
+// SecretRemoveCommand is used to implement 'secret remove' command.
+type SecretRemoveCommand struct {
+	baseCommand
+}
+
+// Init initializes SecretRemoveCommand command.
+func (s *SecretRemoveCommand) Init(c *Cli) {
+	s.cli = c
+
+	s.cmd = &cobra.Command{
+		Use:     "remove SECRET [SECRET...]",
+		Aliases: []string{"rm"},
+		Short:   "Remove one or more secrets",
+		Long:    secretRemoveDescription,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return s.runSecretRemove(args)
+		},
+		Example: secretRemoveExample(),
+	}
+}
+
+// runSecretRemove is the entry of SecretRemoveCommand command.
+func (s *SecretRemoveCommand) runSecretRemove(args []string) error {
+	ctx := context.Background()
+	apiClient := s.cli.Client()
+
+	var errs []string
+	for _, name := range args {
+		if err := apiClient.SecretRemove(ctx, name); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		fmt.Printf("%s\n", name)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to remove secrets: \n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// secretRemoveExample shows examples in secret remove command, and is used in auto-generated cli docs.
+func secretRemoveExample() string {
+	return `$ pouch secret rm db-password
+db-password`
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// systemDescription is used to describe system command in detail and auto generate command doc.
+var systemDescription = "Manage the state of pouchd. " +
+	"'backup' streams the state of pouchd to a tar archive, which can be restored by 'pouchd --restore-from'."
+
+// SystemCommand is used to implement 'system' command.
+type SystemCommand struct {
+	baseCommand
+}
+
+// Init initializes SystemCommand command.
+func (s *SystemCommand) Init(c *Cli) {
+	s.cli = c
+
+	s.cmd = &cobra.Command{
+		Use:   "system [command]",
+		Short: "Manage pouchd",
+		Long:  systemDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fmt.Errorf("command 'pouch system %s' does not exist.\nPlease execute `pouch system --help` for more help", args[0])
+		},
+	}
+
+	c.AddCommand(s, &SystemBackupCommand{})
+}
+
+// systemBackupDescription is used to describe system backup command in detail and auto generate command doc.
+var systemBackupDescription = "Back up the state of pouchd to a tar archive. " +
+	"The archive contains the meta data of containers, volumes, pods and secrets, the key of secrets, the network store, the config of pouchd " +
+	"and the checkpoints of containers. The data of local volumes and the rw layers of containers are only " +
+	"included with '--volume-data' and '--rw-layers'. Images are not included, they should be pulled again " +
+	"or saved by 'pouch save'. The archive is restored by starting pouchd with '--restore-from' on a fresh home dir."
+
+// SystemBackupCommand is used to implement 'system backup' command.
+type SystemBackupCommand struct {
+	baseCommand
+
+	output     string
+	volumeData bool
+	rwLayers   bool
+}
+
+// Init initializes SystemBackupCommand command.
+func (s *SystemBackupCommand) Init(c *Cli) {
+	s.cli = c
+
+	s.cmd = &cobra.Command{
+		Use:   "backup [OPTIONS]",
+		Short: "Back up the state of pouchd to a tar archive or STDOUT",
+		Long:  systemBackupDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return s.runSystemBackup(args)
+		},
+		Example: systemBackupExample(),
+	}
+	s.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (s *SystemBackupCommand) addFlags() {
+	flagSet := s.cmd.Flags()
+	flagSet.StringVarP(&s.output, "output", "o", "", "Write to a tar archive file, instead of STDOUT")
+	flagSet.BoolVar(&s.volumeData, "volume-data", false, "Include the data of local volumes")
+	flagSet.BoolVar(&s.rwLayers, "rw-layers", false, "Include the rw layers of containers")
+}
+
+// runSystemBackup is the entry of system backup command.
+func (s *SystemBackupCommand) runSystemBackup(args []string) (err error) {
+	if s.output == "" && terminal.IsTerminal(int(os.Stdout.Fd())) {
+		return fmt.Errorf("refusing to write the archive to terminal, use -o or redirect STDOUT")
+	}
+
+	ctx := context.Background()
+	apiClient := s.cli.Client()
+
+	r, err := apiClient.DaemonBackup(ctx, s.volumeData, s.rwLayers)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	out := os.Stdout
+	if s.output != "" {
+		if out, err = os.Create(s.output); err != nil {
+			return err
+		}
+		defer func() {
+			out.Close()
+			if err != nil {
+				os.Remove(s.output)
+			}
+		}()
+	}
+
+	if _, err = io.Copy(out, r); err != nil {
+		return fmt.Errorf("failed to back up pouchd: %v", err)
+	}
+	return nil
+}
+
+// systemBackupExample shows examples in system backup command, and is used in auto-generated cli docs.
+func systemBackupExample() string {
+	return `$ pouch system backup --volume-data -o pouch-backup.tar
+$ pouchd --home-dir /var/lib/pouch-new --restore-from pouch-backup.tar`
+}
@@ -58,7 +58,8 @@ GoVersion:       go1.10.4
 KernelVersion:   3.10.0-693.11.6.el7.x86_64
 Os:              linux
 Version:         1.0.0
-APIVersion:      1.24
+APIVersion:      1.25
+MinAPIVersion:   1.24
 Arch:            amd64
 BuildTime:       2018-11-07T07:48:56.348129663Z
 GitCommit:
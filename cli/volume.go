@@ -287,6 +287,26 @@ type VolumeListCommand struct {
 	mountPoint bool
 	quiet      bool
 	filter     []string
+	format     string
+}
+
+// volumeFormat is the fields of volume which can be used in the format of
+// volume list command.
+type volumeFormat struct {
+	Name       string
+	Driver     string
+	Size       string
+	Mountpoint string
+	Labels     map[string]string
+}
+
+// volumeFormatHeader is the header of the table format of volume list command.
+var volumeFormatHeader = map[string]string{
+	"Name":       "VOLUME NAME",
+	"Driver":     "DRIVER",
+	"Size":       "SIZE",
+	"Mountpoint": "MOUNT POINT",
+	"Labels":     "LABELS",
 }
 
 // Init initializes VolumeListCommand command.
@@ -313,6 +333,7 @@ func (v *VolumeListCommand) addFlags() {
 	flagSet.BoolVar(&v.mountPoint, "mountpoint", false, "Display volume mountpoint")
 	flagSet.BoolVarP(&v.quiet, "quiet", "q", false, "Only display volume names")
 	flagSet.StringSliceVarP(&v.filter, "filter", "f", []string{}, "Filter output based on conditions provided, filter support driver, name, label")
+	flagSet.StringVar(&v.format, "format", "", "Format the output using the given go template, use 'table' directive to output with header, or 'json' to output json")
 }
 
 // runVolumeList is the entry of VolumeListCommand command.
@@ -336,6 +357,24 @@ func (v *VolumeListCommand) runVolumeList(args []string) error {
 		return fmt.Errorf("Conflicting options: --size (or --mountpoint) and -q")
 	}
 
+	if v.format != "" && !v.quiet {
+		elements := make([]interface{}, 0, len(volumeList.Volumes))
+		for _, volume := range volumeList.Volumes {
+			size := "ulimit"
+			if s, ok := volume.Status["size"]; ok {
+				size = fmt.Sprintf("%v", s)
+			}
+			elements = append(elements, volumeFormat{
+				Name:       volume.Name,
+				Driver:     volume.Driver,
+				Size:       size,
+				Mountpoint: volume.Mountpoint,
+				Labels:     volume.Labels,
+			})
+		}
+		return formatList(os.Stdout, v.cli.padding, v.format, volumeFormatHeader, elements)
+	}
+
 	display := v.cli.NewTableDisplay()
 	displayHead := []string{"VOLUME NAME"}
 
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/version"
+)
+
+// negotiateAPIVersion lowers the api version of client to the latest one
+// of the server if the server is older, so that the client works with the
+// servers of different versions. It's done once on the first request, and
+// tried again on the next request if the server can't be reached.
+func (client *APIClient) negotiateAPIVersion(ctx context.Context) {
+	client.negotiateLock.Lock()
+	defer client.negotiateLock.Unlock()
+
+	if !client.negotiate {
+		return
+	}
+
+	// the path without version is served in the latest version by server.
+	req, err := http.NewRequest("GET", client.baseURL+"/version", nil)
+	if err != nil {
+		return
+	}
+	resp, err := cancellableDo(ctx, client.HTTPCli, req)
+	if err != nil {
+		// the error is returned by the request itself.
+		return
+	}
+	defer resp.Body.Close()
+
+	client.negotiate = false
+	if resp.StatusCode != http.StatusOK {
+		return
+	}
+
+	serverVersion := &types.SystemVersion{}
+	if err := decodeBody(serverVersion, resp.Body); err != nil || serverVersion.APIVersion == "" {
+		return
+	}
+	if version.CompareAPIVersion(serverVersion.APIVersion, client.version) < 0 {
+		client.version = "v" + strings.TrimPrefix(serverVersion.APIVersion, "v")
+	}
+}
+
+// checkAPIVersion returns error if the api version of client is older than
+// minVersion which the feature is introduced in.
+func (client *APIClient) checkAPIVersion(ctx context.Context, minVersion, feature string) error {
+	client.negotiateAPIVersion(ctx)
+
+	if client.version != "" && version.CompareAPIVersion(client.version, minVersion) < 0 {
+		return fmt.Errorf("%s requires API version %s, but the API version is %s, please upgrade pouchd", feature, minVersion, strings.TrimPrefix(client.version, "v"))
+	}
+	return nil
+}
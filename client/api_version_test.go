@@ -0,0 +1,85 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newVersionedMockClient mocks a server of apiVersion, it records the paths
+// requested.
+func newVersionedMockClient(apiVersion string, paths *[]string) *APIClient {
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		*paths = append(*paths, req.URL.Path)
+
+		if req.URL.Path == "/version" {
+			b, err := json.Marshal(&types.SystemVersion{APIVersion: apiVersion})
+			if err != nil {
+				return nil, err
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(b)),
+			}, nil
+		}
+
+		if !strings.HasPrefix(req.URL.Path, "/v"+apiVersion+"/") {
+			return nil, fmt.Errorf("unexpected path %s", req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("[]"))),
+		}, nil
+	})
+
+	return &APIClient{
+		HTTPCli:   httpClient,
+		version:   defaultVersion,
+		negotiate: true,
+	}
+}
+
+func TestNegotiateAPIVersion(t *testing.T) {
+	var paths []string
+	client := newVersionedMockClient("1.24", &paths)
+
+	_, err := client.ContainerList(context.Background(), types.ContainerListOptions{})
+	assert.NoError(t, err)
+	_, err = client.ContainerList(context.Background(), types.ContainerListOptions{})
+	assert.NoError(t, err)
+
+	// negotiated only once.
+	assert.Equal(t, []string{"/version", "/v1.24/containers/json", "/v1.24/containers/json"}, paths)
+
+	// the features of newer version are refused before requesting.
+	_, err = client.PodList(context.Background())
+	assert.EqualError(t, err, "pod list requires API version 1.25, but the API version is 1.24, please upgrade pouchd")
+	assert.Len(t, paths, 3)
+}
+
+func TestNegotiateSameVersion(t *testing.T) {
+	var paths []string
+	client := newVersionedMockClient("1.25", &paths)
+	client.version = "v1.25"
+
+	_, err := client.PodList(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/version", "/v1.25/pods/json"}, paths)
+
+	// the version specified is not negotiated.
+	paths = nil
+	client = newVersionedMockClient("1.24", &paths)
+	client.UpdateClientVersion("v1.24")
+	_, err = client.ContainerList(context.Background(), types.ContainerListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/v1.24/containers/json"}, paths)
+}
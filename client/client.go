@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/alibaba/pouch/pkg/httputils"
@@ -16,7 +17,7 @@ var (
 	defaultHost    = "unix:///var/run/pouchd.sock"
 	defaultTimeout = time.Second * 10
 	// defaultVersion is the version of the current stable API
-	defaultVersion = "v1.24"
+	defaultVersion = "v1.25"
 )
 
 // APIClient is a API client that performs all operations
@@ -28,6 +29,15 @@ type APIClient struct {
 	HTTPCli *http.Client
 	// version of the server talks to
 	version string
+
+	// negotiate is set if version should be negotiated with the server on
+	// the first request, the lock protects the negotiation.
+	negotiate     bool
+	negotiateLock sync.Mutex
+
+	// retryPolicy is used to retry the idempotent requests, they are not
+	// retried if it's nil.
+	retryPolicy *RetryPolicy
 }
 
 // TLSConfig contains information of tls which users can specify
@@ -56,17 +66,20 @@ func NewAPIClient(host string, tls TLSConfig) (CommonAPIClient, error) {
 
 	basePath := generateBaseURL(newURL, tls)
 
+	// the version specified by user is not negotiated.
 	version := os.Getenv("POUCH_API_VERSION")
+	negotiate := version == ""
 	if version == "" {
 		version = defaultVersion
 	}
 
 	return &APIClient{
-		proto:   newURL.Scheme,
-		addr:    addr,
-		baseURL: basePath,
-		HTTPCli: httpCli,
-		version: version,
+		proto:     newURL.Scheme,
+		addr:      addr,
+		baseURL:   basePath,
+		HTTPCli:   httpCli,
+		version:   version,
+		negotiate: negotiate,
 	}, nil
 }
 
@@ -122,7 +135,12 @@ func (client *APIClient) GetAPIPath(path string, query url.Values) string {
 	return u.String()
 }
 
-// UpdateClientVersion sets client version new value.
+// UpdateClientVersion sets client version new value, which is not
+// negotiated any more.
 func (client *APIClient) UpdateClientVersion(v string) {
+	client.negotiateLock.Lock()
+	defer client.negotiateLock.Unlock()
+
 	client.version = v
+	client.negotiate = false
 }
@@ -9,6 +9,12 @@ import (
 
 // ContainerCreate creates a new container based in the given configuration.
 func (client *APIClient) ContainerCreate(ctx context.Context, config types.ContainerConfig, hostConfig *types.HostConfig, networkingConfig *types.NetworkingConfig, containerName string) (*types.ContainerCreateResp, error) {
+	if hostConfig != nil && (hostConfig.Pod != "" || len(hostConfig.Secrets) > 0) {
+		if err := client.checkAPIVersion(ctx, "1.25", "pod and secrets of container"); err != nil {
+			return nil, err
+		}
+	}
+
 	createConfig := types.ContainerCreateConfig{
 		ContainerConfig:  config,
 		HostConfig:       hostConfig,
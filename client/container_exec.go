@@ -12,6 +12,12 @@ import (
 
 // ContainerCreateExec creates exec process.
 func (client *APIClient) ContainerCreateExec(ctx context.Context, name string, config *types.ExecCreateConfig) (*types.ExecCreateResp, error) {
+	if config != nil && config.WorkingDir != "" {
+		if err := client.checkAPIVersion(ctx, "1.25", "working dir of exec"); err != nil {
+			return nil, err
+		}
+	}
+
 	response, err := client.post(ctx, "/containers/"+name+"/exec", url.Values{}, config, nil)
 	if err != nil {
 		return nil, err
@@ -61,3 +67,37 @@ func (client *APIClient) ContainerExecResize(ctx context.Context, execID string,
 	ensureCloseReader(resp)
 	return err
 }
+
+// ContainerExecList lists the running and recently exited exec processes of a container.
+func (client *APIClient) ContainerExecList(ctx context.Context, name string) ([]*types.ContainerExecInspect, error) {
+	if err := client.checkAPIVersion(ctx, "1.25", "exec list"); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.get(ctx, "/containers/"+name+"/execs", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	execs := []*types.ContainerExecInspect{}
+	err = decodeBody(&execs, resp.Body)
+	ensureCloseReader(resp)
+
+	return execs, err
+}
+
+// ContainerExecKill sends a signal to the exec process running inside a container.
+func (client *APIClient) ContainerExecKill(ctx context.Context, execID string, signal string) error {
+	if err := client.checkAPIVersion(ctx, "1.25", "exec kill"); err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	if signal != "" {
+		query.Set("signal", signal)
+	}
+
+	resp, err := client.post(ctx, "/exec/"+execID+"/kill", query, nil, nil)
+	ensureCloseReader(resp)
+	return err
+}
@@ -153,3 +153,56 @@ func TestContainerExecResize(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestContainerExecList(t *testing.T) {
+	expectedURL := "/containers/container_id/execs"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != expectedURL {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		b, err := json.Marshal([]types.ContainerExecInspect{
+			{ID: "exec1", Running: true},
+			{ID: "exec2", Exited: true, ExitCode: 137},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	execs, err := client.ContainerExecList(context.Background(), "container_id")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(execs))
+	assert.Equal(t, int64(137), execs[1].ExitCode)
+}
+
+func TestContainerExecKill(t *testing.T) {
+	expectedURL := "/exec/exec_id/kill"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != expectedURL {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if signal := req.URL.Query().Get("signal"); signal != "SIGTERM" {
+			return nil, fmt.Errorf("expected signal SIGTERM, got %s", signal)
+		}
+		return &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	assert.NoError(t, client.ContainerExecKill(context.Background(), "exec_id", "SIGTERM"))
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/url"
+)
+
+// DaemonBackup requests daemon to back up its state to a tar archive, the
+// data of local volumes and the rw layers of containers are included if
+// volumeData and rwLayers are set.
+func (client *APIClient) DaemonBackup(ctx context.Context, volumeData, rwLayers bool) (io.ReadCloser, error) {
+	if err := client.checkAPIVersion(ctx, "1.25", "daemon backup"); err != nil {
+		return nil, err
+	}
+
+	q := url.Values{}
+	if volumeData {
+		q.Set("volumedata", "1")
+	}
+	if rwLayers {
+		q.Set("rwlayers", "1")
+	}
+
+	resp, err := client.get(ctx, "/daemon/backup", q, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDaemonBackupServerError(t *testing.T) {
+	expectedError := "Server error"
+
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, expectedError)),
+	}
+
+	_, err := client.DaemonBackup(context.Background(), false, false)
+	if err == nil || !strings.Contains(err.Error(), expectedError) {
+		t.Fatalf("expected (%v), got (%v)", expectedError, err)
+	}
+}
+
+func TestDaemonBackup(t *testing.T) {
+	expectedURL := "/daemon/backup"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "GET" {
+			return nil, fmt.Errorf("expected GET method, got %s", req.Method)
+		}
+		if req.FormValue("volumedata") != "1" || req.FormValue("rwlayers") != "" {
+			return nil, fmt.Errorf("unexpected query %s", req.URL.RawQuery)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("archive"))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	body, err := client.DaemonBackup(context.Background(), true, false)
+	assert.NoError(t, err)
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, "archive", string(data))
+}
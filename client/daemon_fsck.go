@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// DaemonFsck requests daemon to check the consistency of its meta data, the
+// findings are repaired if repair is set and dryRun is not.
+func (client *APIClient) DaemonFsck(ctx context.Context, repair, dryRun bool) (*types.FsckReport, error) {
+	if err := client.checkAPIVersion(ctx, "1.25", "daemon fsck"); err != nil {
+		return nil, err
+	}
+
+	q := url.Values{}
+	if repair {
+		q.Set("repair", "1")
+	}
+	if dryRun {
+		q.Set("dryrun", "1")
+	}
+
+	resp, err := client.post(ctx, "/daemon/fsck", q, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &types.FsckReport{}
+
+	err = decodeBody(report, resp.Body)
+	ensureCloseReader(resp)
+
+	return report, err
+}
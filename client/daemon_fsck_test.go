@@ -0,0 +1,69 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDaemonFsckError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.DaemonFsck(context.Background(), false, false)
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestDaemonFsck(t *testing.T) {
+	expectedURL := "/daemon/fsck"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+		if repair := req.URL.Query().Get("repair"); repair != "1" {
+			return nil, fmt.Errorf("repair not set in URL query properly. Expected '1', got %s", repair)
+		}
+		if dryRun := req.URL.Query().Get("dryrun"); dryRun != "1" {
+			return nil, fmt.Errorf("dryrun not set in URL query properly. Expected '1', got %s", dryRun)
+		}
+
+		b, err := json.Marshal(types.FsckReport{
+			Repair: true,
+			DryRun: true,
+			Findings: []*types.FsckFinding{
+				{Type: "orphan-snapshot", Object: "snapshot overlayfs/abc", Repairable: true},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	report, err := client.DaemonFsck(context.Background(), true, true)
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Len(t, report.Findings, 1)
+	assert.Equal(t, "orphan-snapshot", report.Findings[0].Type)
+}
@@ -0,0 +1,218 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNotFound represents the object is not found.
+	ErrNotFound = errors.New("not found")
+
+	// ErrAlreadyExisted represents the object has already existed.
+	ErrAlreadyExisted = errors.New("already existed")
+
+	// ErrConflict represents the request conflicts with the state of object.
+	ErrConflict = errors.New("conflict")
+
+	// ErrInUse represents the object is used by others.
+	ErrInUse = errors.New("in use")
+
+	// ErrInvalidParam represents the parameters are invalid.
+	ErrInvalidParam = errors.New("invalid param")
+
+	// ErrNotModified represents the object is not modified.
+	ErrNotModified = errors.New("not modified")
+
+	// ErrUnauthorized represents the request is not authorized.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrTooManyRequests represents daemon is too busy to handle the request.
+	ErrTooManyRequests = errors.New("too many requests")
+
+	// ErrDaemonUnavailable represents daemon can't be connected or is
+	// temporarily unavailable.
+	ErrDaemonUnavailable = errors.New("daemon unavailable")
+)
+
+// errorKinds maps the kind of error response to the sentinel errors, the
+// kind is the category of errtypes in daemon.
+var errorKinds = map[string]error{
+	"NotFound":             ErrNotFound,
+	"AlreadyExisted":       ErrAlreadyExisted,
+	"Conflict":             ErrConflict,
+	"InUse":                ErrInUse,
+	"InvalidParam":         ErrInvalidParam,
+	"NotModified":          ErrNotModified,
+	"InvalidAuthorization": ErrUnauthorized,
+}
+
+// errorCodes maps the status code of error response to the sentinel errors,
+// it's used if daemon doesn't return the kind of error.
+var errorCodes = map[int]error{
+	http.StatusNotFound:           ErrNotFound,
+	http.StatusConflict:           ErrConflict,
+	http.StatusBadRequest:         ErrInvalidParam,
+	http.StatusNotModified:        ErrNotModified,
+	http.StatusUnauthorized:       ErrUnauthorized,
+	http.StatusForbidden:          ErrUnauthorized,
+	http.StatusTooManyRequests:    ErrTooManyRequests,
+	http.StatusBadGateway:         ErrDaemonUnavailable,
+	http.StatusServiceUnavailable: ErrDaemonUnavailable,
+	http.StatusGatewayTimeout:     ErrDaemonUnavailable,
+}
+
+// RespError defines the response error.
+type RespError struct {
+	code int
+	msg  string
+
+	// message and kind are decoded from the body of response.
+	message    string
+	kind       string
+	retryAfter time.Duration
+}
+
+// newRespError decodes the error response of daemon.
+func newRespError(resp *http.Response, body []byte) RespError {
+	e := RespError{code: resp.StatusCode, msg: string(body)}
+
+	var errResp types.Error
+	if err := json.Unmarshal(body, &errResp); err == nil {
+		e.message, e.kind = errResp.Message, errResp.Kind
+	}
+
+	if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs > 0 {
+		e.retryAfter = time.Duration(secs) * time.Second
+	}
+	return e
+}
+
+// Error implements the error interface.
+func (e RespError) Error() string {
+	return e.msg
+}
+
+// Code returns the response  code
+func (e RespError) Code() int {
+	return e.code
+}
+
+// Message returns the error message in response, or the whole body if it
+// is not an error message of daemon.
+func (e RespError) Message() string {
+	if e.message == "" {
+		return e.msg
+	}
+	return e.message
+}
+
+// Kind returns the kind of error returned by daemon, such as NotFound,
+// Conflict and InUse. It's empty if daemon doesn't tell the kind.
+func (e RespError) Kind() string {
+	return e.kind
+}
+
+// RetryAfter returns the duration that daemon asks client to wait before
+// retrying, it's zero if not specified.
+func (e RespError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// sentinel returns the sentinel error of the response, the kind is
+// preferred since status code 409 is shared by several kinds.
+func (e RespError) sentinel() error {
+	if err, ok := errorKinds[e.kind]; ok {
+		return err
+	}
+	return errorCodes[e.code]
+}
+
+// ConnectionError represents that client fails to talk to daemon, such as
+// daemon is not running or the connection is broken.
+type ConnectionError struct {
+	Addr string
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *ConnectionError) Error() string {
+	return fmt.Sprintf("failed to connect to pouchd at %s: %v", e.Addr, e.Err)
+}
+
+// Cause returns ErrDaemonUnavailable.
+func (e *ConnectionError) Cause() error {
+	return ErrDaemonUnavailable
+}
+
+// errorSentinel returns the sentinel error of err, or the cause of err if
+// it's not an error of daemon.
+func errorSentinel(err error) error {
+	err = errors.Cause(err)
+	switch e := err.(type) {
+	case RespError:
+		if s := e.sentinel(); s != nil {
+			return s
+		}
+	case *RespError:
+		if s := e.sentinel(); s != nil {
+			return s
+		}
+	}
+	return err
+}
+
+// IsNotFound checks the error is the object not found.
+func IsNotFound(err error) bool {
+	return errorSentinel(err) == ErrNotFound
+}
+
+// IsAlreadyExisted checks the error is the object already existed. Daemon
+// older than API 1.25 returns it as conflict.
+func IsAlreadyExisted(err error) bool {
+	return errorSentinel(err) == ErrAlreadyExisted
+}
+
+// IsConflict checks the error is conflict with the state of object.
+func IsConflict(err error) bool {
+	return errorSentinel(err) == ErrConflict
+}
+
+// IsInUse checks the error is the object used by others. Daemon older than
+// API 1.25 returns it as conflict.
+func IsInUse(err error) bool {
+	return errorSentinel(err) == ErrInUse
+}
+
+// IsInvalidParam checks the error is the parameters are invalid.
+func IsInvalidParam(err error) bool {
+	return errorSentinel(err) == ErrInvalidParam
+}
+
+// IsNotModified checks the error is the object not modified.
+func IsNotModified(err error) bool {
+	return errorSentinel(err) == ErrNotModified
+}
+
+// IsUnauthorized checks the error is the request not authorized.
+func IsUnauthorized(err error) bool {
+	return errorSentinel(err) == ErrUnauthorized
+}
+
+// IsTooManyRequests checks the error is daemon too busy to handle request.
+func IsTooManyRequests(err error) bool {
+	return errorSentinel(err) == ErrTooManyRequests
+}
+
+// IsDaemonUnavailable checks the error is daemon can't be connected or is
+// temporarily unavailable.
+func IsDaemonUnavailable(err error) bool {
+	return errorSentinel(err) == ErrDaemonUnavailable
+}
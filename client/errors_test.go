@@ -0,0 +1,100 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func kindMockResponse(statusCode int, kind string) func(req *http.Request) (*http.Response, error) {
+	return func(req *http.Request) (*http.Response, error) {
+		body, err := json.Marshal(&types.Error{Message: "error of " + kind, Kind: kind})
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: statusCode,
+			Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		}, nil
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	for _, tc := range []struct {
+		code   int
+		kind   string
+		helper func(error) bool
+	}{
+		{http.StatusNotFound, "", IsNotFound},
+		{http.StatusNotFound, "NotFound", IsNotFound},
+		{http.StatusConflict, "", IsConflict},
+		{http.StatusConflict, "AlreadyExisted", IsAlreadyExisted},
+		{http.StatusConflict, "InUse", IsInUse},
+		{http.StatusConflict, "Conflict", IsConflict},
+		{http.StatusBadRequest, "", IsInvalidParam},
+		{http.StatusForbidden, "InvalidAuthorization", IsUnauthorized},
+		{http.StatusTooManyRequests, "", IsTooManyRequests},
+		{http.StatusServiceUnavailable, "", IsDaemonUnavailable},
+		// unknown kind falls back to the status code.
+		{http.StatusNotFound, "Unknown", IsNotFound},
+	} {
+		client := &APIClient{
+			HTTPCli: newMockClient(kindMockResponse(tc.code, tc.kind)),
+		}
+
+		_, err := client.ContainerGet(context.Background(), "foo")
+		assert.Error(t, err)
+		assert.True(t, tc.helper(err), "code %d kind %q", tc.code, tc.kind)
+		assert.True(t, tc.helper(pkgerrors.Wrap(err, "wrapped")), "code %d kind %q", tc.code, tc.kind)
+
+		respErr, ok := err.(RespError)
+		assert.True(t, ok)
+		assert.Equal(t, tc.code, respErr.Code())
+		assert.Equal(t, tc.kind, respErr.Kind())
+		assert.Equal(t, "error of "+tc.kind, respErr.Message())
+	}
+
+	// the kind is preferred to the status code.
+	client := &APIClient{
+		HTTPCli: newMockClient(kindMockResponse(http.StatusConflict, "InUse")),
+	}
+	_, err := client.ContainerGet(context.Background(), "foo")
+	assert.False(t, IsConflict(err))
+	assert.False(t, IsNotFound(errors.New("not found")))
+}
+
+func TestConnectionError(t *testing.T) {
+	client := &APIClient{
+		addr: "/var/run/pouchd.sock",
+		HTTPCli: newMockClient(func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		}),
+	}
+
+	_, err := client.ContainerGet(context.Background(), "foo")
+	assert.True(t, IsDaemonUnavailable(err))
+	assert.Contains(t, err.Error(), "/var/run/pouchd.sock")
+	assert.Contains(t, err.Error(), "connection refused")
+	assert.False(t, IsNotFound(err))
+}
+
+func TestRespErrorRetryAfter(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+
+	err := newRespError(resp, []byte("busy"))
+	assert.Equal(t, 3*time.Second, err.RetryAfter())
+	assert.Equal(t, "busy", err.Message())
+	assert.True(t, IsTooManyRequests(err))
+}
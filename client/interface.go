@@ -17,6 +17,8 @@ type CommonAPIClient interface {
 	VolumeAPIClient
 	SystemAPIClient
 	NetworkAPIClient
+	PodAPIClient
+	SecretAPIClient
 }
 
 // ContainerAPIClient defines methods of Container client.
@@ -31,6 +33,8 @@ type ContainerAPIClient interface {
 	ContainerStartExec(ctx context.Context, execID string, config *types.ExecStartConfig) (net.Conn, *bufio.Reader, error)
 	ContainerExecInspect(ctx context.Context, execID string) (*types.ContainerExecInspect, error)
 	ContainerExecResize(ctx context.Context, execID string, options types.ResizeOptions) error
+	ContainerExecList(ctx context.Context, name string) ([]*types.ContainerExecInspect, error)
+	ContainerExecKill(ctx context.Context, execID string, signal string) error
 	ContainerGet(ctx context.Context, name string) (*types.ContainerJSON, error)
 	ContainerRename(ctx context.Context, id string, name string) error
 	ContainerRestart(ctx context.Context, name string, timeout string) error
@@ -81,7 +85,10 @@ type SystemAPIClient interface {
 	SystemInfo(ctx context.Context) (*types.SystemInfo, error)
 	RegistryLogin(ctx context.Context, auth *types.AuthConfig) (*types.AuthResponse, error)
 	DaemonUpdate(ctx context.Context, daemonConfig *types.DaemonUpdateConfig) error
+	DaemonFsck(ctx context.Context, repair, dryRun bool) (*types.FsckReport, error)
+	DaemonBackup(ctx context.Context, volumeData, rwLayers bool) (io.ReadCloser, error)
 	Events(ctx context.Context, since string, until string, filters filters.Args) (io.ReadCloser, error)
+	EventsStream(ctx context.Context, since string, filters filters.Args) (<-chan types.EventsMessage, <-chan error)
 }
 
 // NetworkAPIClient defines methods of Network client.
@@ -93,3 +100,21 @@ type NetworkAPIClient interface {
 	NetworkConnect(ctx context.Context, network string, req *types.NetworkConnect) error
 	NetworkDisconnect(ctx context.Context, networkID, containerID string, force bool) error
 }
+
+// PodAPIClient defines methods of Pod client.
+type PodAPIClient interface {
+	PodCreate(ctx context.Context, config *types.PodCreateConfig) (*types.PodCreateResp, error)
+	PodList(ctx context.Context) ([]*types.PodInfo, error)
+	PodInspect(ctx context.Context, name string) (*types.PodInfo, error)
+	PodStart(ctx context.Context, name string) error
+	PodStop(ctx context.Context, name string, timeout string) error
+	PodRemove(ctx context.Context, name string, force bool) error
+}
+
+// SecretAPIClient defines methods of Secret client.
+type SecretAPIClient interface {
+	SecretCreate(ctx context.Context, config *types.SecretCreateConfig) (*types.SecretCreateResp, error)
+	SecretList(ctx context.Context) ([]*types.SecretInfo, error)
+	SecretInspect(ctx context.Context, name string) (*types.SecretInfo, error)
+	SecretRemove(ctx context.Context, name string) error
+}
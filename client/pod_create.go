@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// PodCreate creates a pod.
+func (client *APIClient) PodCreate(ctx context.Context, config *types.PodCreateConfig) (*types.PodCreateResp, error) {
+	if err := client.checkAPIVersion(ctx, "1.25", "pod create"); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.post(ctx, "/pods/create", nil, config, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pod := &types.PodCreateResp{}
+
+	err = decodeBody(pod, resp.Body)
+	ensureCloseReader(resp)
+
+	return pod, err
+}
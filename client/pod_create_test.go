@@ -0,0 +1,67 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodCreateServerError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.PodCreate(context.Background(), &types.PodCreateConfig{})
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestPodCreate(t *testing.T) {
+	expectedURL := "/pods/create"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+
+		config := types.PodCreateConfig{}
+		if err := json.NewDecoder(req.Body).Decode(&config); err != nil {
+			return nil, fmt.Errorf("failed to parse json: %v", err)
+		}
+		if config.Name != "web" || !config.SharePID {
+			return nil, fmt.Errorf("unexpected pod config %+v", config)
+		}
+
+		b, err := json.Marshal(types.PodCreateResp{
+			ID:   "pod_id",
+			Name: "web",
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	res, err := client.PodCreate(context.Background(), &types.PodCreateConfig{Name: "web", SharePID: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "pod_id", res.ID)
+	assert.Equal(t, "web", res.Name)
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// PodInspect returns the information of a pod.
+func (client *APIClient) PodInspect(ctx context.Context, name string) (*types.PodInfo, error) {
+	if err := client.checkAPIVersion(ctx, "1.25", "pod inspect"); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.get(ctx, "/pods/"+name+"/json", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pod := &types.PodInfo{}
+
+	err = decodeBody(pod, resp.Body)
+	ensureCloseReader(resp)
+
+	return pod, err
+}
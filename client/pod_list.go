@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// PodList lists all the pods.
+func (client *APIClient) PodList(ctx context.Context) ([]*types.PodInfo, error) {
+	if err := client.checkAPIVersion(ctx, "1.25", "pod list"); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.get(ctx, "/pods/json", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := []*types.PodInfo{}
+
+	err = decodeBody(&pods, resp.Body)
+	ensureCloseReader(resp)
+
+	return pods, err
+}
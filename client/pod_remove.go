@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// PodRemove removes a pod.
+func (client *APIClient) PodRemove(ctx context.Context, name string, force bool) error {
+	if err := client.checkAPIVersion(ctx, "1.25", "pod remove"); err != nil {
+		return err
+	}
+
+	q := url.Values{}
+	if force {
+		q.Set("force", "true")
+	}
+
+	resp, err := client.delete(ctx, "/pods/"+name, q, nil)
+	ensureCloseReader(resp)
+
+	return err
+}
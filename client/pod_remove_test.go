@@ -0,0 +1,49 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPodRemoveError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusConflict, "pod web has 1 containers")),
+	}
+	err := client.PodRemove(context.Background(), "web", false)
+	if err == nil || !strings.Contains(err.Error(), "pod web has 1 containers") {
+		t.Fatalf("expected a Conflict Error, got %v", err)
+	}
+}
+
+func TestPodRemove(t *testing.T) {
+	expectedURL := "/pods/web"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "DELETE" {
+			return nil, fmt.Errorf("expected DELETE method, got %s", req.Method)
+		}
+		if force := req.URL.Query().Get("force"); force != "true" {
+			return nil, fmt.Errorf("force not set in URL properly. Expected 'true', got %s", force)
+		}
+		return &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	if err := client.PodRemove(context.Background(), "web", true); err != nil {
+		t.Fatal(err)
+	}
+}
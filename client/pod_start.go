@@ -0,0 +1,17 @@
+package client
+
+import (
+	"context"
+)
+
+// PodStart starts a pod with the containers in it.
+func (client *APIClient) PodStart(ctx context.Context, name string) error {
+	if err := client.checkAPIVersion(ctx, "1.25", "pod start"); err != nil {
+		return err
+	}
+
+	resp, err := client.post(ctx, "/pods/"+name+"/start", nil, nil, nil)
+	ensureCloseReader(resp)
+
+	return err
+}
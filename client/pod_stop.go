@@ -0,0 +1,21 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// PodStop stops a pod with the containers in it.
+func (client *APIClient) PodStop(ctx context.Context, name string, timeout string) error {
+	if err := client.checkAPIVersion(ctx, "1.25", "pod stop"); err != nil {
+		return err
+	}
+
+	q := url.Values{}
+	q.Add("t", timeout)
+
+	resp, err := client.post(ctx, "/pods/"+name+"/stop", q, nil, nil)
+	ensureCloseReader(resp)
+
+	return err
+}
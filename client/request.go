@@ -14,22 +14,6 @@ import (
 	"time"
 )
 
-// RespError defines the response error.
-type RespError struct {
-	code int
-	msg  string
-}
-
-// Error implements the error interface.
-func (e RespError) Error() string {
-	return e.msg
-}
-
-// Code returns the response  code
-func (e RespError) Code() int {
-	return e.code
-}
-
 // Response wraps the http.Response and other states.
 type Response struct {
 	StatusCode int
@@ -73,6 +57,8 @@ func (client *APIClient) hijack(ctx context.Context, path string, query url.Valu
 		return nil, nil, err
 	}
 
+	client.negotiateAPIVersion(ctx)
+
 	req, err := client.newRequest("POST", path, query, body, header)
 	if err != nil {
 		return nil, nil, err
@@ -84,7 +70,7 @@ func (client *APIClient) hijack(ctx context.Context, path string, query url.Valu
 	req.Host = client.addr
 	conn, err := net.DialTimeout(client.proto, client.addr, defaultTimeout)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, &ConnectionError{Addr: client.addr, Err: err}
 	}
 
 	if tcpConn, ok := conn.(*net.TCPConn); ok {
@@ -119,6 +105,29 @@ func (client *APIClient) newRequest(method, path string, query url.Values, body
 }
 
 func (client *APIClient) sendRequest(ctx context.Context, method, path string, query url.Values, body io.Reader, headers map[string][]string) (*Response, error) {
+	for attempt := 0; ; attempt++ {
+		// the negotiation is tried again if daemon was unavailable.
+		client.negotiateAPIVersion(ctx)
+
+		resp, err := client.doRequest(ctx, method, path, query, body, headers)
+		if err == nil {
+			return resp, nil
+		}
+
+		backoff, retry := client.retryPolicy.shouldRetry(method, attempt, err)
+		if !retry {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func (client *APIClient) doRequest(ctx context.Context, method, path string, query url.Values, body io.Reader, headers map[string][]string) (*Response, error) {
 	req, err := client.newRequest(method, path, query, body, headers)
 	if err != nil {
 		return nil, err
@@ -126,7 +135,10 @@ func (client *APIClient) sendRequest(ctx context.Context, method, path string, q
 
 	resp, err := cancellableDo(ctx, client.HTTPCli, req)
 	if err != nil {
-		return nil, err
+		if err == ctx.Err() {
+			return nil, err
+		}
+		return nil, &ConnectionError{Addr: client.addr, Err: err}
 	}
 
 	if resp.StatusCode >= 400 {
@@ -136,7 +148,7 @@ func (client *APIClient) sendRequest(ctx context.Context, method, path string, q
 			return nil, err
 		}
 
-		return nil, RespError{code: resp.StatusCode, msg: string(data)}
+		return nil, newRespError(resp, data)
 	}
 
 	return &Response{
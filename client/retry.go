@@ -0,0 +1,69 @@
+package client
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+)
+
+// RetryPolicy is the policy to retry the idempotent requests, GET and HEAD,
+// when daemon is unavailable or too busy. The backoff grows exponentially
+// from InitialBackoff to MaxBackoff, unless daemon asks to retry after a
+// duration with Retry-After.
+type RetryPolicy struct {
+	// MaxRetries is the max times to retry a request.
+	MaxRetries int
+	// InitialBackoff is the backoff before the first retry, 100ms by default.
+	InitialBackoff time.Duration
+	// MaxBackoff is the max backoff between retries, 5s by default.
+	MaxBackoff time.Duration
+}
+
+// SetRetryPolicy sets the policy to retry the idempotent requests, nil
+// disables the retry which is the default.
+func (client *APIClient) SetRetryPolicy(policy *RetryPolicy) {
+	client.retryPolicy = policy
+}
+
+// backoff returns the backoff before the retry after attempt failures.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	backoff, max := defaultInitialBackoff, defaultMaxBackoff
+	if p != nil && p.InitialBackoff > 0 {
+		backoff = p.InitialBackoff
+	}
+	if p != nil && p.MaxBackoff > 0 {
+		max = p.MaxBackoff
+	}
+
+	for i := 0; i < attempt && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
+
+// shouldRetry returns the backoff and whether to retry the request which
+// fails with err after attempt retries.
+func (p *RetryPolicy) shouldRetry(method string, attempt int, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxRetries {
+		return 0, false
+	}
+	if method != "GET" && method != "HEAD" {
+		return 0, false
+	}
+	if !IsDaemonUnavailable(err) && !IsTooManyRequests(err) {
+		return 0, false
+	}
+
+	if respErr, ok := errors.Cause(err).(RespError); ok && respErr.RetryAfter() > 0 {
+		return respErr.RetryAfter(), true
+	}
+	return p.backoff(attempt), true
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newFlakyMockClient mocks a server which fails the first failures requests.
+func newFlakyMockClient(failures int, attempts *int) *APIClient {
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		*attempts++
+		if *attempts <= failures {
+			if *attempts%2 == 0 {
+				return errorMockResponse(http.StatusServiceUnavailable, "unavailable")(req)
+			}
+			return nil, errors.New("connection refused")
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+		}, nil
+	})
+
+	return &APIClient{HTTPCli: httpClient}
+}
+
+func TestRetryIdempotentRequest(t *testing.T) {
+	attempts := 0
+	client := newFlakyMockClient(2, &attempts)
+	client.SetRetryPolicy(&RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond})
+
+	_, err := client.ContainerGet(context.Background(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryExceedMaxRetries(t *testing.T) {
+	attempts := 0
+	client := newFlakyMockClient(5, &attempts)
+	client.SetRetryPolicy(&RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond})
+
+	_, err := client.ContainerGet(context.Background(), "foo")
+	assert.True(t, IsDaemonUnavailable(err))
+	assert.Equal(t, 3, attempts)
+}
+
+func TestNoRetry(t *testing.T) {
+	// retry is disabled by default.
+	attempts := 0
+	client := newFlakyMockClient(1, &attempts)
+	_, err := client.ContainerGet(context.Background(), "foo")
+	assert.True(t, IsDaemonUnavailable(err))
+	assert.Equal(t, 1, attempts)
+
+	// POST is not idempotent.
+	attempts = 0
+	client = newFlakyMockClient(1, &attempts)
+	client.SetRetryPolicy(&RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond})
+	err = client.ContainerPause(context.Background(), "foo")
+	assert.True(t, IsDaemonUnavailable(err))
+	assert.Equal(t, 1, attempts)
+
+	// not found is not transient.
+	attempts = 0
+	client = &APIClient{
+		HTTPCli: newMockClient(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			return errorMockResponse(http.StatusNotFound, "not found")(req)
+		}),
+	}
+	client.SetRetryPolicy(&RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond})
+	_, err = client.ContainerGet(context.Background(), "foo")
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := &RetryPolicy{MaxRetries: 10, InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	assert.Equal(t, time.Second, p.backoff(0))
+	assert.Equal(t, 2*time.Second, p.backoff(1))
+	assert.Equal(t, 4*time.Second, p.backoff(2))
+	assert.Equal(t, 5*time.Second, p.backoff(3))
+	assert.Equal(t, 5*time.Second, p.backoff(20))
+
+	// Retry-After of daemon is preferred.
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "7")
+	backoff, retry := p.shouldRetry("GET", 0, newRespError(resp, nil))
+	assert.True(t, retry)
+	assert.Equal(t, 7*time.Second, backoff)
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// SecretCreate creates a secret.
+func (client *APIClient) SecretCreate(ctx context.Context, config *types.SecretCreateConfig) (*types.SecretCreateResp, error) {
+	if err := client.checkAPIVersion(ctx, "1.25", "secret create"); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.post(ctx, "/secrets/create", nil, config, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &types.SecretCreateResp{}
+
+	err = decodeBody(secret, resp.Body)
+	ensureCloseReader(resp)
+
+	return secret, err
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretCreateServerError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.SecretCreate(context.Background(), &types.SecretCreateConfig{})
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestSecretCreate(t *testing.T) {
+	expectedURL := "/secrets/create"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		// the value of secret is base64 encoded in request.
+		if !strings.Contains(string(body), `"Data":"cGFzc3dvcmQ="`) {
+			return nil, fmt.Errorf("unexpected request body %s", body)
+		}
+
+		config := types.SecretCreateConfig{}
+		if err := json.Unmarshal(body, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse json: %v", err)
+		}
+		if config.Name != "db-password" || string(config.Data) != "password" {
+			return nil, fmt.Errorf("unexpected secret config %+v", config)
+		}
+
+		b, err := json.Marshal(types.SecretCreateResp{
+			ID:   "secret_id",
+			Name: "db-password",
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	res, err := client.SecretCreate(context.Background(), &types.SecretCreateConfig{
+		Name: "db-password",
+		Data: []byte("password"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "secret_id", res.ID)
+	assert.Equal(t, "db-password", res.Name)
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// SecretInspect returns the information of a secret, without its value.
+func (client *APIClient) SecretInspect(ctx context.Context, name string) (*types.SecretInfo, error) {
+	if err := client.checkAPIVersion(ctx, "1.25", "secret inspect"); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.get(ctx, "/secrets/"+name, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &types.SecretInfo{}
+
+	err = decodeBody(secret, resp.Body)
+	ensureCloseReader(resp)
+
+	return secret, err
+}
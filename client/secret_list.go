@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// SecretList lists all the secrets.
+func (client *APIClient) SecretList(ctx context.Context) ([]*types.SecretInfo, error) {
+	if err := client.checkAPIVersion(ctx, "1.25", "secret list"); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.get(ctx, "/secrets", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := []*types.SecretInfo{}
+
+	err = decodeBody(&secrets, resp.Body)
+	ensureCloseReader(resp)
+
+	return secrets, err
+}
@@ -0,0 +1,17 @@
+package client
+
+import (
+	"context"
+)
+
+// SecretRemove removes a secret.
+func (client *APIClient) SecretRemove(ctx context.Context, name string) error {
+	if err := client.checkAPIVersion(ctx, "1.25", "secret remove"); err != nil {
+		return err
+	}
+
+	resp, err := client.delete(ctx, "/secrets/"+name, nil, nil)
+	ensureCloseReader(resp)
+
+	return err
+}
@@ -2,11 +2,14 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/url"
 	"time"
 
 	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/pkg/utils"
 )
 
@@ -32,6 +35,10 @@ func (client *APIClient) Events(ctx context.Context, since string, until string,
 		query.Set("until", ts)
 	}
 
+	return client.events(ctx, query, f)
+}
+
+func (client *APIClient) events(ctx context.Context, query url.Values, f filters.Args) (io.ReadCloser, error) {
 	if f.Len() > 0 {
 		filtersJSON, err := filters.ToParam(f)
 		if err != nil {
@@ -48,3 +55,109 @@ func (client *APIClient) Events(ctx context.Context, since string, until string,
 
 	return resp.Body, nil
 }
+
+// EventsStream returns the events in the daemon since the time, and keeps
+// reconnecting to daemon if the stream is broken, the events since the last
+// received one are sent again by daemon and skipped. The backoff between
+// reconnections follows the retry policy of client. The error channel gets
+// the error which stops the stream, it's ctx.Err() if ctx is done.
+func (client *APIClient) EventsStream(ctx context.Context, since string, f filters.Args) (<-chan types.EventsMessage, <-chan error) {
+	messages := make(chan types.EventsMessage)
+	errCh := make(chan error, 1)
+
+	query := url.Values{}
+	if since != "" {
+		ts, err := utils.GetUnixTimestamp(since, time.Now())
+		if err != nil {
+			errCh <- err
+			close(messages)
+			return messages, errCh
+		}
+		query.Set("since", ts)
+	}
+
+	go func() {
+		defer close(messages)
+
+		var (
+			// last is the time of the last received event, and seen are
+			// the events received at that time.
+			last     int64
+			seen     = map[string]struct{}{}
+			failures int
+		)
+
+		for {
+			body, err := client.events(ctx, query, f)
+			if err == nil {
+				failures = 0
+				err = decodeEventsStream(ctx, client.addr, body, func(msg types.EventsMessage) error {
+					key, _ := json.Marshal(msg)
+					if msg.TimeNano < last {
+						return nil
+					} else if msg.TimeNano > last {
+						last = msg.TimeNano
+						seen = map[string]struct{}{}
+					} else if _, ok := seen[string(key)]; ok {
+						return nil
+					}
+					seen[string(key)] = struct{}{}
+
+					select {
+					case messages <- msg:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				})
+				body.Close()
+			}
+
+			if ctx.Err() != nil {
+				errCh <- ctx.Err()
+				return
+			}
+			if err != nil && !IsDaemonUnavailable(err) {
+				errCh <- err
+				return
+			}
+
+			// resume from the last received event, the events at the same
+			// time are skipped above.
+			if last > 0 {
+				query.Set("since", fmt.Sprintf("%d.%09d", last/int64(time.Second), last%int64(time.Second)))
+			}
+
+			select {
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			case <-time.After(client.retryPolicy.backoff(failures)):
+			}
+			failures++
+		}
+	}()
+
+	return messages, errCh
+}
+
+// decodeEventsStream decodes the events in body until it's broken, which is
+// returned as the daemon is unavailable.
+func decodeEventsStream(ctx context.Context, addr string, body io.Reader, handle func(types.EventsMessage) error) error {
+	dec := json.NewDecoder(body)
+	for {
+		var msg types.EventsMessage
+		if err := dec.Decode(&msg); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if _, ok := err.(*json.SyntaxError); ok {
+				return err
+			}
+			return &ConnectionError{Addr: addr, Err: err}
+		}
+		if err := handle(msg); err != nil {
+			return err
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/alibaba/pouch/apis/filters"
 	"github.com/alibaba/pouch/apis/types"
@@ -71,3 +72,76 @@ func TestEvents(t *testing.T) {
 	assert.Equal(t, event.ID, "abcd")
 	assert.Equal(t, event.Type, types.EventTypeContainer)
 }
+
+func TestEventsStream(t *testing.T) {
+	events := []types.EventsMessage{
+		{Action: "create", TimeNano: 1000000001},
+		{Action: "start", TimeNano: 2000000002},
+		{Action: "stop", TimeNano: 2000000002},
+		{Action: "die", TimeNano: 3000000003},
+	}
+
+	// the stream is broken after each three events, and daemon sends the
+	// events since the requested time again.
+	var requests []string
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		since := req.FormValue("since")
+		requests = append(requests, since)
+		if len(requests) > 3 {
+			return errorMockResponse(http.StatusBadRequest, "stop")(req)
+		}
+
+		var sinceNano int64
+		if since != "" {
+			var sec, nsec int64
+			if _, err := fmt.Sscanf(since, "%d.%d", &sec, &nsec); err != nil {
+				return nil, err
+			}
+			sinceNano = sec*int64(time.Second) + nsec
+		}
+
+		buf := &bytes.Buffer{}
+		enc := json.NewEncoder(buf)
+		sent := 0
+		for _, e := range events {
+			if e.TimeNano >= sinceNano && sent < 3 {
+				enc.Encode(e)
+				sent++
+			}
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(buf),
+		}, nil
+	})
+
+	client := &APIClient{HTTPCli: httpClient}
+	client.SetRetryPolicy(&RetryPolicy{InitialBackoff: time.Millisecond})
+
+	messages, errCh := client.EventsStream(context.Background(), "", filters.NewArgs())
+
+	var actions []string
+	for msg := range messages {
+		actions = append(actions, msg.Action)
+	}
+	assert.Equal(t, []string{"create", "start", "stop", "die"}, actions)
+	assert.Equal(t, []string{"", "2.000000002", "3.000000003", "3.000000003"}, requests)
+	assert.True(t, IsInvalidParam(<-errCh))
+}
+
+func TestEventsStreamCancel(t *testing.T) {
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("connection refused")
+	})
+
+	client := &APIClient{HTTPCli: httpClient}
+	client.SetRetryPolicy(&RetryPolicy{InitialBackoff: time.Millisecond})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	messages, errCh := client.EventsStream(ctx, "", filters.NewArgs())
+	for range messages {
+	}
+	assert.Equal(t, context.DeadlineExceeded, <-errCh)
+}
@@ -1045,17 +1045,38 @@ func (c *CriManager) getContainerMetrics(ctx context.Context, meta *mgr.Containe
 		return nil, fmt.Errorf("failed to get stats of container %q: %v", meta.ID, err)
 	}
 
-	if metricsMeta != nil {
-		if metrics.CPU != nil && metrics.CPU.Usage != nil {
+	if metricsMeta != nil && metrics.V1 != nil {
+		if metrics.V1.CPU != nil && metrics.V1.CPU.Usage != nil {
 			cs.Cpu = &runtime.CpuUsage{
 				Timestamp:            metricsMeta.Timestamp.UnixNano(),
-				UsageCoreNanoSeconds: &runtime.UInt64Value{Value: metrics.CPU.Usage.Total},
+				UsageCoreNanoSeconds: &runtime.UInt64Value{Value: metrics.V1.CPU.Usage.Total},
 			}
 		}
-		if metrics.Memory != nil && metrics.Memory.Usage != nil {
+		if metrics.V1.Memory != nil && metrics.V1.Memory.Usage != nil {
 			cs.Memory = &runtime.MemoryUsage{
 				Timestamp:       metricsMeta.Timestamp.UnixNano(),
-				WorkingSetBytes: &runtime.UInt64Value{Value: metrics.Memory.Usage.Usage},
+				WorkingSetBytes: &runtime.UInt64Value{Value: metrics.V1.Memory.Usage.Usage},
+			}
+		}
+	}
+
+	if metricsMeta != nil && metrics.V2 != nil {
+		if metrics.V2.CPU != nil {
+			cs.Cpu = &runtime.CpuUsage{
+				Timestamp:            metricsMeta.Timestamp.UnixNano(),
+				UsageCoreNanoSeconds: &runtime.UInt64Value{Value: metrics.V2.CPU.UsageUsec * 1000},
+			}
+		}
+		if metrics.V2.Memory != nil {
+			// working set is the usage without the inactive file cache,
+			// which can be reclaimed easily.
+			workingSet := metrics.V2.Memory.Usage
+			if inactive := metrics.V2.Memory.Stats["inactive_file"]; inactive < workingSet {
+				workingSet -= inactive
+			}
+			cs.Memory = &runtime.MemoryUsage{
+				Timestamp:       metricsMeta.Timestamp.UnixNano(),
+				WorkingSetBytes: &runtime.UInt64Value{Value: workingSet},
 			}
 		}
 	}
@@ -27,6 +27,7 @@ func NewService(cfg *config.Config, criMgr CriMgr) (*Service, error) {
 		server: grpc.NewServer(
 			grpc.StreamInterceptor(metrics.GRPCMetrics.StreamServerInterceptor()),
 			interceptor.WithUnaryServerChain(
+				interceptor.TracingUnaryServerInterceptor(),
 				metrics.GRPCMetrics.UnaryServerInterceptor(),
 				interceptor.PayloadUnaryServerInterceptor(criLogLevelDecider),
 			),
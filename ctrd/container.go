@@ -16,9 +16,11 @@ import (
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/ioutils"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/trace"
 	"github.com/sirupsen/logrus"
 
 	"github.com/containerd/containerd"
+	tasks "github.com/containerd/containerd/api/services/tasks/v1"
 	containerdtypes "github.com/containerd/containerd/api/types"
 	"github.com/containerd/containerd/archive"
 	"github.com/containerd/containerd/cio"
@@ -90,7 +92,15 @@ func (c *Client) containerStats(ctx context.Context, id string) (*containerdtype
 }
 
 // ExecContainer executes a process in container.
-func (c *Client) ExecContainer(ctx context.Context, process *Process, timeout int) error {
+func (c *Client) ExecContainer(ctx context.Context, process *Process, timeout int) (err0 error) {
+	ctx, span := startSpan(ctx, "ExecContainer",
+		trace.WithAttribute("container.id", process.ContainerID),
+		trace.WithAttribute("exec.id", process.ExecID),
+	)
+	defer func() {
+		span.Finish(err0)
+	}()
+
 	if err := c.execContainer(ctx, process, timeout); err != nil {
 		return convertCtrdErr(err)
 	}
@@ -229,6 +239,21 @@ func (c *Client) ResizeExec(ctx context.Context, id string, execid string, opts
 	return execProcess.Resize(ctx, uint32(opts.Width), uint32(opts.Height))
 }
 
+// KillExec sends a signal to the exec process running in the container.
+func (c *Client) KillExec(ctx context.Context, id string, execid string, signal syscall.Signal) error {
+	pack, err := c.watch.get(id)
+	if err != nil {
+		return err
+	}
+
+	execProcess, err := pack.task.LoadProcess(ctx, execid, nil)
+	if err != nil {
+		return convertCtrdErr(err)
+	}
+
+	return convertCtrdErr(execProcess.Kill(ctx, signal))
+}
+
 // ContainerPID returns the container's init process id.
 func (c *Client) ContainerPID(ctx context.Context, id string) (int, error) {
 	pid, err := c.containerPID(ctx, id)
@@ -395,7 +420,12 @@ func (c *Client) recoverContainer(ctx context.Context, id string, io *containeri
 }
 
 // DestroyContainer kill container and delete it.
-func (c *Client) DestroyContainer(ctx context.Context, id string, timeout int64) (*Message, error) {
+func (c *Client) DestroyContainer(ctx context.Context, id string, timeout int64) (_ *Message, err0 error) {
+	ctx, span := startSpan(ctx, "DestroyContainer", trace.WithAttribute("container.id", id))
+	defer func() {
+		span.Finish(err0)
+	}()
+
 	msg, err := c.destroyContainer(ctx, id, timeout)
 	if err != nil {
 		return msg, convertCtrdErr(err)
@@ -443,7 +473,7 @@ func (c *Client) destroyContainer(ctx context.Context, id string, timeout int64)
 	var msg *Message
 
 	// TODO: set task request timeout by context timeout
-	if err := pack.task.Kill(ctx, syscall.SIGTERM, containerd.WithKillAll); err != nil {
+	if err := pack.task.Kill(ctx, GetStopSignal(ctx), containerd.WithKillAll); err != nil {
 		if !errdefs.IsNotFound(err) {
 			return nil, errors.Wrap(err, "failed to kill task")
 		}
@@ -556,7 +586,12 @@ func (c *Client) unpauseContainer(ctx context.Context, id string) error {
 }
 
 // CreateContainer create container and start process.
-func (c *Client) CreateContainer(ctx context.Context, container *Container, checkpointDir string) error {
+func (c *Client) CreateContainer(ctx context.Context, container *Container, checkpointDir string) (err0 error) {
+	ctx, span := startSpan(ctx, "CreateContainer", trace.WithAttribute("container.id", container.ID))
+	defer func() {
+		span.Finish(err0)
+	}()
+
 	var (
 		ref = container.Image
 		id  = container.ID
@@ -651,6 +686,11 @@ func (c *Client) createContainer(ctx context.Context, ref, id, checkpointDir str
 }
 
 func (c *Client) createTask(ctx context.Context, id, checkpointDir string, container containerd.Container, cc *Container, client *containerd.Client) (p *containerPack, err0 error) {
+	ctx, span := startSpan(ctx, "CreateTask", trace.WithAttribute("container.id", id))
+	defer func() {
+		span.Finish(err0)
+	}()
+
 	var pack *containerPack
 
 	checkpoint, err := createCheckpointDescriptor(ctx, checkpointDir, client)
@@ -818,6 +858,120 @@ func (c *Client) waitContainer(ctx context.Context, id string) (types.ContainerW
 	}, nil
 }
 
+// ListContainers returns all the containers in containerd with the status of their tasks.
+func (c *Client) ListContainers(ctx context.Context) ([]ContainerInfo, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	containers, err := wrapperCli.client.Containers(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list containers")
+	}
+
+	resp, err := wrapperCli.client.TaskService().List(ctx, &tasks.ListTasksRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list tasks")
+	}
+	status := make(map[string]string, len(resp.Tasks))
+	for _, t := range resp.Tasks {
+		status[t.ID] = strings.ToLower(t.Status.String())
+	}
+
+	list := make([]ContainerInfo, 0, len(containers))
+	for _, nc := range containers {
+		info, err := nc.Info(ctx)
+		if err != nil {
+			// the container may be deleted during listing.
+			if errdefs.IsNotFound(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to get info of container %s", nc.ID())
+		}
+
+		list = append(list, ContainerInfo{
+			ID:          info.ID,
+			Snapshotter: info.Snapshotter,
+			SnapshotKey: info.SnapshotKey,
+			CreatedAt:   info.CreatedAt,
+			TaskStatus:  status[info.ID],
+		})
+	}
+	return list, nil
+}
+
+// GetContainerInfo returns the container in containerd with the status of its task.
+func (c *Client) GetContainerInfo(ctx context.Context, id string) (ContainerInfo, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return ContainerInfo{}, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	nc, err := wrapperCli.client.LoadContainer(ctx, id)
+	if err != nil {
+		return ContainerInfo{}, convertCtrdErr(err)
+	}
+	info, err := nc.Info(ctx)
+	if err != nil {
+		return ContainerInfo{}, convertCtrdErr(err)
+	}
+
+	status := ""
+	task, err := nc.Task(ctx, nil)
+	if err != nil && !errdefs.IsNotFound(err) {
+		return ContainerInfo{}, errors.Wrapf(err, "failed to get task of container %s", id)
+	}
+	if err == nil {
+		s, err := task.Status(ctx)
+		if err != nil && !errdefs.IsNotFound(err) {
+			return ContainerInfo{}, errors.Wrapf(err, "failed to get status of task %s", id)
+		}
+		status = string(s.Status)
+	}
+
+	return ContainerInfo{
+		ID:          info.ID,
+		Snapshotter: info.Snapshotter,
+		SnapshotKey: info.SnapshotKey,
+		CreatedAt:   info.CreatedAt,
+		TaskStatus:  status,
+	}, nil
+}
+
+// DeleteContainer deletes the container and its task in containerd which are
+// not watched by pouchd, such as the ones left by a crash.
+func (c *Client) DeleteContainer(ctx context.Context, id string) error {
+	if _, err := c.watch.get(id); err == nil {
+		return errors.Wrapf(errtypes.ErrConflict, "container %s is watched by pouchd", id)
+	}
+
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	nc, err := wrapperCli.client.LoadContainer(ctx, id)
+	if err != nil {
+		return convertCtrdErr(err)
+	}
+
+	task, err := nc.Task(ctx, nil)
+	if err != nil && !errdefs.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to get task of container %s", id)
+	}
+	if err == nil {
+		if _, err := task.Delete(ctx, containerd.WithProcessKill); err != nil && !errdefs.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete task of container %s", id)
+		}
+	}
+
+	if err := nc.Delete(ctx); err != nil && !errdefs.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete container %s", id)
+	}
+	return nil
+}
+
 // CreateCheckpoint create a checkpoint from a running container
 func (c *Client) CreateCheckpoint(ctx context.Context, id string, checkpointDir string, exit bool) error {
 	pack, err := c.watch.get(id)
@@ -1,6 +1,8 @@
 package ctrd
 
 import (
+	"time"
+
 	"github.com/alibaba/pouch/daemon/containerio"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
@@ -30,6 +32,21 @@ type Container struct {
 	UseSystemd bool
 }
 
+// ContainerInfo is the brief of a container in containerd, it's used to check
+// the consistency between pouchd and containerd.
+type ContainerInfo struct {
+	ID          string
+	Snapshotter string
+	SnapshotKey string
+
+	// CreatedAt is the time when the container is created in containerd.
+	CreatedAt time.Time
+
+	// TaskStatus is the status of container's task, such as running and
+	// stopped, it's empty if the container has no task.
+	TaskStatus string
+}
+
 // Process wraps exec process's info.
 type Process struct {
 	ContainerID string
@@ -12,6 +12,7 @@ import (
 	"github.com/alibaba/pouch/pkg/jsonstream"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/reference"
+	"github.com/alibaba/pouch/pkg/trace"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
@@ -252,7 +253,12 @@ func (c *Client) PushImage(ctx context.Context, ref string, authConfig *types.Au
 }
 
 // ResolveImage attempts to resolve the image reference into a available reference and resolver.
-func (c *Client) ResolveImage(ctx context.Context, nameRef string, refs []string, authConfig *types.AuthConfig, opts docker.ResolverOptions) (remotes.Resolver, string, error) {
+func (c *Client) ResolveImage(ctx context.Context, nameRef string, refs []string, authConfig *types.AuthConfig, opts docker.ResolverOptions) (_ remotes.Resolver, _ string, err0 error) {
+	ctx, span := startSpan(ctx, "ResolveImage", trace.WithAttribute("image.ref", nameRef))
+	defer func() {
+		span.Finish(err0)
+	}()
+
 	resolver, availableRef, err := c.getResolver(ctx, authConfig, nameRef, refs, opts)
 	if err != nil {
 		return nil, "", err
@@ -262,7 +268,12 @@ func (c *Client) ResolveImage(ctx context.Context, nameRef string, refs []string
 }
 
 // FetchImage fetches image content from the remote repository.
-func (c *Client) FetchImage(ctx context.Context, resolver remotes.Resolver, availableRef string, authConfig *types.AuthConfig, stream *jsonstream.JSONStream) (containerd.Image, error) {
+func (c *Client) FetchImage(ctx context.Context, resolver remotes.Resolver, availableRef string, authConfig *types.AuthConfig, stream *jsonstream.JSONStream) (_ containerd.Image, err0 error) {
+	ctx, span := startSpan(ctx, "FetchImage", trace.WithAttribute("image.ref", availableRef))
+	defer func() {
+		span.Finish(err0)
+	}()
+
 	wrapperCli, err := c.Get(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
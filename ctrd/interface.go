@@ -3,6 +3,7 @@ package ctrd
 import (
 	"context"
 	"io"
+	"syscall"
 	"time"
 
 	"github.com/alibaba/pouch/apis/types"
@@ -50,6 +51,8 @@ type ContainerAPIClient interface {
 	// ResizeContainer changes the size of the TTY of the exec process running
 	// in the container to the given height and width.
 	ResizeExec(ctx context.Context, id string, execid string, opts types.ResizeOptions) error
+	// KillExec sends a signal to the exec process running in the container.
+	KillExec(ctx context.Context, id string, execid string, signal syscall.Signal) error
 	// RecoverContainer reload the container from metadata and watch it, if program be restarted.
 	RecoverContainer(ctx context.Context, id string, io *containerio.IO) error
 	// PauseContainer pause container.
@@ -69,6 +72,12 @@ type ContainerAPIClient interface {
 	SetExecExitHooks(hooks ...func(string, *Message) error)
 	// SetEventsHooks specified the methods to handle the containerd events.
 	SetEventsHooks(hooks ...func(context.Context, string, string, map[string]string) error)
+	// ListContainers returns all the containers in containerd with the status of their tasks.
+	ListContainers(ctx context.Context) ([]ContainerInfo, error)
+	// GetContainerInfo returns the container in containerd with the status of its task.
+	GetContainerInfo(ctx context.Context, id string) (ContainerInfo, error)
+	// DeleteContainer deletes the container and its task in containerd which are not watched by pouchd.
+	DeleteContainer(ctx context.Context, id string) error
 }
 
 // ImageAPIClient provides access to containerd image features.
@@ -112,6 +121,10 @@ type SnapshotAPIClient interface {
 	// WalkSnapshot walk all snapshots in specific snapshotter. If not set specific snapshotter,
 	// it will be set to current snapshotter. For each snapshot, the function will be called.
 	WalkSnapshot(ctx context.Context, snapshotter string, fn func(context.Context, snapshots.Info) error) error
+	// ExportSnapshotDiff writes the changes of the active snapshot over its parent into w as a tar stream.
+	ExportSnapshotDiff(ctx context.Context, id string, w io.Writer) error
+	// ApplySnapshotDiff applies the tar stream of changes written by ExportSnapshotDiff to the active snapshot.
+	ApplySnapshotDiff(ctx context.Context, id string, r io.Reader) error
 	// CreateCheckpoint creates a checkpoint from a running container
 	CreateCheckpoint(ctx context.Context, id string, checkpointDir string, exit bool) error
 }
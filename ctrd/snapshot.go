@@ -3,8 +3,12 @@ package ctrd
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/trace"
+	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/containerd/containerd/archive"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/leases"
 	"github.com/containerd/containerd/mount"
@@ -34,7 +38,15 @@ func CurrentSnapshotterName(ctx context.Context) string {
 }
 
 // CreateSnapshot creates a active snapshot with image's name and id.
-func (c *Client) CreateSnapshot(ctx context.Context, id, ref string) error {
+func (c *Client) CreateSnapshot(ctx context.Context, id, ref string) (err0 error) {
+	ctx, span := startSpan(ctx, "CreateSnapshot",
+		trace.WithAttribute("snapshot.id", id),
+		trace.WithAttribute("image.ref", ref),
+	)
+	defer func() {
+		span.Finish(err0)
+	}()
+
 	wrapperCli, err := c.Get(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
@@ -167,3 +179,63 @@ func (c *Client) WalkSnapshot(ctx context.Context, snapshotter string, fn func(c
 
 	return service.Walk(ctx, fn)
 }
+
+// ExportSnapshotDiff writes the changes of the active snapshot over its
+// parent into w as a tar stream, the deleted files are written as whiteouts.
+func (c *Client) ExportSnapshotDiff(ctx context.Context, id string, w io.Writer) error {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	ctx = leases.WithLease(ctx, wrapperCli.lease.ID)
+
+	service := wrapperCli.client.SnapshotService(CurrentSnapshotterName(ctx))
+	defer service.Close()
+
+	info, err := service.Stat(ctx, id)
+	if err != nil {
+		return err
+	}
+	if info.Kind != snapshots.KindActive {
+		return fmt.Errorf("snapshot %s is not active", id)
+	}
+
+	upper, err := service.Mounts(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var lower []mount.Mount
+	if info.Parent != "" {
+		lowerKey := fmt.Sprintf("%s-parent-view-%s", info.Parent, utils.RandString(5, "", ""))
+		if lower, err = service.View(ctx, lowerKey, info.Parent); err != nil {
+			return err
+		}
+		defer func() {
+			if err := service.Remove(context.TODO(), lowerKey); err != nil {
+				log.With(ctx).Warnf("failed to cleanup diff lower snapshot(key=%s): %v", lowerKey, err)
+			}
+		}()
+	}
+
+	return mount.WithTempMount(ctx, lower, func(lowerRoot string) error {
+		return mount.WithTempMount(ctx, upper, func(upperRoot string) error {
+			return archive.WriteDiff(ctx, w, lowerRoot, upperRoot)
+		})
+	})
+}
+
+// ApplySnapshotDiff applies the tar stream of changes written by
+// ExportSnapshotDiff to the active snapshot.
+func (c *Client) ApplySnapshotDiff(ctx context.Context, id string, r io.Reader) error {
+	mounts, err := c.GetMounts(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return mount.WithTempMount(ctx, mounts, func(root string) error {
+		_, err := archive.Apply(ctx, root, r)
+		return err
+	})
+}
@@ -0,0 +1,31 @@
+package ctrd
+
+import (
+	"context"
+	"syscall"
+
+	"github.com/containerd/containerd"
+)
+
+type stopSignalKey struct{}
+
+// WithStopSignal sets the signal used to stop the container for context,
+// the signal is in format of name or number, such as SIGINT or 2.
+func WithStopSignal(ctx context.Context, signal string) context.Context {
+	return context.WithValue(ctx, stopSignalKey{}, signal)
+}
+
+// GetStopSignal gets the signal used to stop the container from context,
+// SIGTERM is returned if not set or invalid.
+func GetStopSignal(ctx context.Context) syscall.Signal {
+	signal, _ := ctx.Value(stopSignalKey{}).(string)
+	if signal == "" {
+		return syscall.SIGTERM
+	}
+
+	sig, err := containerd.ParseSignal(signal)
+	if err != nil {
+		return syscall.SIGTERM
+	}
+	return sig
+}
@@ -0,0 +1,24 @@
+package ctrd
+
+import (
+	"context"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetStopSignal(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, syscall.SIGTERM, GetStopSignal(ctx))
+
+	for signal, expected := range map[string]syscall.Signal{
+		"":        syscall.SIGTERM,
+		"SIGINT":  syscall.SIGINT,
+		"SIGQUIT": syscall.SIGQUIT,
+		"9":       syscall.SIGKILL,
+		"invalid": syscall.SIGTERM,
+	} {
+		assert.Equal(t, expected, GetStopSignal(WithStopSignal(ctx, signal)), signal)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/reference"
+	"github.com/alibaba/pouch/pkg/trace"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
@@ -24,6 +25,13 @@ import (
 	"github.com/pkg/errors"
 )
 
+// startSpan starts a client span of the call to containerd, and passes the
+// trace context to containerd in grpc metadata.
+func startSpan(ctx context.Context, name string, opts ...trace.SpanOption) (context.Context, *trace.Span) {
+	ctx, span := trace.StartSpan(ctx, "containerd."+name, append(opts, trace.WithKind(trace.SpanKindClient))...)
+	return trace.InjectGRPC(ctx), span
+}
+
 func withExitShimV1CheckpointTaskOpts() containerd.CheckpointTaskOpts {
 	return func(r *containerd.CheckpointTaskInfo) error {
 		r.Options = &runctypes.CheckpointOptions{
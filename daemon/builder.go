@@ -19,10 +19,12 @@ func (d *Daemon) runBuilderServer() error {
 	cfg.ContainerdWorker.Address = d.config.ContainerdAddr
 	cfg.ContainerdWorker.Namespace = d.config.DefaultNamespace
 	cfg.ContainerdWorker.Snapshotter = d.config.Snapshotter
+	cfg.ContainerdWorker.DiskQuota = d.config.BuilderDiskQuota
 
 	bs, err := builder.New(&builder.Options{
 		Config:              cfg,
 		PostImageExportFunc: d.postBuildExporter(),
+		ContainerRunner:     d.containerMgr,
 	})
 	if err != nil {
 		return err
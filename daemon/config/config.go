@@ -14,8 +14,13 @@ import (
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/client"
 	criconfig "github.com/alibaba/pouch/cri/config"
+	"github.com/alibaba/pouch/daemon/events"
+	"github.com/alibaba/pouch/hookplugins/remote"
 	"github.com/alibaba/pouch/network"
+	"github.com/alibaba/pouch/pkg/admission"
+	"github.com/alibaba/pouch/pkg/audit"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/trace"
 	"github.com/alibaba/pouch/pkg/utils"
 	"github.com/alibaba/pouch/storage/volume"
 
@@ -29,6 +34,10 @@ const (
 	CgroupSystemdDriver = "systemd"
 	// DefaultCgroupDriver is default cgroups driver
 	DefaultCgroupDriver = CgroupfsDriver
+	// ShutdownModeLiveRestore keeps containers running when pouchd stops
+	ShutdownModeLiveRestore = "live-restore"
+	// ShutdownModeStop stops containers when pouchd stops
+	ShutdownModeStop = "stop"
 	// ValidNameChars collects the characters allowed to represent a name, normally used to validate container and volume names.
 	ValidNameChars = `[a-zA-Z0-9][a-zA-Z0-9_.-]`
 )
@@ -55,6 +64,10 @@ type Config struct {
 	// Server listening address.
 	Listen []string `json:"listen,omitempty"`
 
+	// GRPCListen is the listening addresses of gRPC API, it's disabled if
+	// empty.
+	GRPCListen []string `json:"grpc-listen,omitempty"`
+
 	// Debug refers to the log mode.
 	Debug bool `json:"debug,omitempty"`
 
@@ -141,6 +154,53 @@ type Config struct {
 	// EnableBuilder enable builder functionality
 	EnableBuilder bool `json:"enable-builder,omitempty"`
 
+	// CDISpecDirs is the directories to load Container Device Interface specs
+	CDISpecDirs []string `json:"cdi-spec-dirs,omitempty"`
+
+	// BuilderDiskQuota limits the rootfs size of the build step container
+	BuilderDiskQuota string `json:"builder-disk-quota,omitempty"`
+
+	// EnableContainerMetrics specifies whether to export per-container metrics
+	EnableContainerMetrics bool `json:"enable-container-metrics,omitempty"`
+
+	// ContainerMetricsCollectPeriod specifies the time duration (in time.Second) to collect per-container metrics
+	ContainerMetricsCollectPeriod int `json:"container-metrics-collect-period,omitempty"`
+
+	// ContainerMetricsLabels is the container labels exported as the labels of per-container metrics
+	ContainerMetricsLabels []string `json:"container-metrics-labels,omitempty"`
+
+	// ShutdownMode specifies what to do with the running containers when pouchd stops, live-restore or stop
+	ShutdownMode string `json:"shutdown-mode,omitempty"`
+
+	// ShutdownTimeout specifies the time duration (in time.Second) to stop all the containers when pouchd stops
+	ShutdownTimeout int `json:"shutdown-timeout,omitempty"`
+
+	// SecretKeyFile is the key file to encrypt the secrets at rest, it is
+	// generated if it doesn't exist
+	SecretKeyFile string `json:"secret-key-file,omitempty"`
+
+	// MetaStoreDriver is the backend of container meta store, local or boltdb
+	MetaStoreDriver string `json:"meta-store-driver,omitempty"`
+
+	// Audit is the configuration of API audit log
+	Audit audit.Config `json:"audit-config,omitempty"`
+
+	// AdmissionControl is the concurrency limits of API requests, it can
+	// only be set in config file
+	AdmissionControl admission.Config `json:"admission-control,omitempty"`
+
+	// EventWebhooks are the webhooks which the daemon events are pushed to,
+	// they can only be set in config file
+	EventWebhooks []events.WebhookConfig `json:"event-webhooks,omitempty"`
+
+	// Tracing is the configuration of exporting the spans of requests to
+	// an OTLP endpoint
+	Tracing trace.Config `json:"tracing,omitempty"`
+
+	// HookPlugins are the out-of-process hook plugins, they can only be set
+	// in config file
+	HookPlugins []remote.PluginConfig `json:"hook-plugins,omitempty"`
+
 	// MachineMemory is the memory limit for a host.
 	MachineMemory uint64 `json:"-"`
 }
@@ -157,12 +217,13 @@ func (cfg *Config) UseSystemd() bool {
 
 // Validate validates the user input config.
 func (cfg *Config) Validate() error {
-	// for debug config file.
+	// for debug config file, the secrets of event webhooks are redacted.
 	b, _ := json.Marshal(cfg)
-	log.With(nil).Debugf("daemon config: (%s)", string(b))
+	log.With(nil).Debugf("daemon config: (%s)", strings.TrimSpace(string(audit.Redact(b))))
 
 	// deduplicated elements in slice if there is any.
 	cfg.Listen = utils.DeDuplicate(cfg.Listen)
+	cfg.GRPCListen = utils.DeDuplicate(cfg.GRPCListen)
 	cfg.Labels = utils.DeDuplicate(cfg.Labels)
 
 	for _, label := range cfg.Labels {
@@ -186,6 +247,46 @@ func (cfg *Config) Validate() error {
 		cfg.Runtimes[cfg.DefaultRuntime] = types.Runtime{Path: cfg.DefaultRuntime}
 	}
 
+	switch cfg.ShutdownMode {
+	case "":
+		cfg.ShutdownMode = ShutdownModeLiveRestore
+	case ShutdownModeLiveRestore, ShutdownModeStop:
+	default:
+		return fmt.Errorf("invalid shutdown mode %s, should be %s or %s", cfg.ShutdownMode, ShutdownModeLiveRestore, ShutdownModeStop)
+	}
+
+	if err := cfg.AdmissionControl.Validate(); err != nil {
+		return err
+	}
+
+	names := make(map[string]bool, len(cfg.EventWebhooks))
+	for i := range cfg.EventWebhooks {
+		webhook := &cfg.EventWebhooks[i]
+		if err := webhook.Validate(); err != nil {
+			return err
+		}
+		if names[webhook.Name] {
+			return fmt.Errorf("duplicate event webhook name %s", webhook.Name)
+		}
+		names[webhook.Name] = true
+	}
+
+	if err := cfg.Tracing.Validate(); err != nil {
+		return err
+	}
+
+	names = make(map[string]bool, len(cfg.HookPlugins))
+	for i := range cfg.HookPlugins {
+		plugin := &cfg.HookPlugins[i]
+		if err := plugin.Validate(); err != nil {
+			return err
+		}
+		if names[plugin.Name] {
+			return fmt.Errorf("duplicate hook plugin name %s", plugin.Name)
+		}
+		names[plugin.Name] = true
+	}
+
 	// if cgroup driver is empty, use default cgroup driver
 	if cfg.CgroupDriver == "" {
 		cfg.CgroupDriver = DefaultCgroupDriver
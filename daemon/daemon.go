@@ -3,9 +3,11 @@ package daemon
 import (
 	"context"
 	"fmt"
+	"os"
 	"path"
 	"path/filepath"
 	"reflect"
+	"time"
 
 	"github.com/alibaba/pouch/apis/server"
 	criservice "github.com/alibaba/pouch/cri"
@@ -16,11 +18,14 @@ import (
 	"github.com/alibaba/pouch/daemon/events"
 	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/hookplugins"
+	"github.com/alibaba/pouch/hookplugins/remote"
 	"github.com/alibaba/pouch/internal"
 	"github.com/alibaba/pouch/network/mode"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/meta"
 	"github.com/alibaba/pouch/pkg/system"
+	"github.com/alibaba/pouch/pkg/trace"
+	"github.com/alibaba/pouch/storage/volume"
 
 	systemddaemon "github.com/coreos/go-systemd/daemon"
 	systemdutil "github.com/coreos/go-systemd/util"
@@ -42,6 +47,8 @@ type Daemon struct {
 	imageMgr        mgr.ImageMgr
 	volumeMgr       mgr.VolumeMgr
 	networkMgr      mgr.NetworkMgr
+	podMgr          mgr.PodMgr
+	secretMgr       mgr.SecretMgr
 	server          server.Server
 	containerPlugin hookplugins.ContainerPlugin
 	imagePlugin     hookplugins.ImagePlugin
@@ -49,21 +56,13 @@ type Daemon struct {
 	volumePlugin    hookplugins.VolumePlugin
 	criPlugin       hookplugins.CriPlugin
 	apiPlugin       hookplugins.APIPlugin
+	remotePlugins   []*remote.Plugin
 	eventsService   *events.Events
 }
 
 // NewDaemon constructs a brand new server.
 func NewDaemon(cfg *config.Config) *Daemon {
-	containerStore, err := meta.NewStore(meta.Config{
-		Driver:  "local",
-		BaseDir: path.Join(cfg.HomeDir, "containers"),
-		Buckets: []meta.Bucket{
-			{
-				Name: meta.MetaJSONFile,
-				Type: reflect.TypeOf(mgr.Container{}),
-			},
-		},
-	})
+	containerStore, err := meta.NewStore(ContainerMetaConfig(cfg.HomeDir, cfg.MetaStoreDriver))
 	if err != nil {
 		log.With(nil).Errorf("failed to create container meta store: %v", err)
 		return nil
@@ -158,6 +157,11 @@ func (d *Daemon) loadPlugin() error {
 		d.apiPlugin = apiPlugin
 	}
 
+	// chain the out-of-process hook plugins after the in-process ones
+	if err = d.loadRemotePlugins(); err != nil {
+		return err
+	}
+
 	if d.daemonPlugin != nil {
 		log.With(nil).Infof("invoke pre-start hook in plugin")
 		if err = d.daemonPlugin.PreStartHook(); err != nil {
@@ -168,6 +172,43 @@ func (d *Daemon) loadPlugin() error {
 	return nil
 }
 
+// loadRemotePlugins connects to the out-of-process hook plugins in config, and
+// chains them in priority order after the in-process plugins.
+func (d *Daemon) loadRemotePlugins() error {
+	plugins, err := remote.NewPlugins(d.config.HookPlugins)
+	if err != nil {
+		return err
+	}
+	d.remotePlugins = plugins
+
+	var (
+		containerPlugins = []hookplugins.ContainerPlugin{d.containerPlugin}
+		imagePlugins     = []hookplugins.ImagePlugin{d.imagePlugin}
+		volumePlugins    = []hookplugins.VolumePlugin{d.volumePlugin}
+		criPlugins       = []hookplugins.CriPlugin{d.criPlugin}
+		apiPlugins       = []hookplugins.APIPlugin{d.apiPlugin}
+	)
+	for _, p := range plugins {
+		log.With(nil).Infof("load hook plugin %s", p.Name())
+		containerPlugins = append(containerPlugins, p)
+		imagePlugins = append(imagePlugins, p)
+		volumePlugins = append(volumePlugins, p)
+		criPlugins = append(criPlugins, p)
+	}
+	// the handler wrapped by the last api plugin receives the request first,
+	// so the remote plugins are chained in reverse order of priority.
+	for i := len(plugins) - 1; i >= 0; i-- {
+		apiPlugins = append(apiPlugins, plugins[i])
+	}
+
+	d.containerPlugin = hookplugins.NewContainerPluginChain(containerPlugins...)
+	d.imagePlugin = hookplugins.NewImagePluginChain(imagePlugins...)
+	d.volumePlugin = hookplugins.NewVolumePluginChain(volumePlugins...)
+	d.criPlugin = hookplugins.NewCriPluginChain(criPlugins...)
+	d.apiPlugin = hookplugins.NewAPIPluginChain(apiPlugins...)
+	return nil
+}
+
 // Run starts daemon.
 func (d *Daemon) Run() error {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -182,7 +223,18 @@ func (d *Daemon) Run() error {
 		return err
 	}
 
+	if err := trace.Init(d.config.Tracing); err != nil {
+		return fmt.Errorf("failed to init tracing: %v", err)
+	}
+
 	d.eventsService = events.NewEvents()
+	for _, webhookConfig := range d.config.EventWebhooks {
+		webhook, err := events.NewWebhook(webhookConfig, path.Join(d.config.HomeDir, "events", "webhooks"))
+		if err != nil {
+			return fmt.Errorf("failed to create event webhook %s: %v", webhookConfig.Name, err)
+		}
+		d.eventsService.AddWebhook(webhook)
+	}
 
 	imageMgr, err := internal.GenImageMgr(d.config, d)
 	if err != nil {
@@ -221,6 +273,26 @@ func (d *Daemon) Run() error {
 	d.networkMgr = networkMgr
 	containerMgr.(*mgr.ContainerManager).NetworkMgr = networkMgr
 
+	podMgr, err := internal.GenPodMgr(ctx, d.config, d)
+	if err != nil {
+		return err
+	}
+	d.podMgr = podMgr
+	containerMgr.(*mgr.ContainerManager).PodMgr = podMgr
+
+	secretMgr, err := internal.GenSecretMgr(d.config, d)
+	if err != nil {
+		return err
+	}
+	d.secretMgr = secretMgr
+	containerMgr.(*mgr.ContainerManager).SecretMgr = secretMgr
+
+	// the rw layers restored from backup are applied before containers
+	// are recovered.
+	if err := containerMgr.(*mgr.ContainerManager).ApplyRestoredRWLayers(context.Background()); err != nil {
+		return err
+	}
+
 	// after initialize network manager, try to recover all
 	// running containers
 	if err := containerMgr.Restore(context.Background()); err != nil {
@@ -255,8 +327,11 @@ func (d *Daemon) Run() error {
 		ImageMgr:        imageMgr,
 		VolumeMgr:       volumeMgr,
 		NetworkMgr:      networkMgr,
+		PodMgr:          podMgr,
+		SecretMgr:       secretMgr,
 		StreamRouter:    streamRouter,
 		ContainerPlugin: d.containerPlugin,
+		VolumePlugin:    d.volumePlugin,
 		APIPlugin:       d.apiPlugin,
 	}
 
@@ -315,6 +390,24 @@ func (d *Daemon) Shutdown() error {
 		errMsg = fmt.Sprintf("%s\n", err.Error())
 	}
 
+	if d.containerMgr != nil {
+		if err := d.containerMgr.Shutdown(context.Background()); err != nil {
+			log.With(nil).Errorf("failed to shutdown containers: %v", err)
+			errMsg = fmt.Sprintf("%s%s\n", errMsg, err.Error())
+		}
+	}
+
+	if err := d.eventsService.CloseWebhooks(); err != nil {
+		errMsg = fmt.Sprintf("%s%s\n", errMsg, err.Error())
+	}
+
+	// export the spans of the requests handled before shutdown.
+	traceCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	if err := trace.Shutdown(traceCtx); err != nil {
+		log.With(nil).Warnf("failed to export spans before shutdown: %v", err)
+	}
+	cancel()
+
 	log.With(nil).Debugf("Start cleanup containerd...")
 	if err := d.ctrdClient.Cleanup(); err != nil {
 		errMsg = fmt.Sprintf("%s\n", err.Error())
@@ -360,6 +453,67 @@ func (d *Daemon) Containerd() ctrd.APIClient {
 	return d.ctrdClient
 }
 
+// ContainerMetaConfig returns the config of container meta store in home
+// dir with the driver. The boltdb file is placed in the directory of local
+// store, so that the files of containers stay in the same place.
+func ContainerMetaConfig(homeDir, driver string) meta.Config {
+	baseDir := path.Join(homeDir, "containers")
+	if driver == "boltdb" {
+		baseDir = path.Join(baseDir, "meta.db")
+	}
+
+	return meta.Config{
+		Driver:  driver,
+		BaseDir: baseDir,
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(mgr.Container{}),
+			},
+		},
+		Schemas: []meta.Schema{
+			{
+				Bucket:     meta.MetaJSONFile,
+				Version:    mgr.ContainerSchemaVersion,
+				Migrations: mgr.ContainerMigrations,
+			},
+		},
+	}
+}
+
+// RestoreFrom rehydrates the state of pouchd in home dir from the backup
+// archive file written by 'pouch system backup', it must be called before
+// the daemon is created.
+func RestoreFrom(cfg *config.Config, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	networkMetaPath := cfg.NetworkConfig.MetaPath
+	if networkMetaPath == "" {
+		networkMetaPath = cfg.HomeDir
+	}
+
+	manifest, err := mgr.RestoreBackup(context.Background(), f, mgr.RestoreConfig{
+		HomeDir:       cfg.HomeDir,
+		ContainerMeta: ContainerMetaConfig(cfg.HomeDir, cfg.MetaStoreDriver),
+		VolumeMeta:    volume.MetaConfig(path.Join(cfg.HomeDir, "volume", "volume.db")),
+		PodMeta:       mgr.PodMetaConfig(cfg.HomeDir),
+		SecretMeta:    mgr.SecretMetaConfig(cfg.HomeDir),
+		SecretKeyFile: mgr.SecretKeyFile(cfg),
+		NetworkDB:     mgr.NetworkDBPath(networkMetaPath),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore from %s, clean home dir %s before retrying: %v", file, cfg.HomeDir, err)
+	}
+
+	log.With(nil).Infof("state of pouchd %s in %s backed up at %s is restored from %s",
+		manifest.PouchVersion, manifest.HomeDir, manifest.Created, file)
+	return nil
+}
+
 // MetaStore gets store of meta.
 func (d *Daemon) MetaStore() *meta.Store {
 	return d.containerStore
@@ -379,7 +533,8 @@ func (d *Daemon) ImagePlugin() hookplugins.ImagePlugin {
 	return d.imagePlugin
 }
 
-// ShutdownPlugin invoke pre-stop method in daemon plugin if exist
+// ShutdownPlugin invoke pre-stop method in daemon plugin if exist, and
+// closes the connections to hook plugins
 func (d *Daemon) ShutdownPlugin() error {
 	if d.daemonPlugin != nil {
 		log.With(nil).Infof("invoke pre-stop hook in plugin")
@@ -387,6 +542,12 @@ func (d *Daemon) ShutdownPlugin() error {
 			log.With(nil).Errorf("stop prehook execute error %v", err)
 		}
 	}
+
+	for _, p := range d.remotePlugins {
+		if err := p.Close(); err != nil {
+			log.With(nil).Errorf("failed to close hook plugin %s: %v", p.Name(), err)
+		}
+	}
 	return nil
 }
 
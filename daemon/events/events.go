@@ -2,6 +2,7 @@ package events
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
@@ -23,6 +24,10 @@ type Events struct {
 	// support buffered events message
 	events      []types.EventsMessage
 	broadcaster *goevents.Broadcaster
+
+	// webhooks are the sinks registered to broadcaster, which push events
+	// to webhooks.
+	webhooks []goevents.Sink
 }
 
 // NewEvents return a new Events instance
@@ -159,6 +164,49 @@ func (e *Events) Subscribe(ctx context.Context, since, until time.Time, ef *Filt
 	return buffered, evch, errq
 }
 
+// AddWebhook starts pushing the events matched by the filters of webhook to
+// it. The events are queued in memory before added to the batch of webhook,
+// so that publishing is never blocked by webhook.
+func (e *Events) AddWebhook(w *Webhook) {
+	var dst goevents.Sink = goevents.NewQueue(w)
+	if w.filter.filter.Len() > 0 {
+		dst = goevents.NewFilter(dst, goevents.MatcherFunc(func(gev goevents.Event) bool {
+			msg := gev.(*types.EventsMessage)
+			return w.filter.Match(*msg)
+		}))
+	}
+
+	e.mux.Lock()
+	e.webhooks = append(e.webhooks, dst)
+	e.mux.Unlock()
+
+	e.broadcaster.Add(dst)
+}
+
+// CloseWebhooks stops pushing events to webhooks, the events not delivered
+// yet are kept in spool of webhooks.
+func (e *Events) CloseWebhooks() error {
+	e.mux.Lock()
+	webhooks := e.webhooks
+	e.webhooks = nil
+	e.mux.Unlock()
+
+	var errs []string
+	for _, dst := range webhooks {
+		if err := e.broadcaster.Remove(dst); err != nil {
+			errs = append(errs, err.Error())
+		}
+		if err := dst.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Errorf("failed to close event webhooks: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
 // filterBufferedEvents iterates over the cached events in the buffer
 // and returns those that were emitted between two specific dates.
 func (e *Events) filterBufferedEvents(since, until time.Time, ef *Filter) []types.EventsMessage {
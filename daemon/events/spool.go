@@ -0,0 +1,162 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/pkg/errors"
+)
+
+const spoolFileSuffix = ".json"
+
+// spool is a bounded on-disk queue of event batches, every batch is stored as
+// a json file named by its sequence number, so that the batches survive the
+// restart of daemon and are delivered in order.
+type spool struct {
+	dir string
+	max int
+
+	mu   sync.Mutex
+	seqs []uint64
+	next uint64
+}
+
+// newSpool opens the spool in dir, and loads the batches left by last run.
+func newSpool(dir string, max int) (*spool, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create spool directory %s", dir)
+	}
+
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read spool directory %s", dir)
+	}
+
+	s := &spool{dir: dir, max: max}
+	for _, fi := range fis {
+		name := fi.Name()
+		if fi.IsDir() || !strings.HasSuffix(name, spoolFileSuffix) {
+			// remove the temporary file of interrupted write.
+			if strings.HasSuffix(name, ".tmp") {
+				os.Remove(filepath.Join(dir, name))
+			}
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, spoolFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		s.seqs = append(s.seqs, seq)
+	}
+
+	sort.Slice(s.seqs, func(i, j int) bool { return s.seqs[i] < s.seqs[j] })
+	if len(s.seqs) > 0 {
+		s.next = s.seqs[len(s.seqs)-1] + 1
+	}
+	return s, nil
+}
+
+func (s *spool) path(seq uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", seq, spoolFileSuffix))
+}
+
+// put appends a batch into spool. If the spool is full, the oldest batches
+// are discarded, and the number of discarded events is returned.
+func (s *spool) put(batch []types.EventsMessage) (int, error) {
+	data, err := json.Marshal(batch)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dropped := 0
+	for len(s.seqs) >= s.max {
+		oldest := s.seqs[0]
+		if old, err := s.read(oldest); err == nil {
+			dropped += len(old)
+		}
+		if err := os.Remove(s.path(oldest)); err != nil && !os.IsNotExist(err) {
+			return dropped, err
+		}
+		s.seqs = s.seqs[1:]
+	}
+
+	seq := s.next
+	tmp := s.path(seq) + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return dropped, err
+	}
+	if err := os.Rename(tmp, s.path(seq)); err != nil {
+		os.Remove(tmp)
+		return dropped, err
+	}
+
+	s.seqs = append(s.seqs, seq)
+	s.next++
+	return dropped, nil
+}
+
+// first returns the oldest batch in spool, ok is false if spool is empty.
+func (s *spool) first() (seq uint64, batch []types.EventsMessage, ok bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.seqs) == 0 {
+		return 0, nil, false, nil
+	}
+
+	seq = s.seqs[0]
+	batch, err = s.read(seq)
+	return seq, batch, true, err
+}
+
+func (s *spool) read(seq uint64) ([]types.EventsMessage, error) {
+	data, err := ioutil.ReadFile(s.path(seq))
+	if err != nil {
+		return nil, err
+	}
+
+	var batch []types.EventsMessage
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode spooled batch %d", seq)
+	}
+	return batch, nil
+}
+
+// remove removes the batch from spool, after it's delivered or discarded.
+func (s *spool) remove(seq uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, v := range s.seqs {
+		if v == seq {
+			s.seqs = append(s.seqs[:i], s.seqs[i+1:]...)
+			break
+		}
+	}
+
+	if err := os.Remove(s.path(seq)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// len returns the number of batches in spool.
+func (s *spool) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.seqs)
+}
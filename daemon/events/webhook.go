@@ -0,0 +1,411 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/metrics"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/log"
+
+	goevents "github.com/docker/go-events"
+	"github.com/pkg/errors"
+)
+
+const (
+	// WebhookSignatureHeader is the header carrying the HMAC-SHA256 signature
+	// of the request body, in the format sha256=<hex>.
+	WebhookSignatureHeader = "X-Pouch-Signature"
+
+	// WebhookEventCountHeader is the header carrying the number of events in batch.
+	WebhookEventCountHeader = "X-Pouch-Event-Count"
+
+	defaultWebhookBatchSize       = 100
+	defaultWebhookFlushInterval   = 1
+	defaultWebhookTimeout         = 10
+	defaultWebhookMaxBackoff      = 60
+	defaultWebhookMaxSpoolBatches = 1000
+)
+
+var (
+	// webhookMinBackoff is the interval to retry the first failed delivery,
+	// it doubles on each failure until the max backoff of webhook.
+	webhookMinBackoff = time.Second
+
+	validWebhookName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+	acceptedWebhookFilters = map[string]bool{
+		"event": true,
+		"type":  true,
+	}
+)
+
+// WebhookConfig is the configuration of a webhook which the events are pushed to.
+type WebhookConfig struct {
+	// Name identifies the webhook in logs and metrics, it's also the name of
+	// spool directory of webhook.
+	Name string `json:"name"`
+
+	// URL is the http(s) endpoint to post the batches of events to.
+	URL string `json:"url"`
+
+	// Filters selects the events to push, in the same syntax as the
+	// filters of events API, such as type=container or event=die.
+	Filters []string `json:"filters,omitempty"`
+
+	// Secret is the key to sign the request body by HMAC-SHA256.
+	Secret string `json:"secret,omitempty"`
+
+	// BatchSize is the max number of events in a batch.
+	BatchSize int `json:"batch-size,omitempty"`
+
+	// FlushInterval is the max seconds that an event waits for the batch to be full.
+	FlushInterval int `json:"flush-interval,omitempty"`
+
+	// Timeout is the timeout in seconds of each delivery.
+	Timeout int `json:"timeout,omitempty"`
+
+	// MaxBackoff is the max seconds to wait before retrying a failed delivery.
+	MaxBackoff int `json:"max-backoff,omitempty"`
+
+	// MaxSpoolBatches is the max number of batches kept on disk while the
+	// webhook is not available, the oldest batches are dropped beyond it.
+	MaxSpoolBatches int `json:"max-spool-batches,omitempty"`
+}
+
+// Validate validates the webhook config, and fills the default values.
+func (c *WebhookConfig) Validate() error {
+	if !validWebhookName.MatchString(c.Name) {
+		return fmt.Errorf("invalid event webhook name (%s), only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed", c.Name)
+	}
+
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url (%s) of event webhook %s, must be http(s)://host[:port]/path", c.URL, c.Name)
+	}
+
+	args, err := filters.FromFilterOpts(c.Filters)
+	if err != nil {
+		return errors.Wrapf(err, "invalid filters of event webhook %s", c.Name)
+	}
+	if err := args.Validate(acceptedWebhookFilters); err != nil {
+		return errors.Wrapf(err, "invalid filters of event webhook %s", c.Name)
+	}
+
+	for _, v := range []int{c.BatchSize, c.FlushInterval, c.Timeout, c.MaxBackoff, c.MaxSpoolBatches} {
+		if v < 0 {
+			return fmt.Errorf("batch-size, flush-interval, timeout, max-backoff and max-spool-batches of event webhook %s cannot be negative", c.Name)
+		}
+	}
+
+	if c.BatchSize == 0 {
+		c.BatchSize = defaultWebhookBatchSize
+	}
+	if c.FlushInterval == 0 {
+		c.FlushInterval = defaultWebhookFlushInterval
+	}
+	if c.Timeout == 0 {
+		c.Timeout = defaultWebhookTimeout
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = defaultWebhookMaxBackoff
+	}
+	if c.MaxSpoolBatches == 0 {
+		c.MaxSpoolBatches = defaultWebhookMaxSpoolBatches
+	}
+	return nil
+}
+
+// Webhook pushes the events to a http endpoint in json batches. The batches
+// are written into an on-disk spool before delivered, and the failed delivery
+// is retried with exponential backoff, so that the events are not lost when
+// the endpoint or daemon restarts.
+type Webhook struct {
+	config WebhookConfig
+	filter *Filter
+	client *http.Client
+	spool  *spool
+
+	mu      sync.Mutex
+	pending []types.EventsMessage
+	closed  bool
+
+	notify chan struct{}
+	stop   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewWebhook creates a webhook with the validated config, the batches are
+// spooled in <spoolRoot>/<name>.
+func NewWebhook(config WebhookConfig, spoolRoot string) (*Webhook, error) {
+	args, err := filters.FromFilterOpts(config.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := newSpool(filepath.Join(spoolRoot, config.Name), config.MaxSpoolBatches)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Webhook{
+		config: config,
+		filter: NewFilter(args),
+		client: &http.Client{Timeout: time.Duration(config.Timeout) * time.Second},
+		spool:  s,
+		notify: make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+	}
+	metrics.EventWebhookSpoolDepth.WithLabelValues(config.Name).Set(float64(s.len()))
+
+	w.wg.Add(2)
+	go w.flushLoop()
+	go w.deliverLoop()
+
+	return w, nil
+}
+
+// Write adds the event into the pending batch, it implements goevents.Sink.
+func (w *Webhook) Write(ev goevents.Event) error {
+	msg, ok := ev.(*types.EventsMessage)
+	if !ok {
+		return errors.Errorf("invalid message encountered %#v; please file a bug", ev)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return goevents.ErrSinkClosed
+	}
+
+	w.pending = append(w.pending, *msg)
+	if len(w.pending) >= w.config.BatchSize {
+		w.flushLocked()
+	}
+	return nil
+}
+
+// Close stops delivering, the pending events are kept in spool and are
+// delivered on next start.
+func (w *Webhook) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	w.flushLocked()
+	w.mu.Unlock()
+
+	close(w.stop)
+	w.wg.Wait()
+	return nil
+}
+
+// flushLocked moves the pending events into spool as a batch, it must be
+// called with lock held.
+func (w *Webhook) flushLocked() {
+	if len(w.pending) == 0 {
+		return
+	}
+
+	batch := w.pending
+	w.pending = nil
+
+	dropped, err := w.spool.put(batch)
+	if dropped > 0 {
+		metrics.EventWebhookDroppedCounter.WithLabelValues(w.config.Name).Add(float64(dropped))
+		log.With(nil).Warnf("spool of event webhook %s is full, %d events dropped", w.config.Name, dropped)
+	}
+	if err != nil {
+		metrics.EventWebhookDroppedCounter.WithLabelValues(w.config.Name).Add(float64(len(batch)))
+		log.With(nil).Errorf("failed to spool %d events of webhook %s: %v", len(batch), w.config.Name, err)
+		return
+	}
+	metrics.EventWebhookSpoolDepth.WithLabelValues(w.config.Name).Set(float64(w.spool.len()))
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+// flushLoop flushes the pending events periodically, so that an event
+// doesn't wait for the batch to be full too long.
+func (w *Webhook) flushLoop() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(time.Duration(w.config.FlushInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.mu.Lock()
+			w.flushLocked()
+			w.mu.Unlock()
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// deliverLoop delivers the batches in spool one by one in order.
+func (w *Webhook) deliverLoop() {
+	defer w.wg.Done()
+
+	maxBackoff := time.Duration(w.config.MaxBackoff) * time.Second
+	backoff := webhookMinBackoff
+
+	for {
+		seq, batch, ok, err := w.spool.first()
+		if !ok {
+			select {
+			case <-w.notify:
+				continue
+			case <-w.stop:
+				return
+			}
+		}
+
+		if err != nil {
+			// the broken batch can never be delivered, drop it.
+			log.With(nil).Errorf("failed to read spooled batch %d of event webhook %s, drop it: %v", seq, w.config.Name, err)
+			w.discard(seq, 0)
+			continue
+		}
+
+		err = w.deliver(batch)
+		if err == nil {
+			metrics.EventWebhookDeliveredCounter.WithLabelValues(w.config.Name).Add(float64(len(batch)))
+			if len(batch) > 0 {
+				lag := time.Since(time.Unix(0, batch[0].TimeNano))
+				metrics.EventWebhookDeliveryLagTimer.WithLabelValues(w.config.Name).Observe(lag.Seconds())
+			}
+			w.discard(seq, 0)
+			backoff = webhookMinBackoff
+			continue
+		}
+
+		select {
+		case <-w.stop:
+			// the delivery is canceled by Close, keep the batch in spool.
+			return
+		default:
+		}
+
+		metrics.EventWebhookFailuresCounter.WithLabelValues(w.config.Name).Inc()
+		if isPermanentWebhookError(err) {
+			log.With(nil).Errorf("event webhook %s rejected %d events, drop them: %v", w.config.Name, len(batch), err)
+			w.discard(seq, len(batch))
+			continue
+		}
+
+		log.With(nil).Warnf("failed to deliver %d events to webhook %s, retry in %s: %v", len(batch), w.config.Name, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-w.stop:
+			return
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// discard removes the batch from spool, and records the dropped events.
+func (w *Webhook) discard(seq uint64, dropped int) {
+	if err := w.spool.remove(seq); err != nil {
+		log.With(nil).Errorf("failed to remove spooled batch %d of event webhook %s: %v", seq, w.config.Name, err)
+	}
+	if dropped > 0 {
+		metrics.EventWebhookDroppedCounter.WithLabelValues(w.config.Name).Add(float64(dropped))
+	}
+	metrics.EventWebhookSpoolDepth.WithLabelValues(w.config.Name).Set(float64(w.spool.len()))
+}
+
+// webhookStatusError is the error of unexpected response status.
+type webhookStatusError struct {
+	code int
+}
+
+func (e webhookStatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d", e.code)
+}
+
+// isPermanentWebhookError returns true if the batch is rejected by webhook
+// and retrying it makes no sense.
+func isPermanentWebhookError(err error) bool {
+	se, ok := err.(webhookStatusError)
+	if !ok {
+		return false
+	}
+	return se.code >= 400 && se.code < 500 &&
+		se.code != http.StatusRequestTimeout && se.code != http.StatusTooManyRequests
+}
+
+// deliver posts the batch to webhook.
+func (w *Webhook) deliver(batch []types.EventsMessage) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-w.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	req, err := http.NewRequest(http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "pouchd")
+	req.Header.Set(WebhookEventCountHeader, strconv.Itoa(len(batch)))
+	if w.config.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, SignWebhookBody([]byte(w.config.Secret), body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return webhookStatusError{code: resp.StatusCode}
+	}
+	return nil
+}
+
+// SignWebhookBody returns the signature of request body with the secret of
+// webhook, receivers can verify the request by comparing it with the value
+// of header X-Pouch-Signature.
+func SignWebhookBody(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,231 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// webhookStub is a http receiver of webhook, it fails the first failures
+// requests.
+type webhookStub struct {
+	mu       sync.Mutex
+	failures int
+	status   int
+	requests int
+	events   []types.EventsMessage
+	headers  []http.Header
+	bodies   [][]byte
+}
+
+func (s *webhookStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	if s.requests <= s.failures {
+		w.WriteHeader(s.status)
+		return
+	}
+
+	var batch []types.EventsMessage
+	if err := json.Unmarshal(body, &batch); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.events = append(s.events, batch...)
+	s.headers = append(s.headers, r.Header)
+	s.bodies = append(s.bodies, body)
+}
+
+func (s *webhookStub) received() []types.EventsMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]types.EventsMessage(nil), s.events...)
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(10 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout to wait for condition")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func newTestWebhook(t *testing.T, url, spoolRoot string, modify func(*WebhookConfig)) *Webhook {
+	config := WebhookConfig{Name: "test", URL: url, MaxBackoff: 1}
+	if modify != nil {
+		modify(&config)
+	}
+	assert.NoError(t, config.Validate())
+
+	w, err := NewWebhook(config, spoolRoot)
+	assert.NoError(t, err)
+	return w
+}
+
+func TestWebhookConfigValidate(t *testing.T) {
+	config := WebhookConfig{Name: "audit", URL: "https://example.com/events", Filters: []string{"type=container"}}
+	assert.NoError(t, config.Validate())
+	assert.Equal(t, defaultWebhookBatchSize, config.BatchSize)
+	assert.Equal(t, defaultWebhookFlushInterval, config.FlushInterval)
+	assert.Equal(t, defaultWebhookTimeout, config.Timeout)
+	assert.Equal(t, defaultWebhookMaxBackoff, config.MaxBackoff)
+	assert.Equal(t, defaultWebhookMaxSpoolBatches, config.MaxSpoolBatches)
+
+	for _, config := range []WebhookConfig{
+		{Name: "", URL: "https://example.com"},
+		{Name: "../x", URL: "https://example.com"},
+		{Name: "a", URL: "ftp://example.com"},
+		{Name: "a", URL: "http://"},
+		{Name: "a", URL: "http://example.com", Filters: []string{"container"}},
+		{Name: "a", URL: "http://example.com", Filters: []string{"label=a=b"}},
+		{Name: "a", URL: "http://example.com", BatchSize: -1},
+	} {
+		assert.Error(t, config.Validate(), config.Name+" "+config.URL)
+	}
+}
+
+func TestWebhookDeliverWithRetry(t *testing.T) {
+	webhookMinBackoff = 10 * time.Millisecond
+	defer func() { webhookMinBackoff = time.Second }()
+
+	stub := &webhookStub{failures: 2, status: http.StatusServiceUnavailable}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "webhook")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	w := newTestWebhook(t, server.URL, dir, func(c *WebhookConfig) {
+		c.BatchSize = 2
+		c.Secret = "s3cret"
+		c.Filters = []string{"type=container"}
+	})
+
+	e := NewEvents()
+	e.AddWebhook(w)
+	defer e.CloseWebhooks()
+
+	ctx := context.Background()
+	assert.NoError(t, e.Publish(ctx, "create", types.EventTypeContainer, &types.EventsActor{ID: "c1"}))
+	assert.NoError(t, e.Publish(ctx, "pull", types.EventTypeImage, &types.EventsActor{ID: "i1"}))
+	assert.NoError(t, e.Publish(ctx, "start", types.EventTypeContainer, &types.EventsActor{ID: "c1"}))
+
+	waitFor(t, func() bool { return len(stub.received()) == 2 })
+
+	events := stub.received()
+	assert.Equal(t, "create", events[0].Action)
+	assert.Equal(t, "start", events[1].Action)
+
+	stub.mu.Lock()
+	assert.Equal(t, 3, stub.requests)
+	assert.Equal(t, "2", stub.headers[0].Get(WebhookEventCountHeader))
+	assert.Equal(t, SignWebhookBody([]byte("s3cret"), stub.bodies[0]), stub.headers[0].Get(WebhookSignatureHeader))
+	stub.mu.Unlock()
+
+	waitFor(t, func() bool { return w.spool.len() == 0 })
+}
+
+func TestWebhookSpoolSurvivesRestart(t *testing.T) {
+	webhookMinBackoff = 10 * time.Millisecond
+	defer func() { webhookMinBackoff = time.Second }()
+
+	stub := &webhookStub{failures: 1 << 30, status: http.StatusInternalServerError}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "webhook")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	w := newTestWebhook(t, server.URL, dir, func(c *WebhookConfig) { c.BatchSize = 1 })
+	assert.NoError(t, w.Write(&types.EventsMessage{Action: "die", Type: types.EventTypeContainer}))
+	assert.NoError(t, w.Write(&types.EventsMessage{Action: "destroy", Type: types.EventTypeContainer}))
+	waitFor(t, func() bool {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		return stub.requests > 0
+	})
+	assert.NoError(t, w.Close())
+	assert.Equal(t, 2, w.spool.len())
+
+	// the receiver recovers, and the spooled events are delivered in order.
+	stub.mu.Lock()
+	stub.failures = 0
+	stub.mu.Unlock()
+
+	w = newTestWebhook(t, server.URL, dir, nil)
+	defer w.Close()
+
+	waitFor(t, func() bool { return len(stub.received()) == 2 })
+	events := stub.received()
+	assert.Equal(t, "die", events[0].Action)
+	assert.Equal(t, "destroy", events[1].Action)
+}
+
+func TestWebhookDropRejectedBatch(t *testing.T) {
+	stub := &webhookStub{failures: 1, status: http.StatusBadRequest}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "webhook")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	w := newTestWebhook(t, server.URL, dir, func(c *WebhookConfig) { c.BatchSize = 1 })
+	defer w.Close()
+
+	assert.NoError(t, w.Write(&types.EventsMessage{Action: "rejected"}))
+	assert.NoError(t, w.Write(&types.EventsMessage{Action: "accepted"}))
+
+	waitFor(t, func() bool { return len(stub.received()) == 1 })
+	assert.Equal(t, "accepted", stub.received()[0].Action)
+}
+
+func TestSpoolBounded(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spool")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := newSpool(dir, 2)
+	assert.NoError(t, err)
+
+	for _, action := range []string{"a", "b", "c"} {
+		_, err := s.put([]types.EventsMessage{{Action: action}, {Action: action}})
+		assert.NoError(t, err)
+	}
+	dropped, err := s.put([]types.EventsMessage{{Action: "d"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, dropped)
+	assert.Equal(t, 2, s.len())
+
+	// reopen the spool, the oldest batch is "c".
+	s, err = newSpool(dir, 2)
+	assert.NoError(t, err)
+	seq, batch, ok, err := s.first()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "c", batch[0].Action)
+
+	assert.NoError(t, s.remove(seq))
+	_, batch, ok, err = s.first()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "d", batch[0].Action)
+}
@@ -10,10 +10,12 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
+	buildtypes "github.com/alibaba/pouch/builder/types"
 	"github.com/alibaba/pouch/ctrd"
 	daemon_config "github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/daemon/containerio"
@@ -22,17 +24,19 @@ import (
 	"github.com/alibaba/pouch/hookplugins"
 	"github.com/alibaba/pouch/lxcfs"
 	networktypes "github.com/alibaba/pouch/network/types"
+	"github.com/alibaba/pouch/pkg/cdi"
 	"github.com/alibaba/pouch/pkg/collect"
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/meta"
 	mountutils "github.com/alibaba/pouch/pkg/mount"
 	"github.com/alibaba/pouch/pkg/streams"
+	"github.com/alibaba/pouch/pkg/trace"
 	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/alibaba/pouch/pkg/utils/metrics"
 	volumetypes "github.com/alibaba/pouch/storage/volume/types"
 	"github.com/sirupsen/logrus"
 
-	"github.com/containerd/cgroups"
 	containerdtypes "github.com/containerd/containerd/api/types"
 	"github.com/containerd/containerd/mount"
 	"github.com/docker/go-units"
@@ -60,6 +64,9 @@ type ContainerMgr interface {
 	// Restore recover those alive containers.
 	Restore(ctx context.Context) error
 
+	// Shutdown handles the running containers by shutdown mode when pouchd stops.
+	Shutdown(ctx context.Context) error
+
 	// Create a new container.
 	Create(ctx context.Context, name string, config *types.ContainerCreateConfig) (*types.ContainerCreateResp, error)
 
@@ -69,6 +76,10 @@ type ContainerMgr interface {
 	// List returns the list of containers.
 	List(ctx context.Context, option *ContainerListOption) ([]*Container, error)
 
+	// Size returns the size of files created or changed by container, and
+	// the total size of container's rootfs.
+	Size(ctx context.Context, name string) (int64, int64, error)
+
 	// Start a container.
 	Start(ctx context.Context, id string, options *types.ContainerStartOptions) error
 
@@ -88,7 +99,7 @@ type ContainerMgr interface {
 	StreamStats(ctx context.Context, name string, config *ContainerStatsConfig) error
 
 	// Stats of a container.
-	Stats(ctx context.Context, name string) (*containerdtypes.Metric, *cgroups.Metrics, error)
+	Stats(ctx context.Context, name string) (*containerdtypes.Metric, *ContainerMetrics, error)
 
 	// AttachContainerIO attach stream to container IO.
 	AttachContainerIO(ctx context.Context, name string, cfg *streams.AttachConfig) error
@@ -137,6 +148,12 @@ type ContainerMgr interface {
 	// ResizeExec resizes the size of exec process's tty.
 	ResizeExec(ctx context.Context, execid string, opts types.ResizeOptions) error
 
+	// ListExecs returns the running and recently exited exec processes of container.
+	ListExecs(ctx context.Context, name string) ([]*types.ContainerExecInspect, error)
+
+	// KillExec sends a signal to the running exec process.
+	KillExec(ctx context.Context, execid string, signal string) error
+
 	// 3. The following two function is related to network management.
 	// TODO: inconsistency, Connect/Disconnect operation is in newtork_bridge.go in upper API layer.
 	// Here we encapsualted them in container manager, inconsistency exists.
@@ -174,6 +191,15 @@ type ContainerMgr interface {
 
 	// ExtractToDir extracts the given archive at the specified path in the container.
 	ExtractToDir(ctx context.Context, name, path string, copyUIDGID, noOverwriteDirNonDir bool, content io.Reader) error
+
+	// RunBuildContainer runs the container for the build step of builder.
+	RunBuildContainer(ctx context.Context, cfg *buildtypes.BuildContainerConfig) error
+
+	// Fsck checks the consistency of containers' meta data, and repairs the findings by options.
+	Fsck(ctx context.Context, opts *FsckOptions) (*types.FsckReport, error)
+
+	// Backup writes a tar archive of the state of pouchd into w.
+	Backup(ctx context.Context, opts *BackupOptions, w io.Writer) error
 }
 
 // ContainerManager is the default implement of interface ContainerMgr.
@@ -193,6 +219,8 @@ type ContainerManager struct {
 	ImageMgr      ImageMgr
 	VolumeMgr     VolumeMgr
 	NetworkMgr    NetworkMgr
+	PodMgr        PodMgr
+	SecretMgr     SecretMgr
 	IOs           *containerio.Cache
 	ExecProcesses *collect.SafeMap
 
@@ -205,6 +233,26 @@ type ContainerManager struct {
 	// monitor is used to handle container's event, eg: exit, stop and so on.
 	monitor *ContainerMonitor
 
+	// restartManagers decides whether and when to restart the exited
+	// containers by restart policy.
+	restartManagers *restartManagers
+
+	// cdiCache keeps the Container Device Interface specs.
+	cdiCache *cdi.Cache
+
+	// metricsCollector exports the per-container metrics, it is nil if
+	// the per-container metrics is disabled.
+	metricsCollector *ContainerMetricsCollector
+
+	// mutationLock blocks the mutations of containers while the meta stores
+	// are dumped by backup, so that the stores are dumped at the same point.
+	// The mutations which may change several stores hold it for reading.
+	mutationLock sync.RWMutex
+
+	// buildContainers stores the running build step containers of builder.
+	// Element operated in buildContainers must have a type of *Container.
+	buildContainers *collect.SafeMap
+
 	containerPlugin hookplugins.ContainerPlugin
 
 	// eventsService is used to publish events generated by pouchd
@@ -222,8 +270,11 @@ func NewContainerManager(ctx context.Context, store *meta.Store, cli ctrd.APICli
 		IOs:             containerio.NewCache(),
 		ExecProcesses:   collect.NewSafeMap(),
 		cache:           collect.NewSafeMap(),
+		buildContainers: collect.NewSafeMap(),
+		cdiCache:        newCDICache(cfg.CDISpecDirs),
 		Config:          cfg,
-		monitor:         NewContainerMonitor(),
+		monitor:         NewContainerMonitor(defaultMonitorWorkers),
+		restartManagers: newRestartManagers(),
 		containerPlugin: contPlugin,
 		eventsService:   eventsService,
 	}
@@ -232,6 +283,18 @@ func NewContainerManager(ctx context.Context, store *meta.Store, cli ctrd.APICli
 	mgr.Client.SetExecExitHooks(mgr.execExitedAndRelease)
 	mgr.Client.SetEventsHooks(mgr.publishContainerdEvent, mgr.updateContainerState)
 
+	if cfg.EnableContainerMetrics {
+		collector, err := newContainerMetricsCollector(mgr, time.Duration(cfg.ContainerMetricsCollectPeriod)*time.Second, cfg.ContainerMetricsLabels)
+		if err != nil {
+			return nil, err
+		}
+		if err := metrics.GetPrometheusRegistry().Register(collector); err != nil {
+			return nil, errors.Wrap(err, "failed to register container metrics")
+		}
+		mgr.metricsCollector = collector
+		collector.Start()
+	}
+
 	go mgr.execProcessGC()
 
 	return mgr, nil
@@ -301,6 +364,24 @@ func (mgr *ContainerManager) Restore(ctx context.Context) error {
 			return err
 		}
 
+		// the pending restart is interrupted by pouchd restarting, so
+		// handle it as exited again to restart it by policy.
+		if c.IsRestarting() {
+			c.SetStatusExited(c.State.ExitCode, c.State.Error)
+			if err := c.Write(mgr.Store); err != nil {
+				log.With(ctx).Errorf("failed to update meta: %v", err)
+			}
+			mgr.monitor.PostEvent(ContainerExitEvent(c).WithHandle(mgr.handleContainerExit))
+			continue
+		}
+
+		// the container stopped by pouchd shutdown should be started again
+		// by its restart policy.
+		if shouldStartAfterShutdown(c) {
+			mgr.monitor.PostEvent(ContainerExitEvent(c).WithHandle(mgr.startAfterShutdown))
+			continue
+		}
+
 		// recover the running or paused container.
 		if !c.IsRunningOrPaused() {
 			continue
@@ -339,12 +420,26 @@ func (mgr *ContainerManager) Restore(ctx context.Context) error {
 
 // Create checks passed in parameters and create a Container object whose status is set at Created.
 func (mgr *ContainerManager) Create(ctx context.Context, name string, config *types.ContainerCreateConfig) (resp *types.ContainerCreateResp, err error) {
+	mgr.mutationLock.RLock()
+	defer mgr.mutationLock.RUnlock()
+
+	ctx, span := trace.StartSpan(ctx, "ContainerManager.Create",
+		trace.WithAttribute("container.name", name),
+		trace.WithAttribute("container.image", config.Image),
+	)
+	defer func() {
+		span.Finish(err)
+	}()
+
 	currentSnapshotter := ctrd.CurrentSnapshotterName(ctx)
 	config.Snapshotter = currentSnapshotter
 
 	if mgr.containerPlugin != nil {
 		log.With(ctx).Infof("invoke container pre-create hook in plugin")
-		if ex := mgr.containerPlugin.PreCreate(ctx, config); ex != nil {
+		hookCtx, hookSpan := trace.StartSpan(ctx, "ContainerPlugin.PreCreate")
+		ex := mgr.containerPlugin.PreCreate(hookCtx, config)
+		hookSpan.Finish(ex)
+		if ex != nil {
 			return nil, errors.Wrapf(ex, "pre-create plugin point execute failed")
 		}
 	}
@@ -388,6 +483,20 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "NetworkingConfig cannot be empty")
 	}
 
+	// container in pod shares the namespaces and cgroup parent of pod
+	if config.HostConfig.Pod != "" {
+		if mgr.PodMgr == nil {
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "pod is not supported")
+		}
+		if err := mgr.PodMgr.JoinPod(ctx, config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mgr.validateSecretReferences(ctx, config.HostConfig); err != nil {
+		return nil, err
+	}
+
 	// validate disk quota
 	if err := mgr.validateDiskQuota(config); err != nil {
 		return nil, errors.Wrapf(err, "invalid disk quota config")
@@ -400,6 +509,7 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 	//put container id to cache to prevent concurrent containerCreateReq with same specific id
 	mgr.cache.Put(id, nil)
 	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": id})
+	span.SetAttribute("container.id", id)
 	defer func() {
 		//clear cache
 		if err != nil {
@@ -612,17 +722,26 @@ func (mgr *ContainerManager) Start(ctx context.Context, id string, options *type
 		return errors.Wrap(errtypes.ErrInvalidParam, "container ID cannot empty")
 	}
 
+	ctx, span := trace.StartSpan(ctx, "ContainerManager.Start")
+	defer func() {
+		span.Finish(err)
+	}()
+
 	c, err := mgr.container(id)
 	if err != nil {
 		return err
 	}
 
 	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
+	span.SetAttribute("container.id", c.ID)
 
 	// NOTE: choose snapshotter, snapshotter can only be set
 	// through containerPlugin in Create function
 	ctx = ctrd.WithSnapshotter(ctx, c.Config.Snapshotter)
 
+	// started by user, cancel the pending restart and reset the counters.
+	mgr.restartManagers.Get(c.ID).Reset()
+
 	err = mgr.start(ctx, c, options)
 	if err == nil {
 		mgr.LogContainerEvent(ctx, c, "start")
@@ -636,6 +755,12 @@ func (mgr *ContainerManager) start(ctx context.Context, c *Container, options *t
 	c.Lock()
 	defer c.Unlock()
 
+	return mgr.startLocked(ctx, c, options)
+}
+
+// startLocked starts the container, the caller must hold the lock of
+// container.
+func (mgr *ContainerManager) startLocked(ctx context.Context, c *Container, options *types.ContainerStartOptions) error {
 	var err error
 	c.DetachKeys = options.DetachKeys
 
@@ -687,6 +812,10 @@ func (mgr *ContainerManager) start(ctx context.Context, c *Container, options *t
 		return err
 	}
 
+	if err = mgr.setupSecrets(ctx, c); err != nil {
+		return err
+	}
+
 	if err = mgr.createContainerdContainer(ctx, c, options.CheckpointDir, options.CheckpointID); err != nil {
 		return errors.Wrapf(err, "failed to create container(%s) on containerd", c.ID)
 	}
@@ -796,7 +925,9 @@ func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *C
 
 	if mgr.containerPlugin != nil {
 		// TODO: make func PreStart with no data race
-		prioArr, argsArr, err = mgr.containerPlugin.PreStart(ctx, c)
+		hookCtx, hookSpan := trace.StartSpan(ctx, "ContainerPlugin.PreStart")
+		prioArr, argsArr, err = mgr.containerPlugin.PreStart(hookCtx, c)
+		hookSpan.Finish(err)
 		if err != nil {
 			return errors.Wrapf(err, "get pre-start hook error from container plugin")
 		}
@@ -809,6 +940,7 @@ func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *C
 		prioArr:    prioArr,
 		argsArr:    argsArr,
 		useSystemd: mgr.Config.UseSystemd(),
+		cdiCache:   mgr.cdiCache,
 	}
 
 	if err = createSpec(ctx, c, sw); err != nil {
@@ -869,7 +1001,15 @@ func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *C
 		return errors.Wrapf(err, "failed to get PID of container %s", c.ID)
 	}
 
+	// the container has been running, so just warn if the v2 only
+	// resources can't be applied.
+	if err := mgr.setCgroupV2Resources(c); err != nil {
+		log.With(ctx).Warnf("failed to set cgroup v2 resources: %v", err)
+	}
+
 	c.SetStatusRunning(int64(pid))
+	c.HasBeenManuallyStopped = false
+	c.StoppedByShutdown = false
 
 	// set Snapshot MergedDir
 	c.Snapshotter.Data["MergedDir"] = c.BaseFS
@@ -950,7 +1090,18 @@ func (mgr *ContainerManager) stop(ctx context.Context, c *Container, timeout int
 	c.Lock()
 	defer c.Unlock()
 
+	// stopped by user, the container should not be restarted by policy.
+	mgr.restartManagers.Get(c.ID).Cancel()
+	c.HasBeenManuallyStopped = true
+	c.State.CrashLooping = false
+
 	if !c.IsRunningOrPaused() {
+		// the container is waiting to be restarted, just mark it stopped.
+		if c.IsRestarting() {
+			c.SetStatusStopped(c.State.ExitCode, c.State.Error)
+			return c.Write(mgr.Store)
+		}
+
 		// stopping a non-running container is valid.
 		return nil
 	}
@@ -960,6 +1111,7 @@ func (mgr *ContainerManager) stop(ctx context.Context, c *Container, timeout int
 	}
 
 	id := c.ID
+	ctx = ctrd.WithStopSignal(ctx, c.Config.StopSignal)
 	msg, err := mgr.Client.DestroyContainer(ctx, id, timeout)
 	if err != nil {
 		return errors.Wrapf(err, "failed to destroy container %s", id)
@@ -992,6 +1144,9 @@ func (mgr *ContainerManager) Restart(ctx context.Context, name string, timeout i
 
 	log.With(ctx).Debugf("start container %s when restarting", c.ID)
 
+	// restarted by user, reset the counters of restart policy.
+	mgr.restartManagers.Get(c.ID).Reset()
+
 	// start container
 	err = mgr.start(ctx, c, &types.ContainerStartOptions{})
 	if err != nil {
@@ -1131,6 +1286,9 @@ func (mgr *ContainerManager) AttachCRILog(ctx context.Context, name string, logP
 
 // Rename renames a container.
 func (mgr *ContainerManager) Rename(ctx context.Context, oldName, newName string) error {
+	mgr.mutationLock.RLock()
+	defer mgr.mutationLock.RUnlock()
+
 	if mgr.NameToID.Get(newName).Exist() {
 		return errors.Wrapf(errtypes.ErrAlreadyExisted, "container name %s", newName)
 	}
@@ -1174,6 +1332,9 @@ func (mgr *ContainerManager) Rename(ctx context.Context, oldName, newName string
 
 // Update updates the configurations of a container.
 func (mgr *ContainerManager) Update(ctx context.Context, name string, config *types.UpdateConfig) error {
+	mgr.mutationLock.RLock()
+	defer mgr.mutationLock.RUnlock()
+
 	c, err := mgr.container(name)
 	if err != nil {
 		return err
@@ -1281,6 +1442,11 @@ func (mgr *ContainerManager) Update(ctx context.Context, name string, config *ty
 			restore = true
 			return fmt.Errorf("failed to update resource: %s", err)
 		}
+
+		if err := mgr.setCgroupV2Resources(c); err != nil {
+			restore = true
+			return fmt.Errorf("failed to update cgroup v2 resource: %s", err)
+		}
 	}
 
 	// store disk.
@@ -1295,6 +1461,9 @@ func (mgr *ContainerManager) Update(ctx context.Context, name string, config *ty
 
 // Remove removes a container, it may be running or stopped and so on.
 func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *types.ContainerRemoveOptions) error {
+	mgr.mutationLock.RLock()
+	defer mgr.mutationLock.RUnlock()
+
 	c, err := mgr.container(name)
 	if err != nil {
 		return err
@@ -1313,6 +1482,11 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 		return fmt.Errorf("container %s is not stopped, cannot remove it without flag force", c.ID)
 	}
 
+	if c.IsRestarting() && !options.Force {
+		return fmt.Errorf("container %s is restarting, stop it before removing or remove it with flag force", c.ID)
+	}
+	mgr.restartManagers.Remove(c.ID)
+
 	if c.State.Dead {
 		log.With(ctx).Warnf("container has been deleted %s", c.ID)
 		return nil
@@ -1557,6 +1731,9 @@ func (mgr *ContainerManager) Wait(ctx context.Context, name string) (types.Conta
 
 // Connect is used to connect a container to a network.
 func (mgr *ContainerManager) Connect(ctx context.Context, name string, networkIDOrName string, epConfig *types.EndpointSettings) error {
+	mgr.mutationLock.RLock()
+	defer mgr.mutationLock.RUnlock()
+
 	c, err := mgr.container(name)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get container %s", name)
@@ -1599,6 +1776,9 @@ func (mgr *ContainerManager) Connect(ctx context.Context, name string, networkID
 // Disconnect disconnects the given container from
 // given network
 func (mgr *ContainerManager) Disconnect(ctx context.Context, containerName, networkName string, force bool) error {
+	mgr.mutationLock.RLock()
+	defer mgr.mutationLock.RUnlock()
+
 	c, err := mgr.container(containerName)
 	if err != nil {
 		// TODO(ziren): if force is true, force delete endpoint
@@ -1873,6 +2053,11 @@ func (mgr *ContainerManager) markExitedAndRelease(ctx context.Context, c *Contai
 // exitedAndRelease be register into ctrd as a callback function, when the running container suddenly
 // exited, "ctrd" will call it to set the container's state and release resouce and so on.
 func (mgr *ContainerManager) exitedAndRelease(id string, m *ctrd.Message, cleanup func() error) error {
+	// the build step container is released by RunBuildContainer.
+	if _, ok := mgr.buildContainer(id); ok {
+		return nil
+	}
+
 	c, err := mgr.container(id)
 	if err != nil {
 		return err
@@ -1907,21 +2092,7 @@ func (mgr *ContainerManager) exitedAndRelease(id string, m *ctrd.Message, cleanu
 	}
 
 	// send exit event to monitor
-	mgr.monitor.PostEvent(ContainerExitEvent(c).WithHandle(func(c *Container) error {
-		// check status and restart policy
-		if !c.State.Exited {
-			return nil
-		}
-
-		policy := (*ContainerRestartPolicy)(c.HostConfig.RestartPolicy)
-		keys := c.DetachKeys
-
-		if policy == nil || policy.IsNone() {
-			return nil
-		}
-
-		return mgr.Start(context.TODO(), c.ID, &types.ContainerStartOptions{DetachKeys: keys})
-	}))
+	mgr.monitor.PostEvent(ContainerExitEvent(c).WithHandle(mgr.handleContainerExit))
 
 	return nil
 }
@@ -1943,9 +2114,18 @@ func (mgr *ContainerManager) execExitedAndRelease(id string, m *ctrd.Message) er
 	execConfig.Running = false
 	execConfig.Error = m.RawError()
 	execConfig.Exited = true
+	execConfig.FinishedAt = time.Now()
 
 	execConfig.Unlock()
 
+	// the exec_die event is published by ctrd, the exit of exec process is
+	// only posted to monitor in order with the other events of container.
+	if c, err := mgr.container(execConfig.ContainerID); err == nil {
+		mgr.monitor.PostEvent(ContainerExecExitEvent(c, id).WithHandle(func(c *Container) error {
+			return mgr.handleExecExit(c, execConfig)
+		}))
+	}
+
 	eio := mgr.IOs.Get(id)
 	if eio == nil {
 		return nil
@@ -1959,6 +2139,9 @@ func (mgr *ContainerManager) execExitedAndRelease(id string, m *ctrd.Message) er
 
 func (mgr *ContainerManager) releaseContainerResources(ctx context.Context, c *Container) error {
 	mgr.resetContainerIOs(c.ID)
+	if err := mgr.releaseSecrets(ctx, c); err != nil {
+		log.With(ctx).Errorf("failed to release secrets of container %s: %v", c.ID, err)
+	}
 	return mgr.releaseContainerNetwork(ctx, c)
 }
 
@@ -2011,7 +2194,9 @@ func (mgr *ContainerManager) buildContainerEndpoint(ctx context.Context, c *Cont
 
 	if mgr.containerPlugin != nil {
 		// just ignore return err
-		err := mgr.containerPlugin.PreCreateEndpoint(ctx, c.ID, c.Config.Env, ep)
+		hookCtx, hookSpan := trace.StartSpan(ctx, "ContainerPlugin.PreCreateEndpoint")
+		err := mgr.containerPlugin.PreCreateEndpoint(hookCtx, c.ID, c.Config.Env, ep)
+		hookSpan.Finish(err)
 		if err != nil {
 			log.With(ctx).Warnf("failed to call PreCreateEndpoint plugin, err(%v)", err)
 		}
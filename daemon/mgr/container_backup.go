@@ -0,0 +1,629 @@
+package mgr
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/meta"
+	volumetypes "github.com/alibaba/pouch/storage/volume/types"
+	"github.com/alibaba/pouch/version"
+
+	"github.com/containerd/containerd/archive"
+	"github.com/pkg/errors"
+)
+
+// backupFormatVersion is the version of the layout of backup archive.
+const backupFormatVersion = 1
+
+// The entries of backup archive.
+const (
+	backupManifestEntry   = "backup.json"
+	backupContainersEntry = "meta/containers.json"
+	backupVolumesEntry    = "meta/volumes.json"
+	backupPodsEntry       = "meta/pods.json"
+	backupSecretsEntry    = "meta/secrets.json"
+	backupSecretKeyEntry  = "secrets/secret.key"
+	backupNetworkEntry    = "network/local-kv.db"
+	backupConfigEntry     = "daemon/config.json"
+	// backupEndEntry is the last entry, a backup cut off by error has no end.
+	backupEndEntry = "backup.end"
+
+	// backupCheckpointDir contains <container id>/<checkpoint id>/config.json.
+	backupCheckpointDir = "checkpoints"
+	// backupVolumeDataDir contains <volume name>.tar.
+	backupVolumeDataDir = "volumes"
+	// backupRWLayerDir contains <container id>.tar.
+	backupRWLayerDir = "rw-layers"
+)
+
+// BackupOptions contains the options of backup.
+type BackupOptions struct {
+	// VolumeData includes the data of local volumes.
+	VolumeData bool
+	// RWLayers includes the rw layers of containers.
+	RWLayers bool
+}
+
+// BackupManifest describes the backup archive, it's the first entry of archive.
+type BackupManifest struct {
+	Version      int       `json:"version"`
+	Created      time.Time `json:"created"`
+	PouchVersion string    `json:"pouch-version"`
+	HomeDir      string    `json:"home-dir"`
+	VolumeData   bool      `json:"volume-data"`
+	RWLayers     bool      `json:"rw-layers"`
+
+	// VolumePaths is the path of each local volume on host, where the data
+	// of volume is restored to.
+	VolumePaths map[string]string `json:"volume-paths,omitempty"`
+}
+
+// RestoreConfig specifies where the backup is restored to.
+type RestoreConfig struct {
+	HomeDir       string
+	ContainerMeta meta.Config
+	VolumeMeta    meta.Config
+	PodMeta       meta.Config
+	SecretMeta    meta.Config
+	SecretKeyFile string
+	NetworkDB     string
+}
+
+// NetworkDBPath returns the path of the local store of network under metaPath.
+func NetworkDBPath(metaPath string) string {
+	return path.Join(metaPath, "network", "files", "local-kv.db")
+}
+
+// restoreDir is where the parts of backup which are not applied when it is
+// restored are saved.
+func restoreDir(homeDir string) string {
+	return path.Join(homeDir, "restore")
+}
+
+// Backup writes a tar archive of the state of pouchd into w, which includes
+// the meta data of containers, volumes, pods and secrets, the key of secrets,
+// the network store, the configs of checkpoints and the config file of daemon. The data of local volumes and
+// the rw layers of containers are included by options. The meta data is
+// dumped while the creation, removal and update of containers are blocked.
+func (mgr *ContainerManager) Backup(ctx context.Context, opts *BackupOptions, w io.Writer) error {
+	if opts == nil {
+		opts = &BackupOptions{}
+	}
+
+	manifest := &BackupManifest{
+		Version:      backupFormatVersion,
+		Created:      time.Now().UTC(),
+		PouchVersion: version.Version,
+		HomeDir:      mgr.Config.HomeDir,
+		VolumeData:   opts.VolumeData,
+		RWLayers:     opts.RWLayers,
+		VolumePaths:  map[string]string{},
+	}
+
+	// the meta stores are dumped at the same point, before they are streamed.
+	snapshot, err := mgr.snapshotMeta(ctx, manifest)
+	if err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(w)
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	if err := writeTarFile(tw, backupManifestEntry, data); err != nil {
+		return err
+	}
+
+	for _, entry := range snapshot.entries {
+		if err := writeTarFile(tw, entry.name, entry.data); err != nil {
+			return err
+		}
+	}
+
+	if file := mgr.Config.ConfigFile; file != "" {
+		data, err := ioutil.ReadFile(file)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to read config file %s", file)
+		}
+		if err == nil {
+			if err := writeTarFile(tw, backupConfigEntry, data); err != nil {
+				return err
+			}
+		}
+	}
+
+	names := make([]string, 0, len(manifest.VolumePaths))
+	for name := range manifest.VolumePaths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		src := manifest.VolumePaths[name]
+		err := mgr.writeTarStream(tw, path.Join(backupVolumeDataDir, name+".tar"), func(w io.Writer) error {
+			empty, err := ioutil.TempDir(mgr.Config.HomeDir, "backup-empty-")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(empty)
+
+			return archive.WriteDiff(ctx, w, empty, src)
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to back up data of volume %s", name)
+		}
+	}
+
+	if opts.RWLayers {
+		for _, c := range snapshot.containers {
+			if c.RootFSProvided {
+				continue
+			}
+			sctx := ctrd.WithSnapshotter(ctx, c.Config.Snapshotter)
+			err := mgr.writeTarStream(tw, path.Join(backupRWLayerDir, c.ID+".tar"), func(w io.Writer) error {
+				return mgr.Client.ExportSnapshotDiff(sctx, c.SnapshotKey(), w)
+			})
+			if err != nil {
+				return errors.Wrapf(err, "failed to back up rw layer of container %s", c.ID)
+			}
+		}
+	}
+
+	if err := writeTarFile(tw, backupEndEntry, nil); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// backupEntry is a file of backup archive which is read into memory.
+type backupEntry struct {
+	name string
+	data []byte
+}
+
+// metaSnapshot is the meta data of pouchd dumped at the same point.
+type metaSnapshot struct {
+	entries    []backupEntry
+	containers []*Container
+}
+
+func (s *metaSnapshot) add(name string, data []byte) {
+	s.entries = append(s.entries, backupEntry{name: name, data: data})
+}
+
+// snapshotMeta dumps the meta stores of containers, volumes, pods and
+// secrets, the network store and the configs of checkpoints while the
+// mutations of containers are blocked, so that the stores in backup are
+// consistent with each other. The paths of local volumes are recorded in
+// manifest if the data of volumes is backed up.
+func (mgr *ContainerManager) snapshotMeta(ctx context.Context, manifest *BackupManifest) (*metaSnapshot, error) {
+	mgr.mutationLock.Lock()
+	defer mgr.mutationLock.Unlock()
+
+	snapshot := &metaSnapshot{}
+
+	if manifest.VolumeData {
+		volumes, err := mgr.VolumeMgr.List(ctx, filters.NewArgs())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list volumes")
+		}
+		for _, v := range volumes {
+			if v.Driver() != volumetypes.DefaultBackend {
+				continue
+			}
+			p, err := mgr.VolumeMgr.Path(ctx, v.Name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get path of volume %s", v.Name)
+			}
+			manifest.VolumePaths[v.Name] = p
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := mgr.Store.Dump(buf); err != nil {
+		return nil, errors.Wrap(err, "failed to dump container meta store")
+	}
+	snapshot.add(backupContainersEntry, buf.Bytes())
+
+	buf = &bytes.Buffer{}
+	if err := mgr.VolumeMgr.Dump(ctx, buf); err != nil {
+		return nil, errors.Wrap(err, "failed to dump volume meta store")
+	}
+	snapshot.add(backupVolumesEntry, buf.Bytes())
+
+	if mgr.PodMgr != nil {
+		buf = &bytes.Buffer{}
+		if err := mgr.PodMgr.Dump(ctx, buf); err != nil {
+			return nil, errors.Wrap(err, "failed to dump pod meta store")
+		}
+		snapshot.add(backupPodsEntry, buf.Bytes())
+	}
+
+	if mgr.SecretMgr != nil {
+		buf = &bytes.Buffer{}
+		if err := mgr.SecretMgr.Dump(ctx, buf); err != nil {
+			return nil, errors.Wrap(err, "failed to dump secret meta store")
+		}
+		snapshot.add(backupSecretsEntry, buf.Bytes())
+
+		// the secrets can't be decrypted without the key.
+		file := SecretKeyFile(mgr.Config)
+		key, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read secret key file %s", file)
+		}
+		snapshot.add(backupSecretKeyEntry, key)
+	}
+
+	networkDB := NetworkDBPath(mgr.Config.NetworkConfig.MetaPath)
+	if _, err := os.Stat(networkDB); err == nil {
+		buf = &bytes.Buffer{}
+		err := meta.CopyBolt(networkDB, func(size int64, copy io.WriterTo) error {
+			buf.Grow(int(size))
+			_, err := copy.WriteTo(buf)
+			return err
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to copy network store")
+		}
+		snapshot.add(backupNetworkEntry, buf.Bytes())
+	}
+
+	containers, err := mgr.List(ctx, &ContainerListOption{All: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list containers")
+	}
+	sort.Slice(containers, func(i, j int) bool { return containers[i].ID < containers[j].ID })
+	snapshot.containers = containers
+
+	for _, c := range containers {
+		if err := mgr.snapshotCheckpoints(snapshot, c); err != nil {
+			return nil, errors.Wrapf(err, "failed to back up checkpoints of container %s", c.ID)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// snapshotCheckpoints reads the configs of the checkpoints of container.
+func (mgr *ContainerManager) snapshotCheckpoints(snapshot *metaSnapshot, c *Container) error {
+	dir := filepath.Join(mgr.Store.Path(c.ID), "checkpoint")
+	checkpoints, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, checkpoint := range checkpoints {
+		data, err := ioutil.ReadFile(filepath.Join(dir, checkpoint.Name(), checkpointConfigPath))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+
+		snapshot.add(path.Join(backupCheckpointDir, c.ID, checkpoint.Name(), checkpointConfigPath), data)
+	}
+	return nil
+}
+
+// writeTarStream writes the stream into a temporary file to know its size,
+// and then writes it into tw as a file.
+func (mgr *ContainerManager) writeTarStream(tw *tar.Writer, name string, write func(io.Writer) error) error {
+	f, err := ioutil.TempFile(mgr.Config.HomeDir, "backup-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := write(f); err != nil {
+		return err
+	}
+
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err := tw.WriteHeader(newTarHeader(name, size)); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+func writeTarFile(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(newTarHeader(name, int64(len(data)))); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+func newTarHeader(name string, size int64) *tar.Header {
+	return &tar.Header{
+		Name:     name,
+		Mode:     0600,
+		Size:     size,
+		ModTime:  time.Now(),
+		Typeflag: tar.TypeReg,
+	}
+}
+
+// RestoreBackup rehydrates the state of pouchd from the backup archive
+// written by Backup, it must be called before the meta stores are opened by
+// daemon, and the meta stores must be empty. The rw layers of containers
+// are saved under home dir, and applied by ApplyRestoredRWLayers after
+// containerd is connected. The config file of daemon in backup is saved
+// under home dir for reference, it is not applied.
+func RestoreBackup(ctx context.Context, r io.Reader, cfg RestoreConfig) (*BackupManifest, error) {
+	store, err := meta.NewStore(cfg.ContainerMeta)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open container meta store")
+	}
+	defer store.Shutdown()
+
+	volumeStore, err := meta.NewStore(cfg.VolumeMeta)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open volume meta store")
+	}
+	defer volumeStore.Shutdown()
+
+	podStore, err := meta.NewStore(cfg.PodMeta)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open pod meta store")
+	}
+	defer podStore.Shutdown()
+
+	secretStore, err := meta.NewStore(cfg.SecretMeta)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open secret meta store")
+	}
+	defer secretStore.Shutdown()
+
+	for _, s := range []*meta.Store{store, volumeStore, podStore, secretStore} {
+		keys, err := s.Keys()
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) > 0 {
+			return nil, fmt.Errorf("meta store %s is not empty, backup can only be restored to a fresh home dir", s.BaseDir)
+		}
+	}
+	if _, err := os.Stat(cfg.NetworkDB); err == nil {
+		return nil, fmt.Errorf("network store %s exists, backup can only be restored to a fresh home dir", cfg.NetworkDB)
+	}
+
+	tr := tar.NewReader(r)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read backup")
+	}
+	if hdr.Name != backupManifestEntry {
+		return nil, fmt.Errorf("invalid backup, the first entry is %s, expected %s", hdr.Name, backupManifestEntry)
+	}
+	manifest := &BackupManifest{}
+	if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to decode backup manifest")
+	}
+	if manifest.Version != backupFormatVersion {
+		return nil, fmt.Errorf("backup format version %d is not supported, expected %d", manifest.Version, backupFormatVersion)
+	}
+
+	for end := false; !end; {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("backup is incomplete, %s is not found", backupEndEntry)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read backup")
+		}
+
+		name := path.Clean(hdr.Name)
+		if path.IsAbs(name) || strings.HasPrefix(name, "../") {
+			return nil, fmt.Errorf("invalid entry %s in backup", hdr.Name)
+		}
+		dir, base := path.Split(name)
+		dir = path.Clean(dir)
+
+		switch {
+		case name == backupEndEntry:
+			end = true
+
+		case name == backupContainersEntry:
+			n, err := store.Load(tr)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to restore container meta store")
+			}
+			log.With(ctx).Infof("%d containers are restored", n[meta.MetaJSONFile])
+
+		case name == backupVolumesEntry:
+			if _, err := volumeStore.Load(tr); err != nil {
+				return nil, errors.Wrap(err, "failed to restore volume meta store")
+			}
+
+		case name == backupPodsEntry:
+			n, err := podStore.Load(tr)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to restore pod meta store")
+			}
+			log.With(ctx).Infof("%d pods are restored", n[meta.MetaJSONFile])
+
+		case name == backupSecretsEntry:
+			n, err := secretStore.Load(tr)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to restore secret meta store")
+			}
+			log.With(ctx).Infof("%d secrets are restored", n[meta.MetaJSONFile])
+
+		case name == backupSecretKeyEntry:
+			if err := restoreSecretKey(cfg.SecretKeyFile, tr); err != nil {
+				return nil, err
+			}
+
+		case name == backupNetworkEntry:
+			if err := writeRestoredFile(cfg.NetworkDB, tr, 0644); err != nil {
+				return nil, errors.Wrap(err, "failed to restore network store")
+			}
+
+		case name == backupConfigEntry:
+			file := path.Join(restoreDir(cfg.HomeDir), "config.json")
+			if err := writeRestoredFile(file, tr, 0600); err != nil {
+				return nil, err
+			}
+			log.With(ctx).Warnf("the config file of daemon in backup is saved in %s, compare it with the current one", file)
+
+		case strings.HasPrefix(name, backupCheckpointDir+"/"):
+			// checkpoints/<container id>/<checkpoint id>/config.json
+			parts := strings.Split(name, "/")
+			if len(parts) != 4 || parts[3] != checkpointConfigPath {
+				return nil, fmt.Errorf("invalid entry %s in backup", hdr.Name)
+			}
+			file := filepath.Join(store.Path(parts[1]), "checkpoint", parts[2], checkpointConfigPath)
+			if err := writeRestoredFile(file, tr, checkpointConfigPerm); err != nil {
+				return nil, err
+			}
+
+		case dir == backupVolumeDataDir && strings.HasSuffix(base, ".tar"):
+			volume := strings.TrimSuffix(base, ".tar")
+			target, ok := manifest.VolumePaths[volume]
+			if !ok {
+				return nil, fmt.Errorf("path of volume %s is not in backup manifest", volume)
+			}
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return nil, err
+			}
+			if _, err := archive.Apply(ctx, target, tr); err != nil {
+				return nil, errors.Wrapf(err, "failed to restore data of volume %s", volume)
+			}
+
+		case dir == backupRWLayerDir && strings.HasSuffix(base, ".tar"):
+			file := path.Join(restoreDir(cfg.HomeDir), backupRWLayerDir, base)
+			if err := writeRestoredFile(file, tr, 0600); err != nil {
+				return nil, err
+			}
+
+		default:
+			log.With(ctx).Warnf("unknown entry %s in backup is ignored", hdr.Name)
+		}
+	}
+
+	return manifest, nil
+}
+
+// restoreSecretKey writes the key of secrets in backup into file, the
+// existing key file is kept only if it's the same key, otherwise the
+// restored secrets can't be decrypted.
+func restoreSecretKey(file string, r io.Reader) error {
+	key, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errors.Wrap(err, "failed to read secret key in backup")
+	}
+
+	existing, err := ioutil.ReadFile(file)
+	if err == nil {
+		if !bytes.Equal(existing, key) {
+			return fmt.Errorf("secret key file %s exists and differs from the one in backup, the restored secrets can't be decrypted with it", file)
+		}
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to read secret key file %s", file)
+	}
+
+	return writeRestoredFile(file, bytes.NewReader(key), 0600)
+}
+
+func writeRestoredFile(file string, r io.Reader, perm os.FileMode) error {
+	if err := os.MkdirAll(path.Dir(file), 0700); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "failed to write %s", file)
+	}
+	return f.Close()
+}
+
+// ApplyRestoredRWLayers applies the rw layers of containers restored from
+// backup, the snapshots of containers are created from their images first.
+// The layers which fail to be applied are kept, such as the image of
+// container is not pulled yet, and they are applied again when pouchd
+// starts next time.
+func (mgr *ContainerManager) ApplyRestoredRWLayers(ctx context.Context) error {
+	dir := path.Join(restoreDir(mgr.Config.HomeDir), backupRWLayerDir)
+	layers, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, fi := range layers {
+		id := strings.TrimSuffix(fi.Name(), ".tar")
+		file := path.Join(dir, fi.Name())
+
+		c, err := mgr.container(id)
+		if err != nil {
+			log.With(ctx).Warnf("container of restored rw layer %s is not found, remove it: %v", file, err)
+			os.Remove(file)
+			continue
+		}
+
+		if err := mgr.applyRestoredRWLayer(ctx, c, file); err != nil {
+			log.With(ctx).Warnf("failed to apply restored rw layer %s of container %s, pull image %s and restart pouchd to apply it again: %v",
+				file, c.ID, c.Config.Image, err)
+			continue
+		}
+		os.Remove(file)
+		log.With(ctx).Infof("restored rw layer of container %s is applied", c.ID)
+	}
+	return nil
+}
+
+func (mgr *ContainerManager) applyRestoredRWLayer(ctx context.Context, c *Container, file string) error {
+	ctx = ctrd.WithSnapshotter(ctx, c.Config.Snapshotter)
+
+	if _, err := mgr.Client.GetSnapshot(ctx, c.SnapshotKey()); err != nil {
+		if err := mgr.Client.CreateSnapshot(ctx, c.SnapshotKey(), c.Config.Image); err != nil {
+			return errors.Wrap(err, "failed to create snapshot")
+		}
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return mgr.Client.ApplySnapshotDiff(ctx, c.SnapshotKey(), f)
+}
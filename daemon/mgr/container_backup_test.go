@@ -0,0 +1,227 @@
+package mgr
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/collect"
+	"github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/storage/volume"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func backupMetaConfig(baseDir string) meta.Config {
+	return meta.Config{
+		Driver:  "local",
+		BaseDir: baseDir,
+		Buckets: []meta.Bucket{{Name: meta.MetaJSONFile, Type: reflect.TypeOf(Container{})}},
+	}
+}
+
+// newTestBackup writes a backup archive with a container which has a
+// checkpoint, a volume with data, a pod and a secret, the end entry is left
+// out if incomplete is set.
+func newTestBackup(t *testing.T, dir, volumePath string, incomplete bool) []byte {
+	store, err := meta.NewStore(backupMetaConfig(path.Join(dir, "src")))
+	assert.NoError(t, err)
+	assert.NoError(t, store.Put(&Container{
+		ID:    fsckContainerID,
+		Name:  "c1",
+		State: &types.ContainerState{Status: types.StatusStopped},
+	}))
+	containers := &bytes.Buffer{}
+	assert.NoError(t, store.Dump(containers))
+
+	podStore, err := meta.NewStore(PodMetaConfig(path.Join(dir, "src")))
+	assert.NoError(t, err)
+	assert.NoError(t, podStore.Put(&Pod{ID: "pod1", Name: "web"}))
+	pods := &bytes.Buffer{}
+	assert.NoError(t, podStore.Dump(pods))
+
+	secretStore, err := meta.NewStore(SecretMetaConfig(path.Join(dir, "src")))
+	assert.NoError(t, err)
+	assert.NoError(t, secretStore.Put(&Secret{ID: "secret1", Name: "password"}))
+	secrets := &bytes.Buffer{}
+	assert.NoError(t, secretStore.Dump(secrets))
+
+	manifest, err := json.Marshal(&BackupManifest{
+		Version:     backupFormatVersion,
+		Created:     time.Now().UTC(),
+		VolumeData:  true,
+		VolumePaths: map[string]string{"v1": volumePath},
+	})
+	assert.NoError(t, err)
+
+	volumeData := &bytes.Buffer{}
+	vw := tar.NewWriter(volumeData)
+	assert.NoError(t, writeTarFile(vw, "data", []byte("hello")))
+	assert.NoError(t, vw.Close())
+
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	assert.NoError(t, writeTarFile(tw, backupManifestEntry, manifest))
+	assert.NoError(t, writeTarFile(tw, backupContainersEntry, containers.Bytes()))
+	assert.NoError(t, writeTarFile(tw, backupPodsEntry, pods.Bytes()))
+	assert.NoError(t, writeTarFile(tw, backupSecretsEntry, secrets.Bytes()))
+	assert.NoError(t, writeTarFile(tw, backupSecretKeyEntry, []byte("key")))
+	assert.NoError(t, writeTarFile(tw, path.Join(backupCheckpointDir, fsckContainerID, "ckpt", checkpointConfigPath), []byte("{}")))
+	assert.NoError(t, writeTarFile(tw, path.Join(backupVolumeDataDir, "v1.tar"), volumeData.Bytes()))
+	assert.NoError(t, writeTarFile(tw, path.Join(backupRWLayerDir, fsckContainerID+".tar"), []byte("layer")))
+	if !incomplete {
+		assert.NoError(t, writeTarFile(tw, backupEndEntry, nil))
+	}
+	assert.NoError(t, tw.Flush())
+	return buf.Bytes()
+}
+
+func newTestRestoreConfig(homeDir string) RestoreConfig {
+	return RestoreConfig{
+		HomeDir:       homeDir,
+		ContainerMeta: backupMetaConfig(path.Join(homeDir, "containers")),
+		VolumeMeta:    volume.MetaConfig(path.Join(homeDir, "volume", "volume.db")),
+		PodMeta:       PodMetaConfig(homeDir),
+		SecretMeta:    SecretMetaConfig(homeDir),
+		SecretKeyFile: path.Join(homeDir, "secrets", "secret.key"),
+		NetworkDB:     NetworkDBPath(homeDir),
+	}
+}
+
+func TestRestoreBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	homeDir := path.Join(dir, "home")
+	volumePath := path.Join(dir, "volumes", "v1")
+	cfg := newTestRestoreConfig(homeDir)
+
+	manifest, err := RestoreBackup(context.Background(), bytes.NewReader(newTestBackup(t, dir, volumePath, false)), cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, backupFormatVersion, manifest.Version)
+
+	store, err := meta.NewStore(cfg.ContainerMeta)
+	assert.NoError(t, err)
+	obj, err := store.Get(fsckContainerID)
+	assert.NoError(t, err)
+	assert.Equal(t, "c1", obj.(*Container).Name)
+
+	podStore, err := meta.NewStore(cfg.PodMeta)
+	assert.NoError(t, err)
+	obj, err = podStore.Get("pod1")
+	assert.NoError(t, err)
+	assert.Equal(t, "web", obj.(*Pod).Name)
+	secretStore, err := meta.NewStore(cfg.SecretMeta)
+	assert.NoError(t, err)
+	obj, err = secretStore.Get("secret1")
+	assert.NoError(t, err)
+	assert.Equal(t, "password", obj.(*Secret).Name)
+	data, err := ioutil.ReadFile(cfg.SecretKeyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "key", string(data))
+
+	_, err = os.Stat(filepath.Join(store.Path(fsckContainerID), "checkpoint", "ckpt", checkpointConfigPath))
+	assert.NoError(t, err)
+	data, err = ioutil.ReadFile(path.Join(volumePath, "data"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+	data, err = ioutil.ReadFile(path.Join(restoreDir(homeDir), backupRWLayerDir, fsckContainerID+".tar"))
+	assert.NoError(t, err)
+	assert.Equal(t, "layer", string(data))
+
+	// backup can only be restored to a fresh home dir.
+	_, err = RestoreBackup(context.Background(), bytes.NewReader(newTestBackup(t, dir, volumePath, false)), cfg)
+	assert.Error(t, err)
+}
+
+func TestRestoreIncompleteBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	backup := newTestBackup(t, dir, path.Join(dir, "volumes", "v1"), true)
+	_, err = RestoreBackup(context.Background(), bytes.NewReader(backup), newTestRestoreConfig(path.Join(dir, "home")))
+	assert.Error(t, err)
+}
+
+func TestRestoreSecretKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := path.Join(dir, "secrets", "secret.key")
+	assert.NoError(t, restoreSecretKey(file, bytes.NewReader([]byte("key"))))
+	fi, err := os.Stat(file)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	// the same key is kept, but a different one can't be overwritten.
+	assert.NoError(t, restoreSecretKey(file, bytes.NewReader([]byte("key"))))
+	assert.Error(t, restoreSecretKey(file, bytes.NewReader([]byte("other"))))
+	data, err := ioutil.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, "key", string(data))
+}
+
+// backupVolumeMgr dumps no volume, it runs the given func while the volume
+// meta store is dumped.
+type backupVolumeMgr struct {
+	VolumeMgr
+	dumping func()
+}
+
+func (v *backupVolumeMgr) Dump(ctx context.Context, w io.Writer) error {
+	v.dumping()
+	return nil
+}
+
+func TestBackupBlocksMutations(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := meta.NewStore(backupMetaConfig(path.Join(dir, "containers")))
+	assert.NoError(t, err)
+	defer store.Shutdown()
+
+	cfg := &config.Config{HomeDir: dir}
+	cfg.NetworkConfig.MetaPath = path.Join(dir, "network")
+
+	mgr := &ContainerManager{
+		Store:    store,
+		NameToID: collect.NewSafeMap(),
+		cache:    collect.NewSafeMap(),
+		Config:   cfg,
+	}
+
+	// the container renamed during dumping is renamed after the stores
+	// are dumped.
+	renamed := make(chan error, 1)
+	blocked := false
+	mgr.VolumeMgr = &backupVolumeMgr{dumping: func() {
+		go func() {
+			renamed <- mgr.Rename(context.Background(), "c1", "c2")
+		}()
+		select {
+		case <-renamed:
+		case <-time.After(100 * time.Millisecond):
+			blocked = true
+		}
+	}}
+
+	assert.NoError(t, mgr.Backup(context.Background(), nil, ioutil.Discard))
+	assert.True(t, blocked)
+	assert.Error(t, <-renamed)
+}
@@ -0,0 +1,260 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	buildtypes "github.com/alibaba/pouch/builder/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/daemon/containerio"
+	"github.com/alibaba/pouch/lxcfs"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/alibaba/pouch/storage/quota"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// BuildContainerLabel is the label attached to the containers which
+	// run the build steps for builder. It helps to distinguish the build
+	// step containers from the normal containers in events.
+	BuildContainerLabel = "io.pouch.builder"
+
+	// buildCgroupParent is the sub cgroup in which the build step
+	// containers are placed.
+	buildCgroupParent = "buildkit"
+
+	// buildContainerStopTimeout is the timeout in second to stop the build
+	// step container when the build has been canceled.
+	buildContainerStopTimeout = 10
+)
+
+// RunBuildContainer runs the build step container and waits for it to exit.
+//
+// The build step container is not stored in meta store and not shown in the
+// container list, but it is created with the same runtime, cgroup parent,
+// lxcfs, disk quota, hook plugins and IO handling as the normal container.
+func (mgr *ContainerManager) RunBuildContainer(ctx context.Context, cfg *buildtypes.BuildContainerConfig) error {
+	if cfg.ID == "" || cfg.Spec == nil || cfg.RootFS == "" {
+		return errors.Wrap(errtypes.ErrInvalidParam, "build container requires id, spec and rootfs")
+	}
+
+	c, err := mgr.newBuildContainer(cfg.ID)
+	if err != nil {
+		return err
+	}
+
+	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
+
+	if !mgr.buildContainers.PutIfAbsent(c.ID, c) {
+		return errors.Wrapf(errtypes.ErrAlreadyExisted, "build container %s", c.ID)
+	}
+	defer mgr.buildContainers.Remove(c.ID)
+
+	if err := mgr.setupBuildSpec(ctx, c, cfg); err != nil {
+		return err
+	}
+
+	if cfg.DiskQuota != "" {
+		if _, err := quota.SetRootfsDiskQuota(cfg.RootFS, cfg.DiskQuota, 0, false); err != nil {
+			return errors.Wrapf(err, "failed to set disk quota for build container %s", c.ID)
+		}
+	}
+
+	runtimeOptions, err := mgr.generateRuntimeOptions(c.HostConfig.Runtime)
+	if err != nil {
+		return err
+	}
+
+	cntrio := mgr.initBuildContainerIO(c.ID, cfg)
+	defer func() {
+		if err := cntrio.Close(); err != nil {
+			log.With(ctx).Warnf("failed to close build container IO: %v", err)
+		}
+		mgr.IOs.Remove(c.ID)
+	}()
+
+	ctrdContainer := &ctrd.Container{
+		ID:             c.ID,
+		Labels:         c.Config.Labels,
+		RuntimeType:    c.HostConfig.RuntimeType,
+		RuntimeOptions: runtimeOptions,
+		Spec:           cfg.Spec,
+		IO:             cntrio,
+		RootFSProvided: true,
+		BaseFS:         cfg.RootFS,
+		UseSystemd:     mgr.Config.UseSystemd(),
+	}
+
+	if err := mgr.Client.CreateContainer(ctx, ctrdContainer, ""); err != nil {
+		return errors.Wrapf(err, "failed to create build container %s on containerd", c.ID)
+	}
+	mgr.LogContainerEvent(ctx, c, "start")
+
+	// NOTE: the task and container in containerd have been deleted after
+	// the exit hooks, DestroyContainer here is used to remove the
+	// container from the ctrd watch list, or to stop the build container
+	// if the build has been canceled.
+	defer func() {
+		if _, err := mgr.Client.DestroyContainer(context.TODO(), c.ID, buildContainerStopTimeout); err != nil && !errtypes.IsNotfound(err) {
+			log.With(ctx).Warnf("failed to destroy build container: %v", err)
+		}
+		mgr.LogContainerEvent(ctx, c, "destroy")
+	}()
+
+	msgCh := make(chan *ctrd.Message, 1)
+	go func() {
+		msgCh <- mgr.Client.ProbeContainer(context.TODO(), c.ID, 0)
+	}()
+
+	var msg *ctrd.Message
+	select {
+	case msg = <-msgCh:
+	case <-ctx.Done():
+		log.With(ctx).Infof("build has been canceled, stop the build container")
+		return ctx.Err()
+	}
+
+	if err := msg.RawError(); err != nil {
+		return errors.Wrapf(err, "failed to wait build container %s", c.ID)
+	}
+	if code := msg.ExitCode(); code != 0 {
+		return fmt.Errorf("process returned non-zero exit code: %d", code)
+	}
+	return nil
+}
+
+// newBuildContainer returns the in-memory container used to describe the
+// build step container. It is only used to reuse the spec, plugin and events
+// functions for the normal container.
+func (mgr *ContainerManager) newBuildContainer(id string) (*Container, error) {
+	runtime := mgr.Config.DefaultRuntime
+	runtimeType, err := mgr.getRuntimeType(runtime)
+	if err != nil {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "unknown runtime %s: %v", runtime, err)
+	}
+
+	return &Container{
+		ID:   id,
+		Name: "buildkit-" + utils.TruncateID(id),
+		Config: &types.ContainerConfig{
+			Labels: map[string]string{
+				BuildContainerLabel: "true",
+			},
+		},
+		HostConfig: &types.HostConfig{
+			Runtime:      runtime,
+			RuntimeType:  runtimeType,
+			CgroupParent: mgr.Config.CgroupParent,
+		},
+		State: &types.ContainerState{
+			Status: types.StatusCreated,
+		},
+		Created: time.Now().UTC().Format(utils.TimeLayout),
+	}, nil
+}
+
+// setupBuildSpec fills the build step spec with the pouchd's settings.
+func (mgr *ContainerManager) setupBuildSpec(ctx context.Context, c *Container, cfg *buildtypes.BuildContainerConfig) error {
+	s := cfg.Spec
+	if s.Root == nil {
+		s.Root = &specs.Root{}
+	}
+	s.Root.Path = cfg.RootFS
+
+	if s.Linux == nil {
+		s.Linux = &specs.Linux{}
+	}
+	s.Linux.CgroupsPath = buildCgroupsPath(c.HostConfig.CgroupParent, c.ID, mgr.Config.UseSystemd())
+
+	// lxcfs makes the /proc of build step container isolated.
+	if mgr.Config.IsLxcfsEnabled && lxcfs.IsLxcfsEnabled {
+		sourceDir := lxcfs.LxcfsHomeDir + "/proc/"
+		for _, procFile := range lxcfs.LxcfsProcFiles {
+			s.Mounts = append(s.Mounts, specs.Mount{
+				Source:      sourceDir + procFile,
+				Destination: "/proc/" + procFile,
+				Type:        "bind",
+				Options:     []string{"rbind", "rprivate"},
+			})
+		}
+	}
+
+	var (
+		prioArr []int
+		argsArr [][]string
+		err     error
+	)
+	if mgr.containerPlugin != nil {
+		prioArr, argsArr, err = mgr.containerPlugin.PreStart(ctx, c)
+		if err != nil {
+			return errors.Wrapf(err, "get pre-start hook error from container plugin")
+		}
+	}
+
+	return setupHook(ctx, c, &SpecWrapper{
+		s:          s,
+		ctrMgr:     mgr,
+		volMgr:     mgr.VolumeMgr,
+		netMgr:     mgr.NetworkMgr,
+		prioArr:    prioArr,
+		argsArr:    argsArr,
+		useSystemd: mgr.Config.UseSystemd(),
+		cdiCache:   mgr.cdiCache,
+	})
+}
+
+// initBuildContainerIO creates the IO of build step container and redirects
+// the output into the writers given by builder.
+func (mgr *ContainerManager) initBuildContainerIO(id string, cfg *buildtypes.BuildContainerConfig) *containerio.IO {
+	cntrio := containerio.NewIO(id, cfg.Stdin != nil)
+	if cfg.Stdout != nil {
+		cntrio.Stream().AddStdoutWriter(cfg.Stdout)
+	}
+	if cfg.Stderr != nil {
+		cntrio.Stream().AddStderrWriter(cfg.Stderr)
+	}
+	if cfg.Stdin != nil {
+		go func() {
+			io.Copy(cntrio.Stream().StdinPipe(), cfg.Stdin)
+			cntrio.Stream().StdinPipe().Close()
+		}()
+	}
+
+	mgr.IOs.Put(id, cntrio)
+	return cntrio
+}
+
+// buildContainer returns the running build step container by id.
+func (mgr *ContainerManager) buildContainer(id string) (*Container, bool) {
+	v, ok := mgr.buildContainers.Get(id).Result()
+	if !ok {
+		return nil, false
+	}
+
+	c, ok := v.(*Container)
+	return c, ok
+}
+
+// buildCgroupsPath returns the cgroups path for the build step container,
+// which is placed under the sub cgroup of daemon's cgroup parent.
+func buildCgroupsPath(cgroupParent, id string, useSystemd bool) string {
+	if useSystemd {
+		if cgroupParent == "" {
+			cgroupParent = "system.slice"
+		}
+		return filepath.Clean(cgroupParent) + ":" + defaultCgroupParent + "-" + buildCgroupParent + ":" + id
+	}
+
+	if cgroupParent == "" {
+		cgroupParent = "/default"
+	}
+	return filepath.Clean(filepath.Join("/", cgroupParent, buildCgroupParent, id))
+}
@@ -0,0 +1,68 @@
+package mgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	buildtypes "github.com/alibaba/pouch/builder/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/collect"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildCgroupsPath(t *testing.T) {
+	for _, tc := range []struct {
+		cgroupParent string
+		useSystemd   bool
+		expected     string
+	}{
+		{"", false, "/default/buildkit/abc"},
+		{"/pouch", false, "/pouch/buildkit/abc"},
+		{"pouch/", false, "/pouch/buildkit/abc"},
+		{"", true, "system.slice:pouch-buildkit:abc"},
+		{"pouch.slice", true, "pouch.slice:pouch-buildkit:abc"},
+	} {
+		assert.Equal(t, tc.expected, buildCgroupsPath(tc.cgroupParent, "abc", tc.useSystemd))
+	}
+}
+
+func TestSetupBuildSpec(t *testing.T) {
+	mgr := &ContainerManager{
+		Config: &config.Config{
+			DefaultRuntime: "runc",
+			CgroupParent:   "/pouch",
+			Runtimes: map[string]types.Runtime{
+				"runc": {Path: "runc"},
+			},
+		},
+		buildContainers: collect.NewSafeMap(),
+	}
+
+	c, err := mgr.newBuildContainer("0123456789abcdef")
+	assert.NoError(t, err)
+	assert.Equal(t, "buildkit-0123456789ab", c.Name)
+	assert.Equal(t, "true", c.Config.Labels[BuildContainerLabel])
+
+	cfg := &buildtypes.BuildContainerConfig{
+		ID:     c.ID,
+		Spec:   &specs.Spec{},
+		RootFS: "/tmp/rootfs",
+	}
+	assert.NoError(t, mgr.setupBuildSpec(context.TODO(), c, cfg))
+	assert.Equal(t, "/tmp/rootfs", cfg.Spec.Root.Path)
+	assert.Equal(t, "/pouch/buildkit/0123456789abcdef", cfg.Spec.Linux.CgroupsPath)
+	assert.NotNil(t, cfg.Spec.Hooks)
+
+	mgr.buildContainers.Put(c.ID, c)
+	got, ok := mgr.buildContainer(c.ID)
+	assert.True(t, ok)
+	assert.Equal(t, c, got)
+
+	// the step with the id of running build container is rejected.
+	err = mgr.RunBuildContainer(context.TODO(), cfg)
+	assert.True(t, errtypes.IsAlreadyExisted(err), err)
+}
@@ -0,0 +1,290 @@
+package mgr
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/system"
+
+	containerdtypes "github.com/containerd/containerd/api/types"
+	"github.com/pkg/errors"
+)
+
+// defaultCPUPeriod is the default cfs period of cpu.max in microseconds.
+const defaultCPUPeriod = 100000
+
+// containerCgroupV2Path returns the cgroup v2 directory of container.
+func (mgr *ContainerManager) containerCgroupV2Path(c *Container) (string, error) {
+	return system.CgroupV2Path(containerCgroupsPath(c, mgr.Config.UseSystemd()), mgr.Config.UseSystemd())
+}
+
+// setCgroupV2Resources writes the resources of container into the cgroup v2
+// controllers directly. The runtime only knows the cgroup v1 fields in OCI
+// spec, some of them can't be converted into v2 on update, and the v2 only
+// knobs like memory.high are not supported by the runtime at all.
+func (mgr *ContainerManager) setCgroupV2Resources(c *Container) error {
+	if !system.IsCgroup2UnifiedMode() {
+		return nil
+	}
+
+	values, err := cgroupV2Resources(&c.HostConfig.Resources)
+	if err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	dir, err := mgr.containerCgroupV2Path(c)
+	if err != nil {
+		return err
+	}
+	return system.WriteCgroupV2Files(dir, values)
+}
+
+// cgroupV2Resources translates the resources into the values of cgroup v2
+// controller files, only the resources set by user are translated.
+func cgroupV2Resources(r *types.Resources) (map[string]string, error) {
+	values := map[string]string{}
+
+	// memory controller
+	if r.Memory > 0 {
+		values["memory.max"] = strconv.FormatInt(r.Memory, 10)
+
+		if r.MemoryWmarkRatio != nil && *r.MemoryWmarkRatio > 0 && *r.MemoryWmarkRatio <= 100 {
+			values["memory.high"] = strconv.FormatInt(r.Memory*(*r.MemoryWmarkRatio)/100, 10)
+		}
+	}
+	switch {
+	case r.MemorySwap == -1:
+		values["memory.swap.max"] = "max"
+	case r.MemorySwap > 0:
+		// memory.swap.max only limits the swap, but MemorySwap is the
+		// limit of memory plus swap.
+		if r.Memory <= 0 || r.MemorySwap < r.Memory {
+			return nil, fmt.Errorf("memory swap %d should be larger than memory limit %d", r.MemorySwap, r.Memory)
+		}
+		values["memory.swap.max"] = strconv.FormatInt(r.MemorySwap-r.Memory, 10)
+	}
+	if r.MemoryReservation > 0 {
+		values["memory.low"] = strconv.FormatInt(r.MemoryReservation, 10)
+	}
+
+	// cpu controller
+	if r.CPUShares > 0 {
+		values["cpu.weight"] = strconv.FormatUint(cpuSharesToWeight(uint64(r.CPUShares)), 10)
+	}
+	if r.CPUQuota != 0 || r.CPUPeriod != 0 {
+		quota := "max"
+		if r.CPUQuota > 0 {
+			quota = strconv.FormatInt(r.CPUQuota, 10)
+		}
+		period := int64(defaultCPUPeriod)
+		if r.CPUPeriod > 0 {
+			period = r.CPUPeriod
+		}
+		values["cpu.max"] = fmt.Sprintf("%s %d", quota, period)
+	}
+	if r.CpusetCpus != "" {
+		values["cpuset.cpus"] = r.CpusetCpus
+	}
+	if r.CpusetMems != "" {
+		values["cpuset.mems"] = r.CpusetMems
+	}
+
+	// io controller
+	var weights []string
+	if r.BlkioWeight > 0 {
+		weights = append(weights, fmt.Sprintf("default %d", blkioWeightToIOWeight(r.BlkioWeight)))
+	}
+	weightDevices, err := ctrd.GetWeightDevice(r.BlkioWeightDevice)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get blkio weight device")
+	}
+	for _, d := range weightDevices {
+		if d.Weight != nil {
+			weights = append(weights, fmt.Sprintf("%d:%d %d", d.Major, d.Minor, blkioWeightToIOWeight(*d.Weight)))
+		}
+	}
+	if len(weights) > 0 {
+		values["io.weight"] = strings.Join(weights, "\n")
+	}
+
+	ioMax, err := cgroupV2IOMax(r)
+	if err != nil {
+		return nil, err
+	}
+	if ioMax != "" {
+		values["io.max"] = ioMax
+	}
+
+	// pids controller
+	switch {
+	case r.PidsLimit > 0:
+		values["pids.max"] = strconv.FormatInt(r.PidsLimit, 10)
+	case r.PidsLimit < 0:
+		values["pids.max"] = "max"
+	}
+
+	return values, nil
+}
+
+// cgroupV2IOMax merges the throttle devices into the lines of io.max, one
+// line for each device, like "8:0 rbps=1048576 wiops=100".
+func cgroupV2IOMax(r *types.Resources) (string, error) {
+	limits := map[string][]string{}
+	for _, item := range []struct {
+		key     string
+		devices []*types.ThrottleDevice
+	}{
+		{"rbps", r.BlkioDeviceReadBps},
+		{"wbps", r.BlkioDeviceWriteBps},
+		{"riops", r.BlkioDeviceReadIOps},
+		{"wiops", r.BlkioDeviceWriteIOps},
+	} {
+		devices, err := ctrd.GetThrottleDevice(item.devices)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to get blkio throttle device")
+		}
+		for _, d := range devices {
+			dev := fmt.Sprintf("%d:%d", d.Major, d.Minor)
+			limits[dev] = append(limits[dev], fmt.Sprintf("%s=%d", item.key, d.Rate))
+		}
+	}
+
+	lines := make([]string, 0, len(limits))
+	for dev, l := range limits {
+		lines = append(lines, dev+" "+strings.Join(l, " "))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n"), nil
+}
+
+// cpuSharesToWeight converts cpu.shares in [2, 262144] into cpu.weight
+// in [1, 10000], which is same to the runc's conversion.
+func cpuSharesToWeight(shares uint64) uint64 {
+	if shares < 2 {
+		shares = 2
+	}
+	if shares > 262144 {
+		shares = 262144
+	}
+	return 1 + ((shares-2)*9999)/262142
+}
+
+// blkioWeightToIOWeight converts blkio.weight in [10, 1000] into io.weight
+// in [1, 10000], which is same to the runc's conversion.
+func blkioWeightToIOWeight(weight uint16) uint64 {
+	w := uint64(weight)
+	if w < 10 {
+		w = 10
+	}
+	if w > 1000 {
+		w = 1000
+	}
+	return 1 + (w-10)*9999/990
+}
+
+// cgroupV2Stats reads the metrics from the cgroup v2 directory of container.
+func (mgr *ContainerManager) cgroupV2Stats(c *Container) (*containerdtypes.Metric, *ContainerMetrics, error) {
+	dir, err := mgr.containerCgroupV2Path(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metrics, err := system.ReadCgroupV2Metrics(dir)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to read cgroup v2 metrics of container %s", c.ID)
+	}
+
+	meta := &containerdtypes.Metric{
+		Timestamp: time.Now(),
+		ID:        c.ID,
+	}
+	return meta, &ContainerMetrics{V2: metrics}, nil
+}
+
+// setCgroupV2Stats converts the cgroup v2 metrics into container stats.
+func setCgroupV2Stats(res *types.ContainerStats, m *system.CgroupV2Metrics) {
+	if m.Pids != nil {
+		res.PidsStats = &types.PidsStats{
+			Current: m.Pids.Current,
+			Limit:   m.Pids.Limit,
+		}
+	}
+
+	if m.CPU != nil {
+		res.CPUStats = &types.CPUStats{
+			CPUUsage: &types.CPUUsage{
+				TotalUsage:        m.CPU.UsageUsec * 1000,
+				UsageInKernelmode: m.CPU.SystemUsec * 1000,
+				UsageInUsermode:   m.CPU.UserUsec * 1000,
+			},
+			ThrottlingData: &types.ThrottlingData{
+				Periods:          m.CPU.NrPeriods,
+				ThrottledPeriods: m.CPU.NrThrottled,
+				ThrottledTime:    m.CPU.ThrottledUsec * 1000,
+			},
+			Pressure: toPressureStats(m.CPU.Pressure),
+		}
+	}
+
+	if m.IO != nil {
+		res.BlkioStats = &types.BlkioStats{
+			IoServiceBytesRecursive: []*types.BlkioStatEntry{},
+			IoServicedRecursive:     []*types.BlkioStatEntry{},
+			Pressure:                toPressureStats(m.IO.Pressure),
+		}
+		for _, e := range m.IO.Entries {
+			res.BlkioStats.IoServiceBytesRecursive = append(res.BlkioStats.IoServiceBytesRecursive,
+				&types.BlkioStatEntry{Major: e.Major, Minor: e.Minor, Op: "read", Value: e.Rbytes},
+				&types.BlkioStatEntry{Major: e.Major, Minor: e.Minor, Op: "write", Value: e.Wbytes},
+			)
+			res.BlkioStats.IoServicedRecursive = append(res.BlkioStats.IoServicedRecursive,
+				&types.BlkioStatEntry{Major: e.Major, Minor: e.Minor, Op: "read", Value: e.Rios},
+				&types.BlkioStatEntry{Major: e.Major, Minor: e.Minor, Op: "write", Value: e.Wios},
+			)
+		}
+	}
+
+	if m.Memory != nil {
+		res.MemoryStats = &types.MemoryStats{
+			Usage:    m.Memory.Usage,
+			Limit:    m.Memory.Limit,
+			Failcnt:  m.Memory.Events["max"],
+			Stats:    m.Memory.Stats,
+			Pressure: toPressureStats(m.Memory.Pressure),
+		}
+		if m.Memory.High != math.MaxUint64 {
+			res.MemoryStats.High = m.Memory.High
+		}
+	}
+}
+
+func toPressureStats(p *system.Pressure) *types.PressureStats {
+	if p == nil {
+		return nil
+	}
+
+	convert := func(d *system.PressureData) *types.PressureData {
+		if d == nil {
+			return nil
+		}
+		return &types.PressureData{
+			Avg10:  d.Avg10,
+			Avg60:  d.Avg60,
+			Avg300: d.Avg300,
+			Total:  d.Total,
+		}
+	}
+	return &types.PressureStats{
+		Some: convert(p.Some),
+		Full: convert(p.Full),
+	}
+}
@@ -0,0 +1,94 @@
+package mgr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/system"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCgroupV2Resources(t *testing.T) {
+	ratio := int64(80)
+	values, err := cgroupV2Resources(&types.Resources{
+		Memory:            1000 * 1024 * 1024,
+		MemorySwap:        1500 * 1024 * 1024,
+		MemoryReservation: 500 * 1024 * 1024,
+		MemoryWmarkRatio:  &ratio,
+		CPUShares:         1024,
+		CPUQuota:          50000,
+		CpusetCpus:        "0-1",
+		BlkioWeight:       500,
+		PidsLimit:         -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"memory.max":      "1048576000",
+		"memory.high":     "838860800",
+		"memory.swap.max": "524288000",
+		"memory.low":      "524288000",
+		"cpu.weight":      "39",
+		"cpu.max":         "50000 100000",
+		"cpuset.cpus":     "0-1",
+		"io.weight":       "default 4950",
+		"pids.max":        "max",
+	}, values)
+
+	values, err = cgroupV2Resources(&types.Resources{CPUQuota: -1, CPUPeriod: 200000, MemorySwap: -1})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"cpu.max":         "max 200000",
+		"memory.swap.max": "max",
+	}, values)
+
+	values, err = cgroupV2Resources(&types.Resources{})
+	assert.NoError(t, err)
+	assert.Empty(t, values)
+
+	_, err = cgroupV2Resources(&types.Resources{MemorySwap: 1024})
+	assert.Error(t, err)
+}
+
+func TestSetCgroupV2Stats(t *testing.T) {
+	res := &types.ContainerStats{}
+	setCgroupV2Stats(res, &system.CgroupV2Metrics{
+		Pids: &system.CgroupV2PidsStat{Current: 2, Limit: 100},
+		CPU: &system.CgroupV2CPUStat{
+			UsageUsec:     3,
+			UserUsec:      2,
+			SystemUsec:    1,
+			ThrottledUsec: 4,
+			Pressure: &system.Pressure{
+				Some: &system.PressureData{Avg10: 1.5, Total: 10},
+			},
+		},
+		Memory: &system.CgroupV2MemoryStat{
+			Usage:  1024,
+			Limit:  math.MaxUint64,
+			High:   math.MaxUint64,
+			Events: map[string]uint64{"max": 3},
+			Stats:  map[string]uint64{"anon": 512},
+		},
+		IO: &system.CgroupV2IOStat{
+			Entries: []system.CgroupV2IOEntry{{Major: 8, Rbytes: 10, Wbytes: 20, Rios: 1, Wios: 2}},
+		},
+	})
+
+	assert.Equal(t, &types.PidsStats{Current: 2, Limit: 100}, res.PidsStats)
+	assert.Equal(t, &types.CPUUsage{TotalUsage: 3000, UsageInKernelmode: 1000, UsageInUsermode: 2000}, res.CPUStats.CPUUsage)
+	assert.Equal(t, uint64(4000), res.CPUStats.ThrottlingData.ThrottledTime)
+	assert.Equal(t, &types.PressureStats{Some: &types.PressureData{Avg10: 1.5, Total: 10}}, res.CPUStats.Pressure)
+
+	assert.Equal(t, uint64(1024), res.MemoryStats.Usage)
+	assert.Equal(t, uint64(0), res.MemoryStats.High)
+	assert.Equal(t, uint64(3), res.MemoryStats.Failcnt)
+	assert.Nil(t, res.MemoryStats.Pressure)
+
+	assert.Equal(t, []*types.BlkioStatEntry{
+		{Major: 8, Op: "read", Value: 10},
+		{Major: 8, Op: "write", Value: 20},
+	}, res.BlkioStats.IoServiceBytesRecursive)
+	assert.Len(t, res.BlkioStats.IoServicedRecursive, 2)
+}
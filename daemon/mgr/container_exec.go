@@ -4,6 +4,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/ctrd"
@@ -11,8 +15,11 @@ import (
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/randomid"
 	"github.com/alibaba/pouch/pkg/streams"
+	"github.com/alibaba/pouch/pkg/term"
 	"github.com/alibaba/pouch/pkg/user"
+	"github.com/alibaba/pouch/pkg/utils"
 
+	"github.com/containerd/containerd"
 	"github.com/docker/docker/daemon/caps"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
@@ -35,6 +42,16 @@ func (mgr *ContainerManager) CreateExec(ctx context.Context, name string, config
 		return "", err
 	}
 
+	if config.WorkingDir != "" && !filepath.IsAbs(config.WorkingDir) {
+		return "", errors.Wrapf(errtypes.ErrInvalidParam, "working directory %s should be an absolute path", config.WorkingDir)
+	}
+
+	if config.DetachKeys != "" {
+		if _, err := term.ToBytes(config.DetachKeys); err != nil {
+			return "", errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
+	}
+
 	execid := randomid.Generate()
 	execConfig := &ContainerExecConfig{
 		ExecID:           execid,
@@ -76,6 +93,7 @@ func (mgr *ContainerManager) StartExec(ctx context.Context, execid string, cfg *
 			execConfig.ExitCode = int64(exitCode)
 			execConfig.Exited = true
 			execConfig.Used = true
+			execConfig.FinishedAt = time.Now()
 			execConfig.Unlock()
 		}
 	}()
@@ -101,7 +119,10 @@ func (mgr *ContainerManager) StartExec(ctx context.Context, execid string, cfg *
 		return err
 	}
 
-	cwd := c.Config.WorkingDir
+	cwd := execConfig.WorkingDir
+	if cwd == "" {
+		cwd = c.Config.WorkingDir
+	}
 	if cwd == "" {
 		cwd = "/"
 	}
@@ -158,6 +179,10 @@ func (mgr *ContainerManager) StartExec(ctx context.Context, execid string, cfg *
 
 	// NOTE: always close stdin pipe for exec process
 	cfg.CloseStdin = true
+	if execConfig.DetachKeys != "" {
+		// the detach keys have been validated when exec created.
+		cfg.DetachKeys, _ = term.ToBytes(execConfig.DetachKeys)
+	}
 	eio, err := mgr.initExecIO(execid, cfg.UseStdin)
 	if err != nil {
 		execConfig.Unlock()
@@ -173,19 +198,44 @@ func (mgr *ContainerManager) StartExec(ctx context.Context, execid string, cfg *
 	}()
 
 	execConfig.Running = true
+	execConfig.StartedAt = time.Now()
 	mgr.LogContainerEvent(ctx, c, "exec_start")
 
 	execConfig.Unlock()
-	if err := mgr.Client.ExecContainer(ctx, &ctrd.Process{
-		ContainerID: execConfig.ContainerID,
-		ExecID:      execid,
-		IO:          eio,
-		P:           process,
-		Detach:      cfg.Detach,
-	}, timeout); err != nil {
+
+	execErrCh := make(chan error, 1)
+	go func() {
+		execErrCh <- mgr.Client.ExecContainer(ctx, &ctrd.Process{
+			ContainerID: execConfig.ContainerID,
+			ExecID:      execid,
+			IO:          eio,
+			P:           process,
+			Detach:      cfg.Detach,
+		}, timeout)
+	}()
+
+	select {
+	case err := <-attachErrCh:
+		// detached by the detach keys, the exec process keeps running.
+		if err == term.ErrDetached {
+			log.With(ctx).Infof("detach from exec process %s", execid)
+			return nil
+		}
+
+		if execErr := <-execErrCh; execErr != nil {
+			return execErr
+		}
 		return err
+	case err := <-execErrCh:
+		if err != nil {
+			return err
+		}
+
+		if err := <-attachErrCh; err != term.ErrDetached {
+			return err
+		}
+		return nil
 	}
-	return <-attachErrCh
 }
 
 // InspectExec returns low-level information about exec command.
@@ -195,24 +245,109 @@ func (mgr *ContainerManager) InspectExec(ctx context.Context, execid string) (*t
 		return nil, err
 	}
 
+	return mgr.inspectExec(execConfig), nil
+}
+
+func (mgr *ContainerManager) inspectExec(execConfig *ContainerExecConfig) *types.ContainerExecInspect {
 	entrypoint, args := mgr.getEntrypointAndArgs(execConfig.Cmd)
+
+	execConfig.Lock()
+	defer execConfig.Unlock()
+
 	processConfig := &types.ProcessConfig{
 		Privileged: execConfig.Privileged,
 		Tty:        execConfig.Tty,
 		User:       execConfig.User,
 		Arguments:  args,
 		Entrypoint: entrypoint,
+		WorkingDir: execConfig.WorkingDir,
 	}
-	execConfig.Lock()
-	defer execConfig.Unlock()
-	return &types.ContainerExecInspect{
+
+	inspect := &types.ContainerExecInspect{
 		ID: execConfig.ExecID,
 		// FIXME: try to use the correct running status of exec
 		Running:       execConfig.Running,
+		Exited:        execConfig.Exited,
 		ExitCode:      execConfig.ExitCode,
 		ContainerID:   execConfig.ContainerID,
 		ProcessConfig: processConfig,
-	}, nil
+		DetachKeys:    execConfig.DetachKeys,
+		OpenStdin:     execConfig.AttachStdin,
+		OpenStdout:    execConfig.AttachStdout,
+		OpenStderr:    execConfig.AttachStderr,
+	}
+	if !execConfig.StartedAt.IsZero() {
+		inspect.StartedAt = execConfig.StartedAt.UTC().Format(utils.TimeLayout)
+	}
+	if !execConfig.FinishedAt.IsZero() {
+		inspect.FinishedAt = execConfig.FinishedAt.UTC().Format(utils.TimeLayout)
+	}
+	return inspect
+}
+
+// ListExecs returns the running and recently exited exec processes of
+// container, the exited ones are kept until cleaned by execProcessGC.
+func (mgr *ContainerManager) ListExecs(ctx context.Context, name string) ([]*types.ContainerExecInspect, error) {
+	c, err := mgr.container(name)
+	if err != nil {
+		return nil, err
+	}
+
+	execProcesses := mgr.ExecProcesses.Values(func(v interface{}) bool {
+		execConfig, ok := v.(*ContainerExecConfig)
+		return ok && execConfig.ContainerID == c.ID
+	})
+
+	// NOTE: listing doesn't mark the exec process as used, so that the
+	// exit code can still be got by inspect.
+	execs := make([]*types.ContainerExecInspect, 0, len(execProcesses))
+	for _, v := range execProcesses {
+		execs = append(execs, mgr.inspectExec(v.(*ContainerExecConfig)))
+	}
+
+	sort.Slice(execs, func(i, j int) bool {
+		return execs[i].StartedAt > execs[j].StartedAt
+	})
+	return execs, nil
+}
+
+// KillExec sends a signal to the running exec process, SIGKILL is sent if
+// signal is empty.
+func (mgr *ContainerManager) KillExec(ctx context.Context, execid string, signal string) error {
+	execConfig, err := mgr.GetExecConfig(ctx, execid)
+	if err != nil {
+		return err
+	}
+
+	if signal == "" {
+		signal = "SIGKILL"
+	}
+	sig, err := containerd.ParseSignal(signal)
+	if err != nil {
+		return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
+	execConfig.Lock()
+	running := execConfig.Running
+	execConfig.Unlock()
+	if !running {
+		return errors.Wrapf(errtypes.ErrConflict, "exec process %s is not running", execid)
+	}
+
+	c, err := mgr.container(execConfig.ContainerID)
+	if err != nil {
+		return err
+	}
+
+	if err := mgr.Client.KillExec(ctx, c.ID, execid, sig); err != nil {
+		return err
+	}
+
+	mgr.LogContainerEventWithAttributes(ctx, c, "exec_kill", map[string]string{
+		"execID": execid,
+		"signal": strconv.Itoa(int(sig)),
+	})
+	return nil
 }
 
 // GetExecConfig returns execonfig of a exec process inside container.
@@ -0,0 +1,689 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/pkg/utils"
+	volumetypes "github.com/alibaba/pouch/storage/volume/types"
+
+	"github.com/containerd/containerd/snapshots"
+	"github.com/pkg/errors"
+)
+
+// The types of fsck findings.
+const (
+	// FsckSchemaMismatch means the schema version of container meta store is
+	// not the one of current pouchd.
+	FsckSchemaMismatch = "schema-mismatch"
+	// FsckCorruptMeta means the meta data of container can't be decoded.
+	FsckCorruptMeta = "corrupt-meta"
+	// FsckOrphanContainerDir means the directory of container has no meta data.
+	FsckOrphanContainerDir = "orphan-container-dir"
+	// FsckOrphanContainerdContainer means the container in containerd has no meta data.
+	FsckOrphanContainerdContainer = "orphan-containerd-container"
+	// FsckStaleContainerdContainer means the container in containerd is left
+	// by a container which is not running.
+	FsckStaleContainerdContainer = "stale-containerd-container"
+	// FsckMissingTask means the container is running in meta data, but it has
+	// no task in containerd.
+	FsckMissingTask = "missing-task"
+	// FsckMissingSnapshot means the snapshot of container doesn't exist.
+	FsckMissingSnapshot = "missing-snapshot"
+	// FsckOrphanSnapshot means the snapshot is not used by any container.
+	FsckOrphanSnapshot = "orphan-snapshot"
+	// FsckMissingVolume means the volume mounted by container doesn't exist.
+	FsckMissingVolume = "missing-volume"
+	// FsckMissingVolumeRef means the volume mounted by container doesn't
+	// reference the container.
+	FsckMissingVolumeRef = "missing-volume-ref"
+	// FsckOrphanVolumeRef means the volume references a container which
+	// doesn't exist.
+	FsckOrphanVolumeRef = "orphan-volume-ref"
+	// FsckOrphanSandbox means the network sandbox belongs to no running container.
+	FsckOrphanSandbox = "orphan-sandbox"
+	// FsckMissingSandbox means the network sandbox of running container doesn't exist.
+	FsckMissingSandbox = "missing-sandbox"
+	// FsckMissingEndpoint means the network endpoint of container doesn't exist.
+	FsckMissingEndpoint = "missing-endpoint"
+)
+
+// fsckGracePeriod is the age of objects to be checked, the younger ones may
+// be being created, such as the snapshot and directory of a creating container.
+var fsckGracePeriod = 5 * time.Minute
+
+// containerIDRegexp matches the ID of container, which is also the key of
+// container's snapshot and the name of container's directory.
+var containerIDRegexp = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+// FsckOptions contains the options of fsck.
+type FsckOptions struct {
+	// Repair repairs the repairable findings.
+	Repair bool
+	// DryRun only reports what would be repaired.
+	DryRun bool
+}
+
+// fsckChecker collects the findings and repairs them by options.
+type fsckChecker struct {
+	ctx    context.Context
+	opts   FsckOptions
+	report *types.FsckReport
+
+	// corrupt are the ids of containers whose meta data can't be decoded,
+	// the objects of them are known and never repaired as orphans.
+	corrupt map[string]bool
+}
+
+func newFsckChecker(ctx context.Context, opts *FsckOptions) *fsckChecker {
+	if opts == nil {
+		opts = &FsckOptions{}
+	}
+
+	return &fsckChecker{
+		ctx:     ctx,
+		opts:    *opts,
+		corrupt: map[string]bool{},
+		report: &types.FsckReport{
+			Repair:   opts.Repair,
+			DryRun:   opts.DryRun,
+			Checked:  map[string]int64{},
+			Findings: []*types.FsckFinding{},
+		},
+	}
+}
+
+// checked counts the objects of kind which are checked.
+func (c *fsckChecker) checked(kind string, n int) {
+	c.report.Checked[kind] += int64(n)
+}
+
+// add records the finding, and repairs it if repair is not nil.
+func (c *fsckChecker) add(finding *types.FsckFinding, repair func() error) {
+	finding.Repairable = repair != nil
+	c.report.Findings = append(c.report.Findings, finding)
+
+	logger := log.With(c.ctx).WithField("type", finding.Type)
+	if !finding.Repairable || !c.opts.Repair || c.opts.DryRun {
+		logger.Warnf("fsck: %s: %s", finding.Object, finding.Message)
+		return
+	}
+
+	if err := repair(); err != nil {
+		finding.Error = err.Error()
+		logger.Errorf("fsck: failed to repair %s: %v", finding.Object, err)
+		return
+	}
+	finding.Repaired = true
+	logger.Infof("fsck: %s is repaired: %s", finding.Object, finding.Message)
+}
+
+// Fsck cross-references the meta data of containers with containerd,
+// snapshots, volumes and networks, reports the orphans and dangling
+// references, and repairs them by options.
+func (mgr *ContainerManager) Fsck(ctx context.Context, opts *FsckOptions) (*types.FsckReport, error) {
+	checker := newFsckChecker(ctx, opts)
+
+	containers, err := checkContainerMeta(checker, mgr.Store, path.Join(mgr.Config.HomeDir, "containers"))
+	if err != nil {
+		return nil, err
+	}
+	// check and repair the containers in memory, which are the latest.
+	for id := range containers {
+		if c, err := mgr.container(id); err == nil {
+			containers[id] = c
+		}
+	}
+
+	if err := mgr.fsckContainerd(checker, containers); err != nil {
+		return nil, err
+	}
+
+	volumes, err := mgr.VolumeMgr.List(ctx, filters.NewArgs())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list volumes")
+	}
+	checkVolumeRefs(checker, containers, volumes,
+		func(name, id string) error {
+			_, err := mgr.VolumeMgr.Attach(ctx, name, map[string]string{volumetypes.OptionRef: id})
+			return err
+		},
+		func(name, id string) error {
+			_, err := mgr.VolumeMgr.Detach(ctx, name, map[string]string{volumetypes.OptionRef: id})
+			return err
+		},
+	)
+
+	mgr.fsckNetwork(checker, containers)
+
+	return checker.report, nil
+}
+
+// FsckOffline checks the meta data of containers and volumes while pouchd is
+// stopped, containerd and network are not checked since they are managed by
+// the running pouchd. The container meta store should be opened without
+// schemas, so that it's not upgraded by the check.
+func FsckOffline(ctx context.Context, homeDir string, store, volumeStore *meta.Store, opts *FsckOptions) (*types.FsckReport, error) {
+	checker := newFsckChecker(ctx, opts)
+
+	if err := checkContainerSchema(checker, store); err != nil {
+		return nil, err
+	}
+
+	containers, err := checkContainerMeta(checker, store, path.Join(homeDir, "containers"))
+	if err != nil {
+		return nil, err
+	}
+
+	objs, err := volumeStore.List()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list volumes")
+	}
+	volumes := make([]*volumetypes.Volume, 0, len(objs))
+	for _, obj := range objs {
+		volumes = append(volumes, obj.(*volumetypes.Volume))
+	}
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].Name < volumes[j].Name })
+
+	updateRef := func(name string, update func(ids []string) []string) error {
+		obj, err := volumeStore.Get(name)
+		if err != nil {
+			return err
+		}
+		v := obj.(*volumetypes.Volume)
+		if v.Spec.Extra == nil {
+			v.Spec.Extra = map[string]string{}
+		}
+		v.SetOption(volumetypes.OptionRef, strings.Join(update(volumeRefs(v)), ","))
+		return volumeStore.Put(v)
+	}
+	checkVolumeRefs(checker, containers, volumes,
+		func(name, id string) error {
+			return updateRef(name, func(ids []string) []string { return append(ids, id) })
+		},
+		func(name, id string) error {
+			return updateRef(name, func(ids []string) []string { return utils.StringSliceDelete(ids, id) })
+		},
+	)
+
+	return checker.report, nil
+}
+
+// checkContainerSchema checks the schema version of container meta store.
+// If the meta data needs migration or it's written by a newer pouchd, it may
+// be decoded incorrectly, so the findings are only reported.
+func checkContainerSchema(c *fsckChecker, store *meta.Store) error {
+	version, err := store.Version()
+	if err != nil {
+		return errors.Wrap(err, "failed to get schema version of container meta store")
+	}
+	if version == ContainerSchemaVersion {
+		return nil
+	}
+
+	compatible := version < ContainerSchemaVersion
+	for _, m := range ContainerMigrations {
+		if m.Version > version && m.Version <= ContainerSchemaVersion {
+			compatible = false
+		}
+	}
+
+	finding := &types.FsckFinding{
+		Type:   FsckSchemaMismatch,
+		Object: "container meta store " + store.BaseDir,
+	}
+	switch {
+	case compatible:
+		finding.Message = fmt.Sprintf("the schema version is %d, it's stamped to %d when pouchd starts", version, ContainerSchemaVersion)
+	case version < ContainerSchemaVersion:
+		finding.Message = fmt.Sprintf("the schema version is %d, start pouchd to upgrade it to %d, nothing is repaired before upgraded", version, ContainerSchemaVersion)
+	default:
+		finding.Message = fmt.Sprintf("the schema version is %d, it's written by a newer pouchd which supports version %d, nothing is repaired", version, ContainerSchemaVersion)
+	}
+	c.add(finding, nil)
+
+	if !compatible {
+		c.opts.Repair = false
+	}
+	return nil
+}
+
+// checkContainerMeta decodes the meta data of all containers, and checks the
+// directories of containers under root. It returns the decoded containers.
+func checkContainerMeta(c *fsckChecker, store *meta.Store, root string) (map[string]*Container, error) {
+	keys, err := store.Keys()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list keys of container meta store")
+	}
+	sort.Strings(keys)
+	c.checked("containers", len(keys))
+
+	containers := make(map[string]*Container, len(keys))
+	for _, key := range keys {
+		obj, err := store.Get(key)
+		if err != nil {
+			c.corrupt[key] = true
+			c.add(&types.FsckFinding{
+				Type:      FsckCorruptMeta,
+				Object:    "container " + key,
+				Container: key,
+				Message:   fmt.Sprintf("failed to decode meta data: %v, remove or fix %s by hand", err, store.Path(key)),
+			}, nil)
+			continue
+		}
+		containers[key] = obj.(*Container)
+	}
+
+	entries, err := ioutil.ReadDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return containers, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read directory %s", root)
+	}
+	for _, fi := range entries {
+		if !fi.IsDir() || !containerIDRegexp.MatchString(fi.Name()) {
+			continue
+		}
+		// the directory of corrupt container is not orphan.
+		if utils.StringInSlice(keys, fi.Name()) || time.Since(fi.ModTime()) < fsckGracePeriod {
+			continue
+		}
+
+		dir := path.Join(root, fi.Name())
+		c.add(&types.FsckFinding{
+			Type:    FsckOrphanContainerDir,
+			Object:  "directory " + dir,
+			Message: "the directory has no container meta data, remove it",
+		}, func() error {
+			return os.RemoveAll(dir)
+		})
+	}
+
+	return containers, nil
+}
+
+// fsckContainerd checks the containers, tasks and snapshots in containerd.
+func (mgr *ContainerManager) fsckContainerd(c *fsckChecker, containers map[string]*Container) error {
+	ctx := c.ctx
+
+	ctrdContainers, err := mgr.Client.ListContainers(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list containers of containerd")
+	}
+	c.checked("containerd-containers", len(ctrdContainers))
+
+	// the snapshots used by containers, keyed by snapshotter.
+	used := map[string]map[string]bool{}
+	use := func(snapshotter, key string) {
+		if snapshotter == "" {
+			snapshotter = ctrd.CurrentSnapshotterName(ctx)
+		}
+		if used[snapshotter] == nil {
+			used[snapshotter] = map[string]bool{}
+		}
+		used[snapshotter][key] = true
+	}
+	for _, ctr := range containers {
+		if !ctr.RootFSProvided {
+			use(ctr.Config.Snapshotter, ctr.SnapshotKey())
+		}
+	}
+
+	tasks := make(map[string]string, len(ctrdContainers))
+	for _, info := range ctrdContainers {
+		id := info.ID
+		tasks[id] = info.TaskStatus
+
+		ctr, ok := containers[id]
+		if !ok && (c.corrupt[id] || mgr.buildContainers.Get(id).Exist()) {
+			use(info.Snapshotter, info.SnapshotKey)
+			continue
+		}
+		// the young container may be being started, it's checked next time.
+		if time.Since(info.CreatedAt) < fsckGracePeriod {
+			use(info.Snapshotter, info.SnapshotKey)
+			continue
+		}
+		if !ok {
+			c.add(&types.FsckFinding{
+				Type:    FsckOrphanContainerdContainer,
+				Object:  "containerd container " + id,
+				Message: "the container in containerd has no meta data, delete it and its task",
+			}, func() error {
+				return mgr.deleteOrphanContainerdContainer(ctx, id)
+			})
+			continue
+		}
+		use(info.Snapshotter, info.SnapshotKey)
+
+		if isStaleContainerdContainer(ctr, info) {
+			c.add(&types.FsckFinding{
+				Type:      FsckStaleContainerdContainer,
+				Object:    "containerd container " + id,
+				Container: id,
+				Message:   fmt.Sprintf("the container is %s, but it's left in containerd, delete it", ctr.State.Status),
+			}, func() error {
+				return mgr.deleteStaleContainerdContainer(ctx, ctr)
+			})
+		}
+	}
+
+	for id, ctr := range containers {
+		if !ctr.IsRunningOrPaused() {
+			continue
+		}
+		status, ok := tasks[id]
+		if ok && status != "" {
+			continue
+		}
+
+		c.add(&types.FsckFinding{
+			Type:      FsckMissingTask,
+			Object:    "task " + id,
+			Container: id,
+			Message:   fmt.Sprintf("the container is %s, but it has no task in containerd, mark it exited", ctr.State.Status),
+		}, func() error {
+			return mgr.exitedAndRelease(id, nil, func() error {
+				if !ok {
+					return nil
+				}
+				return mgr.Client.DeleteContainer(ctx, id)
+			})
+		})
+	}
+
+	snapshotters := make([]string, 0, len(used))
+	for sn := range used {
+		snapshotters = append(snapshotters, sn)
+	}
+	if _, ok := used[ctrd.CurrentSnapshotterName(ctx)]; !ok {
+		snapshotters = append(snapshotters, ctrd.CurrentSnapshotterName(ctx))
+	}
+	sort.Strings(snapshotters)
+
+	for _, sn := range snapshotters {
+		existing := map[string]bool{}
+		var orphans []snapshots.Info
+		err := mgr.Client.WalkSnapshot(ctx, sn, func(_ context.Context, info snapshots.Info) error {
+			existing[info.Name] = true
+			if info.Kind == snapshots.KindActive && containerIDRegexp.MatchString(info.Name) &&
+				!used[sn][info.Name] && !c.corrupt[info.Name] && time.Since(info.Created) >= fsckGracePeriod {
+				orphans = append(orphans, info)
+			}
+			return nil
+		})
+		if err != nil {
+			log.With(ctx).Warnf("fsck: failed to walk snapshots of snapshotter %s: %v", sn, err)
+			continue
+		}
+		c.checked("snapshots", len(existing))
+
+		for _, info := range orphans {
+			key := info.Name
+			c.add(&types.FsckFinding{
+				Type:    FsckOrphanSnapshot,
+				Object:  fmt.Sprintf("snapshot %s/%s", sn, key),
+				Message: "the snapshot is not used by any container, remove it",
+			}, func() error {
+				return mgr.Client.RemoveSnapshot(ctrd.WithSnapshotter(ctx, sn), key)
+			})
+		}
+
+		keys := make([]string, 0, len(used[sn]))
+		for key := range used[sn] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if existing[key] {
+				continue
+			}
+			for id, ctr := range containers {
+				if ctr.RootFSProvided || ctr.SnapshotKey() != key {
+					continue
+				}
+				c.add(&types.FsckFinding{
+					Type:      FsckMissingSnapshot,
+					Object:    fmt.Sprintf("snapshot %s/%s", sn, key),
+					Container: id,
+					Message:   "the snapshot of container doesn't exist, the container should be removed",
+				}, nil)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isStaleContainerdContainer returns true if the container is neither
+// started nor being restarted, but it's left in containerd without a live task.
+func isStaleContainerdContainer(c *Container, info ctrd.ContainerInfo) bool {
+	return !c.IsRunningOrPaused() && !c.IsRestarting() &&
+		(info.TaskStatus == "" || info.TaskStatus == "stopped") &&
+		time.Since(info.CreatedAt) >= fsckGracePeriod
+}
+
+// deleteStaleContainerdContainer deletes the containerd container left by
+// the container. The container is started under its lock, so the state and
+// the task are checked again under the lock before deleting.
+func (mgr *ContainerManager) deleteStaleContainerdContainer(ctx context.Context, c *Container) error {
+	c.Lock()
+	defer c.Unlock()
+
+	info, err := mgr.Client.GetContainerInfo(ctx, c.ID)
+	if err != nil {
+		if errtypes.IsNotfound(err) {
+			return nil
+		}
+		return err
+	}
+	if !isStaleContainerdContainer(c, info) {
+		return errors.Wrapf(errtypes.ErrConflict, "container %s is %s with task %q", c.ID, c.State.Status, info.TaskStatus)
+	}
+	return mgr.Client.DeleteContainer(ctx, c.ID)
+}
+
+// deleteOrphanContainerdContainer deletes the containerd container which has
+// no meta data, unless the container is created or built after checking.
+func (mgr *ContainerManager) deleteOrphanContainerdContainer(ctx context.Context, id string) error {
+	if _, err := mgr.Store.Get(id); err == nil {
+		return errors.Wrapf(errtypes.ErrConflict, "container %s is created", id)
+	} else if merr, ok := err.(meta.Error); !ok || !merr.IsNotfound() {
+		return errors.Wrapf(err, "failed to get meta data of container %s", id)
+	}
+	if mgr.buildContainers.Get(id).Exist() {
+		return errors.Wrapf(errtypes.ErrConflict, "container %s is a build container", id)
+	}
+
+	info, err := mgr.Client.GetContainerInfo(ctx, id)
+	if err != nil {
+		if errtypes.IsNotfound(err) {
+			return nil
+		}
+		return err
+	}
+	if time.Since(info.CreatedAt) < fsckGracePeriod {
+		return errors.Wrapf(errtypes.ErrConflict, "container %s is created in containerd just now", id)
+	}
+	return mgr.Client.DeleteContainer(ctx, id)
+}
+
+// checkVolumeRefs checks the volumes mounted by containers and the
+// references of volumes, the references are repaired by attach and detach.
+func checkVolumeRefs(c *fsckChecker, containers map[string]*Container, volumes []*volumetypes.Volume, attach, detach func(name, id string) error) {
+	c.checked("volumes", len(volumes))
+
+	byName := make(map[string]*volumetypes.Volume, len(volumes))
+	for _, v := range volumes {
+		byName[v.Name] = v
+
+		for _, id := range volumeRefs(v) {
+			if _, ok := containers[id]; ok || c.corrupt[id] {
+				continue
+			}
+			name := v.Name
+			c.add(&types.FsckFinding{
+				Type:      FsckOrphanVolumeRef,
+				Object:    "volume " + name,
+				Container: id,
+				Message:   fmt.Sprintf("the volume references container %s which doesn't exist, remove the reference", id),
+			}, func() error {
+				return detach(name, id)
+			})
+		}
+	}
+
+	ids := make([]string, 0, len(containers))
+	for id := range containers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		for _, m := range containers[id].Mounts {
+			if m.Name == "" {
+				continue
+			}
+			name := m.Name
+
+			v, ok := byName[name]
+			if !ok {
+				c.add(&types.FsckFinding{
+					Type:      FsckMissingVolume,
+					Object:    "volume " + name,
+					Container: id,
+					Message:   fmt.Sprintf("the volume mounted on %s doesn't exist", m.Destination),
+				}, nil)
+				continue
+			}
+
+			if utils.StringInSlice(volumeRefs(v), id) {
+				continue
+			}
+			c.add(&types.FsckFinding{
+				Type:      FsckMissingVolumeRef,
+				Object:    "volume " + name,
+				Container: id,
+				Message:   "the volume is mounted by container but doesn't reference it, add the reference",
+			}, func() error {
+				return attach(name, id)
+			})
+		}
+	}
+}
+
+// fsckNetwork checks the network sandboxes and endpoints of containers.
+func (mgr *ContainerManager) fsckNetwork(c *fsckChecker, containers map[string]*Container) {
+	if mgr.NetworkMgr == nil || mgr.NetworkMgr.Controller() == nil {
+		return
+	}
+	ctl := mgr.NetworkMgr.Controller()
+
+	sandboxes := ctl.Sandboxes()
+	c.checked("sandboxes", len(sandboxes))
+	for _, sb := range sandboxes {
+		ctr, ok := containers[sb.ContainerID()]
+		if ok && (ctr.IsRunningOrPaused() || ctr.NetworkSettings != nil && ctr.NetworkSettings.SandboxID == sb.ID()) {
+			continue
+		}
+
+		c.add(&types.FsckFinding{
+			Type:      FsckOrphanSandbox,
+			Object:    "sandbox " + sb.ID(),
+			Container: sb.ContainerID(),
+			Message:   "the network sandbox belongs to no running container, delete it",
+		}, sb.Delete)
+	}
+
+	ids := make([]string, 0, len(containers))
+	for id := range containers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		ctr := containers[id]
+		if ctr.NetworkSettings == nil {
+			continue
+		}
+
+		running := ctr.IsRunningOrPaused()
+		if sid := ctr.NetworkSettings.SandboxID; running && sid != "" {
+			if _, err := ctl.SandboxByID(sid); err != nil {
+				c.add(&types.FsckFinding{
+					Type:      FsckMissingSandbox,
+					Object:    "sandbox " + sid,
+					Container: id,
+					Message:   "the network sandbox of running container doesn't exist, restart the container",
+				}, nil)
+			}
+		}
+
+		names := make([]string, 0, len(ctr.NetworkSettings.Networks))
+		for name := range ctr.NetworkSettings.Networks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			ep := ctr.NetworkSettings.Networks[name]
+			if ep == nil || ep.EndpointID == "" {
+				continue
+			}
+
+			n, err := ctl.NetworkByName(name)
+			if err == nil {
+				if _, err = n.EndpointByID(ep.EndpointID); err == nil {
+					continue
+				}
+			}
+
+			finding := &types.FsckFinding{
+				Type:      FsckMissingEndpoint,
+				Object:    fmt.Sprintf("endpoint %s/%s", name, ep.EndpointID),
+				Container: id,
+				Message:   fmt.Sprintf("the network endpoint doesn't exist: %v", err),
+			}
+			if running {
+				finding.Message += ", reconnect the container to network"
+				c.add(finding, nil)
+				continue
+			}
+
+			finding.Message += ", clear it from meta data"
+			c.add(finding, func() error {
+				ctr.Lock()
+				defer ctr.Unlock()
+
+				if ctr.IsRunningOrPaused() {
+					return errors.Wrapf(errtypes.ErrConflict, "container %s is started", id)
+				}
+				ep.EndpointID = ""
+				return ctr.Write(mgr.Store)
+			})
+		}
+	}
+}
+
+// volumeRefs returns the ids of containers referenced by volume.
+func volumeRefs(v *volumetypes.Volume) []string {
+	ref := v.Option(volumetypes.OptionRef)
+	if ref == "" {
+		return nil
+	}
+	return strings.Split(ref, ",")
+}
@@ -0,0 +1,364 @@
+package mgr
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/collect"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/storage/volume"
+	volumetypes "github.com/alibaba/pouch/storage/volume/types"
+	volumemeta "github.com/alibaba/pouch/storage/volume/types/meta"
+
+	"github.com/containerd/containerd/snapshots"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	fsckContainerID = strings.Repeat("a", 64)
+	fsckGoneID      = strings.Repeat("b", 64)
+	fsckOrphanDirID = strings.Repeat("c", 64)
+	fsckCorruptID   = strings.Repeat("d", 64)
+	fsckSnapshotID  = strings.Repeat("e", 64)
+)
+
+func newFsckVolume(name, ref string) *volumetypes.Volume {
+	return &volumetypes.Volume{
+		ObjectMeta: volumemeta.ObjectMeta{Name: name},
+		Spec: &volumetypes.VolumeSpec{
+			Extra: map[string]string{volumetypes.OptionRef: ref},
+		},
+	}
+}
+
+func fsckTypes(report *types.FsckReport) []string {
+	list := make([]string, 0, len(report.Findings))
+	for _, f := range report.Findings {
+		list = append(list, f.Type+" "+f.Object)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func TestCheckVolumeRefs(t *testing.T) {
+	containers := map[string]*Container{
+		fsckContainerID: {
+			ID: fsckContainerID,
+			Mounts: []*types.MountPoint{
+				{Name: "v1", Destination: "/v1"},
+				{Name: "v2", Destination: "/v2"},
+				{Source: "/bind", Destination: "/bind"},
+			},
+		},
+	}
+	volumes := []*volumetypes.Volume{
+		newFsckVolume("v1", ""),
+		newFsckVolume("v3", fsckContainerID+","+fsckGoneID),
+	}
+
+	var attached, detached []string
+	checker := newFsckChecker(context.Background(), &FsckOptions{Repair: true})
+	checkVolumeRefs(checker, containers, volumes,
+		func(name, id string) error {
+			attached = append(attached, name+"/"+id)
+			return nil
+		},
+		func(name, id string) error {
+			detached = append(detached, name+"/"+id)
+			return nil
+		},
+	)
+
+	assert.Equal(t, []string{
+		"missing-volume volume v2",
+		"missing-volume-ref volume v1",
+		"orphan-volume-ref volume v3",
+	}, fsckTypes(checker.report))
+	assert.Equal(t, []string{"v1/" + fsckContainerID}, attached)
+	assert.Equal(t, []string{"v3/" + fsckGoneID}, detached)
+
+	for _, f := range checker.report.Findings {
+		assert.Equal(t, f.Type != FsckMissingVolume, f.Repaired)
+	}
+	assert.Equal(t, int64(2), checker.report.Checked["volumes"])
+}
+
+func TestFsckCheckerDryRun(t *testing.T) {
+	checker := newFsckChecker(context.Background(), &FsckOptions{Repair: true, DryRun: true})
+
+	repaired := false
+	checker.add(&types.FsckFinding{Type: FsckOrphanSnapshot}, func() error {
+		repaired = true
+		return nil
+	})
+
+	assert.False(t, repaired)
+	assert.True(t, checker.report.DryRun)
+	assert.True(t, checker.report.Findings[0].Repairable)
+	assert.False(t, checker.report.Findings[0].Repaired)
+}
+
+// fsckClient is a containerd client with the given containers and snapshots,
+// it records the deleted containers and removed snapshots.
+type fsckClient struct {
+	ctrd.APIClient
+	containers []ctrd.ContainerInfo
+	snapshots  []snapshots.Info
+	deleted    []string
+	removed    []string
+}
+
+func (c *fsckClient) ListContainers(ctx context.Context) ([]ctrd.ContainerInfo, error) {
+	return c.containers, nil
+}
+
+func (c *fsckClient) GetContainerInfo(ctx context.Context, id string) (ctrd.ContainerInfo, error) {
+	for _, info := range c.containers {
+		if info.ID == id {
+			return info, nil
+		}
+	}
+	return ctrd.ContainerInfo{}, errors.Wrapf(errtypes.ErrNotfound, "container %s", id)
+}
+
+func (c *fsckClient) DeleteContainer(ctx context.Context, id string) error {
+	c.deleted = append(c.deleted, id)
+	return nil
+}
+
+func (c *fsckClient) WalkSnapshot(ctx context.Context, snapshotter string, fn func(context.Context, snapshots.Info) error) error {
+	for _, info := range c.snapshots {
+		if err := fn(ctx, info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *fsckClient) RemoveSnapshot(ctx context.Context, id string) error {
+	c.removed = append(c.removed, id)
+	return nil
+}
+
+func TestFsckContainerdCorruptMeta(t *testing.T) {
+	root, err := ioutil.TempDir("", "fsck")
+	assert.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	assert.NoError(t, os.MkdirAll(path.Join(root, fsckCorruptID), 0700))
+	assert.NoError(t, ioutil.WriteFile(path.Join(root, fsckCorruptID, meta.MetaJSONFile), []byte("{"), 0644))
+
+	store, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: root,
+		Buckets: []meta.Bucket{{Name: meta.MetaJSONFile, Type: reflect.TypeOf(Container{})}},
+	})
+	assert.NoError(t, err)
+	defer store.Shutdown()
+
+	old := time.Now().Add(-time.Hour)
+	client := &fsckClient{
+		containers: []ctrd.ContainerInfo{
+			{ID: fsckCorruptID, SnapshotKey: fsckSnapshotID, TaskStatus: "running"},
+			{ID: fsckGoneID, SnapshotKey: fsckGoneID},
+		},
+		snapshots: []snapshots.Info{
+			{Name: fsckSnapshotID, Kind: snapshots.KindActive, Created: old},
+			{Name: fsckCorruptID, Kind: snapshots.KindActive, Created: old},
+			{Name: fsckGoneID, Kind: snapshots.KindActive, Created: old},
+		},
+	}
+	mgr := &ContainerManager{Client: client, Store: store, buildContainers: collect.NewSafeMap()}
+
+	checker := newFsckChecker(context.Background(), &FsckOptions{Repair: true})
+	containers, err := checkContainerMeta(checker, store, root)
+	assert.NoError(t, err)
+	assert.NoError(t, mgr.fsckContainerd(checker, containers))
+
+	// the containerd container and snapshots of corrupt container are kept.
+	assert.Equal(t, []string{
+		"corrupt-meta container " + fsckCorruptID,
+		"orphan-containerd-container containerd container " + fsckGoneID,
+		"orphan-snapshot snapshot " + ctrd.CurrentSnapshotterName(context.Background()) + "/" + fsckGoneID,
+	}, fsckTypes(checker.report))
+	assert.Equal(t, []string{fsckGoneID}, client.deleted)
+	assert.Equal(t, []string{fsckGoneID}, client.removed)
+}
+
+func TestFsckContainerdInFlight(t *testing.T) {
+	root, err := ioutil.TempDir("", "fsck")
+	assert.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	store, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: root,
+		Buckets: []meta.Bucket{{Name: meta.MetaJSONFile, Type: reflect.TypeOf(Container{})}},
+	})
+	assert.NoError(t, err)
+	defer store.Shutdown()
+
+	stopped := &Container{
+		ID:     fsckContainerID,
+		Config: &types.ContainerConfig{},
+		State:  &types.ContainerState{Status: types.StatusStopped},
+	}
+	assert.NoError(t, store.Put(stopped))
+
+	old := time.Now().Add(-time.Hour)
+	client := &fsckClient{
+		containers: []ctrd.ContainerInfo{
+			{ID: fsckContainerID, SnapshotKey: fsckContainerID, CreatedAt: old},
+			// created just now, it may be being started.
+			{ID: fsckGoneID, SnapshotKey: fsckGoneID, CreatedAt: time.Now()},
+			// the container of build step has no meta data.
+			{ID: fsckSnapshotID, SnapshotKey: fsckSnapshotID, CreatedAt: old},
+		},
+		snapshots: []snapshots.Info{
+			{Name: fsckContainerID, Kind: snapshots.KindActive, Created: old},
+			{Name: fsckGoneID, Kind: snapshots.KindActive, Created: old},
+			{Name: fsckSnapshotID, Kind: snapshots.KindActive, Created: old},
+		},
+	}
+	mgr := &ContainerManager{Client: client, Store: store, buildContainers: collect.NewSafeMap()}
+	mgr.buildContainers.Put(fsckSnapshotID, &Container{ID: fsckSnapshotID})
+
+	checker := newFsckChecker(context.Background(), nil)
+	assert.NoError(t, mgr.fsckContainerd(checker, map[string]*Container{fsckContainerID: stopped}))
+	assert.Equal(t, []string{
+		"stale-containerd-container containerd container " + fsckContainerID,
+	}, fsckTypes(checker.report))
+
+	// the container is started before the stale one is repaired.
+	stopped.SetStatusRunning(1)
+	client.containers[0].TaskStatus = "running"
+	assert.True(t, errtypes.IsConflict(mgr.deleteStaleContainerdContainer(context.Background(), stopped)))
+
+	// the young one and the one created after checking are kept.
+	assert.True(t, errtypes.IsConflict(mgr.deleteOrphanContainerdContainer(context.Background(), fsckGoneID)))
+	assert.NoError(t, store.Put(&Container{ID: fsckOrphanDirID, State: &types.ContainerState{}}))
+	assert.True(t, errtypes.IsConflict(mgr.deleteOrphanContainerdContainer(context.Background(), fsckOrphanDirID)))
+	assert.Empty(t, client.deleted)
+}
+
+func TestFsckOffline(t *testing.T) {
+	homeDir, err := ioutil.TempDir("", "fsck")
+	assert.NoError(t, err)
+	defer os.RemoveAll(homeDir)
+
+	root := path.Join(homeDir, "containers")
+
+	// a corrupt meta data and an orphan directory left by crash.
+	assert.NoError(t, os.MkdirAll(path.Join(root, fsckCorruptID), 0700))
+	assert.NoError(t, ioutil.WriteFile(path.Join(root, fsckCorruptID, meta.MetaJSONFile), []byte("{"), 0644))
+	orphanDir := path.Join(root, fsckOrphanDirID)
+	assert.NoError(t, os.MkdirAll(orphanDir, 0700))
+	old := time.Now().Add(-time.Hour)
+	assert.NoError(t, os.Chtimes(orphanDir, old, old))
+
+	// the store is stamped with current schema version by pouchd.
+	store, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: root,
+		Buckets: []meta.Bucket{{Name: meta.MetaJSONFile, Type: reflect.TypeOf(Container{})}},
+		Schemas: []meta.Schema{{Bucket: meta.MetaJSONFile, Version: ContainerSchemaVersion}},
+	})
+	assert.NoError(t, err)
+	defer store.Shutdown()
+	assert.NoError(t, store.Put(&Container{
+		ID:     fsckContainerID,
+		Name:   "c1",
+		State:  &types.ContainerState{Status: types.StatusStopped},
+		Mounts: []*types.MountPoint{{Name: "v1", Destination: "/v1"}},
+	}))
+
+	volumeStore, err := meta.NewStore(volume.MetaConfig(path.Join(homeDir, "volume", "volume.db")))
+	assert.NoError(t, err)
+	defer volumeStore.Shutdown()
+	assert.NoError(t, volumeStore.Put(newFsckVolume("v1", "")))
+	assert.NoError(t, volumeStore.Put(newFsckVolume("v2", fsckGoneID)))
+
+	expected := []string{
+		"corrupt-meta container " + fsckCorruptID,
+		"missing-volume-ref volume v1",
+		"orphan-container-dir directory " + orphanDir,
+		"orphan-volume-ref volume v2",
+	}
+
+	// dry run changes nothing.
+	report, err := FsckOffline(context.Background(), homeDir, store, volumeStore, &FsckOptions{Repair: true, DryRun: true})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, fsckTypes(report))
+	_, err = os.Stat(orphanDir)
+	assert.NoError(t, err)
+
+	report, err = FsckOffline(context.Background(), homeDir, store, volumeStore, &FsckOptions{Repair: true})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, fsckTypes(report))
+	for _, f := range report.Findings {
+		assert.Equal(t, f.Type != FsckCorruptMeta, f.Repaired, f.Type)
+	}
+
+	_, err = os.Stat(orphanDir)
+	assert.True(t, os.IsNotExist(err))
+	obj, err := volumeStore.Get("v1")
+	assert.NoError(t, err)
+	assert.Equal(t, fsckContainerID, obj.(*volumetypes.Volume).Option(volumetypes.OptionRef))
+	obj, err = volumeStore.Get("v2")
+	assert.NoError(t, err)
+	assert.Equal(t, "", obj.(*volumetypes.Volume).Option(volumetypes.OptionRef))
+
+	// only the corrupt meta data is left.
+	report, err = FsckOffline(context.Background(), homeDir, store, volumeStore, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, expected[:1], fsckTypes(report))
+}
+
+func TestCheckContainerSchema(t *testing.T) {
+	root, err := ioutil.TempDir("", "fsck")
+	assert.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	cfg := meta.Config{
+		Driver:  "local",
+		BaseDir: root,
+		Buckets: []meta.Bucket{{Name: meta.MetaJSONFile, Type: reflect.TypeOf(Container{})}},
+	}
+	store, err := meta.NewStore(cfg)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Put(&Container{ID: fsckContainerID}))
+
+	// the meta data written before the version is stamped is reported, and
+	// it's not upgraded by the check. Version 0 is compatible with current
+	// version, so the findings are still repaired.
+	checker := newFsckChecker(context.Background(), &FsckOptions{Repair: true})
+	assert.NoError(t, checkContainerSchema(checker, store))
+	assert.Equal(t, []string{"schema-mismatch container meta store " + root}, fsckTypes(checker.report))
+	assert.True(t, checker.opts.Repair)
+	version, err := store.Version()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, version)
+	store.Shutdown()
+
+	// the meta data written by a newer pouchd is never repaired.
+	cfg.Schemas = []meta.Schema{{Bucket: meta.MetaJSONFile, Version: ContainerSchemaVersion + 1}}
+	store, err = meta.NewStore(cfg)
+	assert.NoError(t, err)
+	defer store.Shutdown()
+
+	checker = newFsckChecker(context.Background(), &FsckOptions{Repair: true})
+	assert.NoError(t, checkContainerSchema(checker, store))
+	assert.Equal(t, []string{"schema-mismatch container meta store " + root}, fsckTypes(checker.report))
+	assert.False(t, checker.opts.Repair)
+}
@@ -3,24 +3,52 @@ package mgr
 import (
 	"context"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
 	"github.com/alibaba/pouch/pkg/utils/filters"
+
+	"github.com/pkg/errors"
 )
 
 var (
-	labelFilter  = "label"
-	idFilter     = "id"
-	nameFilter   = "name"
-	statusFilter = "status"
+	labelFilter    = "label"
+	idFilter       = "id"
+	nameFilter     = "name"
+	statusFilter   = "status"
+	ancestorFilter = "ancestor"
+	beforeFilter   = "before"
+	sinceFilter    = "since"
+	exitedFilter   = "exited"
+	volumeFilter   = "volume"
+	networkFilter  = "network"
+	healthFilter   = "health"
+	publishFilter  = "publish"
+	runtimeFilter  = "runtime"
 )
 
+// healthNone is the health status of container without health check.
+const healthNone = "none"
+
 // filterContext includes conditions provide for filter
 type filterContext struct {
 	condition  map[string][]string
 	all        bool
 	filterFunc ContainerFilter
+
+	// before and since are the created time of containers in before and
+	// since filter.
+	before *time.Time
+	since  *time.Time
+
+	// ancestors are the image IDs of ancestor filter.
+	ancestors map[string]bool
 }
 
 // newFilterContext initials a filterContext struct, and validate option.Filter
@@ -33,6 +61,20 @@ func newFilterContext(option *ContainerListOption) (*filterContext, error) {
 	if err := filters.Validate(option.Filter); err != nil {
 		return nil, err
 	}
+
+	for _, v := range option.Filter[exitedFilter] {
+		if _, err := strconv.Atoi(v); err != nil {
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid exit code %s of filter exited", v)
+		}
+	}
+
+	for _, v := range option.Filter[healthFilter] {
+		switch v {
+		case "starting", "healthy", "unhealthy", healthNone:
+		default:
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid health status %s of filter health", v)
+		}
+	}
 	return &filterContext{
 		condition:  option.Filter,
 		all:        option.All,
@@ -127,6 +169,137 @@ func (fc *filterContext) matchKVFilter(field string, value map[string]string) bo
 	return match
 }
 
+// matchExactFilter filters value equals to one of the values of condition.
+func (fc *filterContext) matchExactFilter(field string, values ...string) bool {
+	filters, exist := fc.condition[field]
+	if !exist {
+		// return true if field is not exist
+		return true
+	}
+
+	for _, f := range filters {
+		for _, v := range values {
+			if v != "" && f == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchCreated filters container created between since and before.
+func (fc *filterContext) matchCreated(c *Container) bool {
+	if fc.before == nil && fc.since == nil {
+		return true
+	}
+
+	created, err := time.Parse(utils.TimeLayout, c.Created)
+	if err != nil {
+		return false
+	}
+
+	if fc.before != nil && !created.Before(*fc.before) {
+		return false
+	}
+	if fc.since != nil && !created.After(*fc.since) {
+		return false
+	}
+	return true
+}
+
+// matchAncestor filters container created from the image in ancestor filter.
+func (fc *filterContext) matchAncestor(c *Container) bool {
+	if _, exist := fc.condition[ancestorFilter]; !exist {
+		return true
+	}
+
+	if fc.ancestors[c.Image] {
+		return true
+	}
+	return fc.matchExactFilter(ancestorFilter, c.Config.Image)
+}
+
+// matchExited filters container exited with the code in exited filter.
+func (fc *filterContext) matchExited(c *Container) bool {
+	if _, exist := fc.condition[exitedFilter]; !exist {
+		return true
+	}
+
+	if c.State.Status != types.StatusExited && c.State.Status != types.StatusStopped {
+		return false
+	}
+	return fc.matchExactFilter(exitedFilter, strconv.FormatInt(c.State.ExitCode, 10))
+}
+
+// matchVolume filters container mounting the volume name or destination
+// in volume filter.
+func (fc *filterContext) matchVolume(c *Container) bool {
+	if _, exist := fc.condition[volumeFilter]; !exist {
+		return true
+	}
+
+	for _, mp := range c.Mounts {
+		if fc.matchExactFilter(volumeFilter, mp.Name, mp.Destination) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchNetwork filters container connected to the network name or ID in
+// network filter.
+func (fc *filterContext) matchNetwork(c *Container) bool {
+	if _, exist := fc.condition[networkFilter]; !exist {
+		return true
+	}
+
+	if c.NetworkSettings == nil {
+		return false
+	}
+
+	for name, ep := range c.NetworkSettings.Networks {
+		var id string
+		if ep != nil {
+			id = ep.NetworkID
+		}
+		if fc.matchExactFilter(networkFilter, name, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPublish filters container publishing the port in publish filter,
+// the port is in format of `<port>[/<proto>]` and proto is tcp by default.
+func (fc *filterContext) matchPublish(c *Container) bool {
+	filters, exist := fc.condition[publishFilter]
+	if !exist {
+		return true
+	}
+
+	if c.HostConfig == nil {
+		return false
+	}
+
+	for _, f := range filters {
+		if !strings.Contains(f, "/") {
+			f += "/tcp"
+		}
+		if bindings, ok := c.HostConfig.PortBindings[f]; ok && len(bindings) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// matchRuntime filters container running by the runtime in runtime filter.
+func (fc *filterContext) matchRuntime(c *Container) bool {
+	if c.HostConfig == nil {
+		return fc.matchExactFilter(runtimeFilter)
+	}
+	return fc.matchExactFilter(runtimeFilter, c.HostConfig.Runtime)
+}
+
 // filter does all select container work.
 func (fc *filterContext) filter(c *Container) bool {
 	isNonStop := c.IsRunningOrPaused()
@@ -163,6 +336,24 @@ func (fc *filterContext) filter(c *Container) bool {
 			match = fc.matchFilter(nameFilter, c.Name)
 		case statusFilter:
 			match = fc.matchFilter(statusFilter, string(c.State.Status))
+		case ancestorFilter:
+			match = fc.matchAncestor(c)
+		case beforeFilter, sinceFilter:
+			match = fc.matchCreated(c)
+		case exitedFilter:
+			match = fc.matchExited(c)
+		case volumeFilter:
+			match = fc.matchVolume(c)
+		case networkFilter:
+			match = fc.matchNetwork(c)
+		case healthFilter:
+			// NOTE: health check is not supported yet, so that all the
+			// containers have no health status.
+			match = fc.matchExactFilter(healthFilter, healthNone)
+		case publishFilter:
+			match = fc.matchPublish(c)
+		case runtimeFilter:
+			match = fc.matchRuntime(c)
 		default:
 			continue
 		}
@@ -172,7 +363,8 @@ func (fc *filterContext) filter(c *Container) bool {
 			break
 		}
 
-		if name == statusFilter {
+		// the status and exited filter may select non-running containers.
+		if name == statusFilter || name == exitedFilter {
 			statusKey = true
 		}
 	}
@@ -195,6 +387,10 @@ func (mgr *ContainerManager) List(ctx context.Context, option *ContainerListOpti
 		return nil, err
 	}
 
+	if err := mgr.resolveFilterContext(ctx, fc); err != nil {
+		return nil, err
+	}
+
 	for id, obj := range list {
 		c, ok := obj.(*Container)
 		if !ok {
@@ -209,3 +405,73 @@ func (mgr *ContainerManager) List(ctx context.Context, option *ContainerListOpti
 
 	return cons, nil
 }
+
+// resolveFilterContext resolves the containers and images referenced by
+// before, since and ancestor filters.
+func (mgr *ContainerManager) resolveFilterContext(ctx context.Context, fc *filterContext) error {
+	createdOf := func(field string) (*time.Time, error) {
+		refs := fc.condition[field]
+		if len(refs) == 0 {
+			return nil, nil
+		}
+		if len(refs) > 1 {
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "filter %s should have only one value", field)
+		}
+
+		c, err := mgr.container(refs[0])
+		if err != nil {
+			return nil, err
+		}
+
+		created, err := time.Parse(utils.TimeLayout, c.Created)
+		if err != nil {
+			return nil, err
+		}
+		return &created, nil
+	}
+
+	var err error
+	if fc.before, err = createdOf(beforeFilter); err != nil {
+		return err
+	}
+	if fc.since, err = createdOf(sinceFilter); err != nil {
+		return err
+	}
+
+	for _, ref := range fc.condition[ancestorFilter] {
+		// the image may have been removed, so only match the image name.
+		imgID, _, _, err := mgr.ImageMgr.CheckReference(ctx, ref)
+		if err != nil {
+			continue
+		}
+
+		if fc.ancestors == nil {
+			fc.ancestors = map[string]bool{}
+		}
+		fc.ancestors[imgID.String()] = true
+	}
+
+	return nil
+}
+
+// Size returns the size of files created or changed by container, and the
+// total size of container's rootfs including image.
+func (mgr *ContainerManager) Size(ctx context.Context, name string) (int64, int64, error) {
+	c, err := mgr.container(name)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ctx = ctrd.WithSnapshotter(ctx, c.Config.Snapshotter)
+	usage, err := mgr.Client.GetSnapshotUsage(ctx, c.SnapshotKey())
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to get snapshot usage of container %s", c.ID)
+	}
+
+	sizeRootFs := usage.Size
+	if img, err := mgr.ImageMgr.GetImage(ctx, c.Image); err == nil {
+		sizeRootFs += img.Size
+	}
+
+	return usage.Size, sizeRootFs, nil
+}
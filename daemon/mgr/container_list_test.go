@@ -3,6 +3,10 @@ package mgr
 import (
 	"fmt"
 	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/utils"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -28,6 +32,12 @@ func TestNewFilterContext(t *testing.T) {
 		{Filter: map[string][]string{
 			"foo": {},
 		}},
+		{Filter: map[string][]string{
+			"exited": {"x"},
+		}},
+		{Filter: map[string][]string{
+			"health": {"good"},
+		}},
 	} {
 		_, err := newFilterContext(t)
 		assert.Error(err)
@@ -182,3 +192,82 @@ func TestMatchKVFilter(t *testing.T) {
 		assert.Equal(t.isFilter, fc.matchKVFilter(t.field, t.value), fmt.Sprintf("%+v", t.value))
 	}
 }
+
+func TestFilterExtendedConditions(t *testing.T) {
+	now := time.Now()
+
+	c := &Container{
+		ID:      "id1",
+		Name:    "name1",
+		Image:   "sha256:image1",
+		Created: now.Format(utils.TimeLayout),
+		Config: &types.ContainerConfig{
+			Image: "busybox:latest",
+		},
+		HostConfig: &types.HostConfig{
+			Runtime: "runc",
+			PortBindings: types.PortMap{
+				"80/tcp": {{HostPort: "8080"}},
+				"53/udp": {{HostPort: "53"}},
+			},
+		},
+		State: &types.ContainerState{
+			Status:   types.StatusExited,
+			ExitCode: 137,
+		},
+		Mounts: []*types.MountPoint{
+			{Name: "vol1", Destination: "/data"},
+		},
+		NetworkSettings: &types.NetworkSettings{
+			Networks: map[string]*types.EndpointSettings{
+				"bridge": {NetworkID: "net1"},
+			},
+		},
+	}
+
+	before := now.Add(time.Minute)
+	since := now.Add(-time.Minute)
+
+	for _, tc := range []struct {
+		filter   map[string][]string
+		before   *time.Time
+		since    *time.Time
+		expected bool
+	}{
+		{filter: map[string][]string{"ancestor": {"busybox:latest"}}, expected: false},
+		{filter: map[string][]string{"ancestor": {"busybox:latest"}, "status": {"exited"}}, expected: true},
+		{filter: map[string][]string{"exited": {"137"}}, expected: true},
+		{filter: map[string][]string{"exited": {"0"}}, expected: false},
+		{filter: map[string][]string{"exited": {"137"}, "volume": {"vol1"}}, expected: true},
+		{filter: map[string][]string{"exited": {"137"}, "volume": {"/data"}}, expected: true},
+		{filter: map[string][]string{"exited": {"137"}, "volume": {"vol2"}}, expected: false},
+		{filter: map[string][]string{"exited": {"137"}, "network": {"bridge"}}, expected: true},
+		{filter: map[string][]string{"exited": {"137"}, "network": {"net1"}}, expected: true},
+		{filter: map[string][]string{"exited": {"137"}, "network": {"host"}}, expected: false},
+		{filter: map[string][]string{"exited": {"137"}, "health": {"none"}}, expected: true},
+		{filter: map[string][]string{"exited": {"137"}, "health": {"healthy"}}, expected: false},
+		{filter: map[string][]string{"exited": {"137"}, "publish": {"80"}}, expected: true},
+		{filter: map[string][]string{"exited": {"137"}, "publish": {"53/udp"}}, expected: true},
+		{filter: map[string][]string{"exited": {"137"}, "publish": {"53"}}, expected: false},
+		{filter: map[string][]string{"exited": {"137"}, "runtime": {"runc"}}, expected: true},
+		{filter: map[string][]string{"exited": {"137"}, "runtime": {"runv"}}, expected: false},
+		{filter: map[string][]string{"exited": {"137"}, "before": {"c2"}}, before: &before, expected: true},
+		{filter: map[string][]string{"exited": {"137"}, "before": {"c2"}}, before: &since, expected: false},
+		{filter: map[string][]string{"exited": {"137"}, "since": {"c2"}}, since: &since, expected: true},
+		{filter: map[string][]string{"exited": {"137"}, "since": {"c2"}}, since: &before, expected: false},
+	} {
+		fc, err := newFilterContext(&ContainerListOption{Filter: tc.filter})
+		assert.NoError(t, err)
+
+		fc.before, fc.since = tc.before, tc.since
+		assert.Equal(t, tc.expected, fc.filter(c), fmt.Sprintf("%v", tc.filter))
+	}
+
+	// ancestor filter matches the resolved image ID.
+	fc, err := newFilterContext(&ContainerListOption{All: true, Filter: map[string][]string{"ancestor": {"busybox"}}})
+	assert.NoError(t, err)
+	assert.False(t, fc.filter(c))
+
+	fc.ancestors = map[string]bool{"sha256:image1": true}
+	assert.True(t, fc.filter(c))
+}
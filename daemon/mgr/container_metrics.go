@@ -0,0 +1,444 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	containerMetricsNamespace = "engine"
+	containerMetricsSubsystem = "container"
+
+	// containerMetricsLabelPrefix is the prefix of metric label converted
+	// from the container label.
+	containerMetricsLabelPrefix = "container_label_"
+)
+
+var invalidMetricsLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// containerMetric describes one per-container metric and how to get the
+// values from the container stats.
+type containerMetric struct {
+	name      string
+	help      string
+	valueType prometheus.ValueType
+	// extraLabels are the labels besides the container labels, like device.
+	extraLabels []string
+	getValues   func(s *types.ContainerStats, events containerEventCounts) []metricValue
+}
+
+// containerEventCounts is the number of events of container since the
+// daemon started, which are counted by the handlers of monitor.
+type containerEventCounts struct {
+	oom          uint64
+	execFailures uint64
+}
+
+type metricValue struct {
+	value  float64
+	labels []string
+}
+
+func singleValue(v float64) []metricValue {
+	return []metricValue{{value: v}}
+}
+
+// containerMetricsSample is the stats of container collected last time.
+type containerMetricsSample struct {
+	labels []string
+	stats  *types.ContainerStats
+}
+
+// ContainerMetricsCollector collects the stats of running containers
+// periodically, and exports them as prometheus metrics. The scraping of
+// metrics only reads the stats collected last time, so that it doesn't
+// put pressure on containerd.
+type ContainerMetricsCollector struct {
+	mgr        *ContainerManager
+	syncPeriod time.Duration
+
+	// labels is the allow-list of container labels exported as metric
+	// labels, which controls the cardinality of metrics.
+	labels  []string
+	metrics []containerMetric
+	descs   []*prometheus.Desc
+
+	lock    sync.RWMutex
+	samples map[string]*containerMetricsSample
+	events  map[string]containerEventCounts
+}
+
+// newContainerMetricsCollector creates a per-container metrics collector.
+func newContainerMetricsCollector(mgr *ContainerManager, period time.Duration, labels []string) (*ContainerMetricsCollector, error) {
+	if period <= 0 {
+		return nil, fmt.Errorf("container metrics collect period should > 0")
+	}
+
+	labelNames := []string{"id", "name", "image"}
+	converted := map[string]string{}
+	for _, l := range labels {
+		name := containerMetricsLabelName(l)
+		if origin, ok := converted[name]; ok {
+			return nil, fmt.Errorf("container labels %s and %s are both converted into metric label %s", origin, l, name)
+		}
+		converted[name] = l
+		labelNames = append(labelNames, name)
+	}
+
+	c := &ContainerMetricsCollector{
+		mgr:        mgr,
+		syncPeriod: period,
+		labels:     labels,
+		metrics:    containerMetrics(),
+		samples:    map[string]*containerMetricsSample{},
+		events:     map[string]containerEventCounts{},
+	}
+
+	for _, m := range c.metrics {
+		c.descs = append(c.descs, prometheus.NewDesc(
+			prometheus.BuildFQName(containerMetricsNamespace, containerMetricsSubsystem, m.name),
+			m.help,
+			append(append([]string{}, labelNames...), m.extraLabels...),
+			nil,
+		))
+	}
+	return c, nil
+}
+
+// containerMetricsLabelName converts the container label into the valid
+// prometheus label name, like com.example.app => container_label_com_example_app.
+func containerMetricsLabelName(label string) string {
+	return containerMetricsLabelPrefix + invalidMetricsLabelChars.ReplaceAllString(label, "_")
+}
+
+// Start starts to collect the stats periodically.
+func (c *ContainerMetricsCollector) Start() {
+	tick := time.NewTicker(c.syncPeriod)
+	go func() {
+		defer tick.Stop()
+		for {
+			if err := c.Sync(context.Background()); err != nil {
+				log.With(nil).Errorf("failed to sync container metrics: %v", err)
+			}
+			<-tick.C
+		}
+	}()
+}
+
+// Sync collects the stats of all the running containers.
+func (c *ContainerMetricsCollector) Sync(ctx context.Context) error {
+	containers, err := c.mgr.List(ctx, &ContainerListOption{All: true})
+	if err != nil {
+		return fmt.Errorf("failed to list containers: %v", err)
+	}
+
+	samples := make(map[string]*containerMetricsSample, len(containers))
+	existed := make(map[string]struct{}, len(containers))
+	for _, container := range containers {
+		existed[container.ID] = struct{}{}
+		if !container.IsRunningOrPaused() {
+			continue
+		}
+
+		metricMeta, metrics, err := c.mgr.Stats(ctx, container.ID)
+		if err != nil {
+			log.With(ctx).Debugf("failed to get stats of container %s: %v", container.ID, err)
+			continue
+		}
+		// the container is stopped after listed.
+		if metricMeta == nil {
+			continue
+		}
+
+		samples[container.ID] = &containerMetricsSample{
+			labels: c.containerLabels(container),
+			stats:  c.mgr.wrapContainerStats(container, metricMeta, metrics),
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.samples = samples
+	// forget the events of removed containers.
+	for id := range c.events {
+		if _, ok := existed[id]; !ok {
+			delete(c.events, id)
+		}
+	}
+	return nil
+}
+
+// containerLabels returns the values of metric labels of container.
+func (c *ContainerMetricsCollector) containerLabels(container *Container) []string {
+	values := []string{container.ID, strings.TrimPrefix(container.Name, "/"), ""}
+	if container.Config != nil {
+		values[2] = container.Config.Image
+	}
+
+	for _, l := range c.labels {
+		var v string
+		if container.Config != nil {
+			v = container.Config.Labels[l]
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+// IncOOM records the oom event of container.
+func (c *ContainerMetricsCollector) IncOOM(id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	events := c.events[id]
+	events.oom++
+	c.events[id] = events
+}
+
+// IncExecFailure records the exec process of container exited with non-zero
+// code.
+func (c *ContainerMetricsCollector) IncExecFailure(id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	events := c.events[id]
+	events.execFailures++
+	c.events[id] = events
+}
+
+// Describe implements prometheus.Collector.
+func (c *ContainerMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range c.descs {
+		ch <- d
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *ContainerMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	for id, sample := range c.samples {
+		for i, m := range c.metrics {
+			for _, v := range m.getValues(sample.stats, c.events[id]) {
+				labels := append(append([]string{}, sample.labels...), v.labels...)
+				metric, err := prometheus.NewConstMetric(c.descs[i], m.valueType, v.value, labels...)
+				if err != nil {
+					log.With(nil).Warnf("failed to generate metric %s of container %s: %v", m.name, id, err)
+					continue
+				}
+				ch <- metric
+			}
+		}
+	}
+}
+
+// containerMetrics returns all the per-container metrics.
+func containerMetrics() []containerMetric {
+	const nanoSeconds = float64(time.Second)
+
+	metrics := []containerMetric{
+		{
+			name:      "cpu_usage_seconds_total",
+			help:      "Cumulative cpu time consumed by the container in seconds.",
+			valueType: prometheus.CounterValue,
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				if s.CPUStats == nil || s.CPUStats.CPUUsage == nil {
+					return nil
+				}
+				return singleValue(float64(s.CPUStats.CPUUsage.TotalUsage) / nanoSeconds)
+			},
+		},
+		{
+			name:      "cpu_user_seconds_total",
+			help:      "Cumulative user cpu time consumed by the container in seconds.",
+			valueType: prometheus.CounterValue,
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				if s.CPUStats == nil || s.CPUStats.CPUUsage == nil {
+					return nil
+				}
+				return singleValue(float64(s.CPUStats.CPUUsage.UsageInUsermode) / nanoSeconds)
+			},
+		},
+		{
+			name:      "cpu_system_seconds_total",
+			help:      "Cumulative system cpu time consumed by the container in seconds.",
+			valueType: prometheus.CounterValue,
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				if s.CPUStats == nil || s.CPUStats.CPUUsage == nil {
+					return nil
+				}
+				return singleValue(float64(s.CPUStats.CPUUsage.UsageInKernelmode) / nanoSeconds)
+			},
+		},
+		{
+			name:      "cpu_throttled_periods_total",
+			help:      "Number of periods the container hits its cpu throttling limit.",
+			valueType: prometheus.CounterValue,
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				if s.CPUStats == nil || s.CPUStats.ThrottlingData == nil {
+					return nil
+				}
+				return singleValue(float64(s.CPUStats.ThrottlingData.ThrottledPeriods))
+			},
+		},
+		{
+			name:      "cpu_throttled_seconds_total",
+			help:      "Total time the container was throttled in seconds.",
+			valueType: prometheus.CounterValue,
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				if s.CPUStats == nil || s.CPUStats.ThrottlingData == nil {
+					return nil
+				}
+				return singleValue(float64(s.CPUStats.ThrottlingData.ThrottledTime) / nanoSeconds)
+			},
+		},
+		{
+			name:      "memory_usage_bytes",
+			help:      "Current memory usage of the container in bytes.",
+			valueType: prometheus.GaugeValue,
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				if s.MemoryStats == nil {
+					return nil
+				}
+				return singleValue(float64(s.MemoryStats.Usage))
+			},
+		},
+		{
+			name:      "memory_limit_bytes",
+			help:      "Memory limit of the container in bytes.",
+			valueType: prometheus.GaugeValue,
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				if s.MemoryStats == nil {
+					return nil
+				}
+				return singleValue(float64(s.MemoryStats.Limit))
+			},
+		},
+		{
+			name:      "memory_failures_total",
+			help:      "Number of times the memory usage of the container hits the limit.",
+			valueType: prometheus.CounterValue,
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				if s.MemoryStats == nil {
+					return nil
+				}
+				return singleValue(float64(s.MemoryStats.Failcnt))
+			},
+		},
+		{
+			name:      "oom_events_total",
+			help:      "Number of oom events of the container since the daemon started.",
+			valueType: prometheus.CounterValue,
+			getValues: func(_ *types.ContainerStats, events containerEventCounts) []metricValue {
+				return singleValue(float64(events.oom))
+			},
+		},
+		{
+			name:      "exec_failures_total",
+			help:      "Number of exec processes of the container exited with non-zero code since the daemon started.",
+			valueType: prometheus.CounterValue,
+			getValues: func(_ *types.ContainerStats, events containerEventCounts) []metricValue {
+				return singleValue(float64(events.execFailures))
+			},
+		},
+		{
+			name:      "pids",
+			help:      "Number of processes running in the container.",
+			valueType: prometheus.GaugeValue,
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				if s.PidsStats == nil {
+					return nil
+				}
+				return singleValue(float64(s.PidsStats.Current))
+			},
+		},
+		{
+			name:        "blkio_service_bytes_total",
+			help:        "Cumulative bytes transferred to and from the block device.",
+			valueType:   prometheus.CounterValue,
+			extraLabels: []string{"device", "operation"},
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				if s.BlkioStats == nil {
+					return nil
+				}
+				return blkioValues(s.BlkioStats.IoServiceBytesRecursive)
+			},
+		},
+		{
+			name:        "blkio_serviced_total",
+			help:        "Cumulative number of io requests issued to the block device.",
+			valueType:   prometheus.CounterValue,
+			extraLabels: []string{"device", "operation"},
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				if s.BlkioStats == nil {
+					return nil
+				}
+				return blkioValues(s.BlkioStats.IoServicedRecursive)
+			},
+		},
+	}
+
+	for _, n := range []struct {
+		name  string
+		help  string
+		value func(types.NetworkStats) uint64
+	}{
+		{"network_receive_bytes_total", "Cumulative bytes received.", func(n types.NetworkStats) uint64 { return n.RxBytes }},
+		{"network_receive_packets_total", "Cumulative packets received.", func(n types.NetworkStats) uint64 { return n.RxPackets }},
+		{"network_receive_errors_total", "Cumulative errors while receiving.", func(n types.NetworkStats) uint64 { return n.RxErrors }},
+		{"network_receive_packets_dropped_total", "Cumulative incoming packets dropped.", func(n types.NetworkStats) uint64 { return n.RxDropped }},
+		{"network_transmit_bytes_total", "Cumulative bytes transmitted.", func(n types.NetworkStats) uint64 { return n.TxBytes }},
+		{"network_transmit_packets_total", "Cumulative packets transmitted.", func(n types.NetworkStats) uint64 { return n.TxPackets }},
+		{"network_transmit_errors_total", "Cumulative errors while transmitting.", func(n types.NetworkStats) uint64 { return n.TxErrors }},
+		{"network_transmit_packets_dropped_total", "Cumulative outgoing packets dropped.", func(n types.NetworkStats) uint64 { return n.TxDropped }},
+	} {
+		value := n.value
+		metrics = append(metrics, containerMetric{
+			name:        n.name,
+			help:        n.help,
+			valueType:   prometheus.CounterValue,
+			extraLabels: []string{"interface"},
+			getValues: func(s *types.ContainerStats, _ containerEventCounts) []metricValue {
+				var values []metricValue
+				for iface, stats := range s.Networks {
+					values = append(values, metricValue{value: float64(value(stats)), labels: []string{iface}})
+				}
+				return values
+			},
+		})
+	}
+
+	return metrics
+}
+
+// blkioValues converts the blkio entries into values, the entries with same
+// device and operation are merged since the metric can't be duplicated.
+func blkioValues(entries []*types.BlkioStatEntry) []metricValue {
+	values := make([]metricValue, 0, len(entries))
+	index := map[string]int{}
+	for _, e := range entries {
+		device, op := fmt.Sprintf("%d:%d", e.Major, e.Minor), strings.ToLower(e.Op)
+		if i, ok := index[device+" "+op]; ok {
+			values[i].value += float64(e.Value)
+			continue
+		}
+
+		index[device+" "+op] = len(values)
+		values = append(values, metricValue{
+			value:  float64(e.Value),
+			labels: []string{device, op},
+		})
+	}
+	return values
+}
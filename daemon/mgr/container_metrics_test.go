@@ -0,0 +1,97 @@
+package mgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerMetricsLabelName(t *testing.T) {
+	assert.Equal(t, "container_label_app", containerMetricsLabelName("app"))
+	assert.Equal(t, "container_label_com_example_app_name", containerMetricsLabelName("com.example/app-name"))
+}
+
+func TestNewContainerMetricsCollector(t *testing.T) {
+	_, err := newContainerMetricsCollector(nil, 0, nil)
+	assert.Error(t, err)
+
+	_, err = newContainerMetricsCollector(nil, time.Second, []string{"a.b", "a-b"})
+	assert.Error(t, err)
+
+	_, err = newContainerMetricsCollector(nil, time.Second, []string{"a.b", "c"})
+	assert.NoError(t, err)
+}
+
+func TestContainerMetricsCollect(t *testing.T) {
+	collector, err := newContainerMetricsCollector(nil, time.Second, []string{"app"})
+	assert.NoError(t, err)
+
+	c := &Container{
+		ID:   "abc",
+		Name: "foo",
+		Config: &types.ContainerConfig{
+			Image:  "busybox:latest",
+			Labels: map[string]string{"app": "web", "ignored": "x"},
+		},
+	}
+	collector.samples[c.ID] = &containerMetricsSample{
+		labels: collector.containerLabels(c),
+		stats: &types.ContainerStats{
+			CPUStats: &types.CPUStats{
+				CPUUsage: &types.CPUUsage{TotalUsage: 2 * uint64(time.Second)},
+			},
+			MemoryStats: &types.MemoryStats{Usage: 1024, Limit: 4096},
+			BlkioStats: &types.BlkioStats{
+				IoServiceBytesRecursive: []*types.BlkioStatEntry{
+					{Major: 8, Op: "Read", Value: 1},
+					{Major: 8, Op: "Read", Value: 2},
+					{Major: 8, Op: "Write", Value: 3},
+				},
+			},
+			Networks: map[string]types.NetworkStats{"eth0": {RxBytes: 100}},
+		},
+	}
+	collector.IncOOM(c.ID)
+	collector.IncExecFailure(c.ID)
+	collector.IncExecFailure(c.ID)
+
+	registry := prometheus.NewRegistry()
+	assert.NoError(t, registry.Register(collector))
+
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+
+	values := map[string][]float64{}
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			assert.Equal(t, "abc", labels["id"])
+			assert.Equal(t, "foo", labels["name"])
+			assert.Equal(t, "busybox:latest", labels["image"])
+			assert.Equal(t, "web", labels["container_label_app"])
+			assert.NotContains(t, labels, "container_label_ignored")
+
+			if m.GetCounter() != nil {
+				values[f.GetName()] = append(values[f.GetName()], m.GetCounter().GetValue())
+			} else {
+				values[f.GetName()] = append(values[f.GetName()], m.GetGauge().GetValue())
+			}
+		}
+	}
+
+	assert.Equal(t, []float64{2}, values["engine_container_cpu_usage_seconds_total"])
+	assert.Equal(t, []float64{1024}, values["engine_container_memory_usage_bytes"])
+	assert.Equal(t, []float64{4096}, values["engine_container_memory_limit_bytes"])
+	assert.Equal(t, []float64{1}, values["engine_container_oom_events_total"])
+	assert.Equal(t, []float64{2}, values["engine_container_exec_failures_total"])
+	assert.Equal(t, []float64{100}, values["engine_container_network_receive_bytes_total"])
+	assert.Equal(t, []float64{3, 3}, values["engine_container_blkio_service_bytes_total"])
+	assert.NotContains(t, values, "engine_container_pids")
+}
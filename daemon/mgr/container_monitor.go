@@ -2,7 +2,10 @@ package mgr
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/alibaba/pouch/apis/metrics"
 	"github.com/alibaba/pouch/pkg/log"
 )
 
@@ -10,21 +13,58 @@ const (
 	// EvExit represents container's exit event.
 	EvExit = iota
 
-	// TODO add more
+	// EvOOM represents container's oom event.
+	EvOOM
+
+	// EvHealthChange represents the change of container's health status.
+	EvHealthChange
+
+	// EvExecExit represents the exit event of exec process in container.
+	EvExecExit
 )
 
+// defaultMonitorWorkers is the number of goroutines to handle container
+// events in monitor.
+const defaultMonitorWorkers = 16
+
 // ContainerEvent represents the container's events.
 type ContainerEvent struct {
 	Kind   int
 	c      *Container
 	handle func(*Container) error
+
+	// ExecID is the exec process of EvExecExit event.
+	ExecID string
+
+	// Health is the new health status of EvHealthChange event.
+	Health string
+}
+
+// KindName returns the name of event's kind.
+func (e *ContainerEvent) KindName() string {
+	switch e.Kind {
+	case EvExit:
+		return "exit"
+	case EvOOM:
+		return "oom"
+	case EvHealthChange:
+		return "health_change"
+	case EvExecExit:
+		return "exec_exit"
+	default:
+		return "none"
+	}
 }
 
 // String returns container's event type as a string.
 func (e *ContainerEvent) String() string {
 	switch e.Kind {
-	case EvExit:
-		return fmt.Sprintf("%s exit", e.c.ID)
+	case EvExit, EvOOM:
+		return fmt.Sprintf("%s %s", e.c.ID, e.KindName())
+	case EvHealthChange:
+		return fmt.Sprintf("%s %s to %s", e.c.ID, e.KindName(), e.Health)
+	case EvExecExit:
+		return fmt.Sprintf("%s %s %s", e.c.ID, e.KindName(), e.ExecID)
 	default:
 		return "none"
 	}
@@ -44,39 +84,130 @@ func ContainerExitEvent(c *Container) *ContainerEvent {
 	}
 }
 
-// TODO add more events
+// ContainerOOMEvent represents container's oom event.
+func ContainerOOMEvent(c *Container) *ContainerEvent {
+	return &ContainerEvent{
+		Kind: EvOOM,
+		c:    c,
+	}
+}
+
+// ContainerHealthChangeEvent represents the change of container's health status.
+func ContainerHealthChangeEvent(c *Container, health string) *ContainerEvent {
+	return &ContainerEvent{
+		Kind:   EvHealthChange,
+		c:      c,
+		Health: health,
+	}
+}
+
+// ContainerExecExitEvent represents the exit event of exec process in container.
+func ContainerExecExitEvent(c *Container, execID string) *ContainerEvent {
+	return &ContainerEvent{
+		Kind:   EvExecExit,
+		c:      c,
+		ExecID: execID,
+	}
+}
 
 // ContainerMonitor is used to monitor contianer's event.
+//
+// The events of one container are handled one by one in the order of
+// posting, and the events of different containers are handled concurrently
+// by a bounded pool of workers, so that a slow handler only delays the
+// events of its own container.
 type ContainerMonitor struct {
-	c chan *ContainerEvent
+	sync.Mutex
+	cond *sync.Cond
+
+	// queues keeps the pending events of each container. The container
+	// has an entry as long as its events are queued or being handled.
+	queues map[string][]*ContainerEvent
+
+	// ready is the containers whose next event can be handled.
+	ready []string
+
+	// pending is the number of events queued or being handled.
+	pending int
 }
 
 // NewContainerMonitor returns one ContainerMonitor object.
-func NewContainerMonitor() *ContainerMonitor {
+func NewContainerMonitor(workers int) *ContainerMonitor {
+	if workers <= 0 {
+		workers = defaultMonitorWorkers
+	}
+
 	m := &ContainerMonitor{
-		c: make(chan *ContainerEvent, 1000),
+		queues: map[string][]*ContainerEvent{},
 	}
+	m.cond = sync.NewCond(m)
 
-	go func() {
-		for {
-			ev := <-m.c
+	for i := 0; i < workers; i++ {
+		go m.worker()
+	}
 
-			log.With(nil).Debugf("receive event: %s", ev)
+	return m
+}
 
-			if ev.handle != nil {
-				log.With(nil).Infof("handle event: %s", ev)
+// PostEvent sends a event to monitor, it never blocks.
+func (m *ContainerMonitor) PostEvent(ev *ContainerEvent) {
+	m.Lock()
+	defer m.Unlock()
+
+	id := ev.c.ID
+	queue, scheduled := m.queues[id]
+	m.queues[id] = append(queue, ev)
+	m.pending++
+	metrics.ContainerMonitorQueueDepth.Set(float64(m.pending))
+
+	// the container is waiting or being handled by other worker, which
+	// will schedule the container again after handling the current event.
+	if !scheduled {
+		m.ready = append(m.ready, id)
+		m.cond.Signal()
+	}
+}
 
-				if err := ev.handle(ev.c); err != nil {
-					log.With(nil).Errorf("failed to handle event: %s", ev)
-				}
-			}
+func (m *ContainerMonitor) worker() {
+	for {
+		m.Lock()
+		for len(m.ready) == 0 {
+			m.cond.Wait()
 		}
-	}()
 
-	return m
+		id := m.ready[0]
+		m.ready = m.ready[1:]
+		ev := m.queues[id][0]
+		m.queues[id] = m.queues[id][1:]
+		m.Unlock()
+
+		m.handle(ev)
+
+		m.Lock()
+		m.pending--
+		metrics.ContainerMonitorQueueDepth.Set(float64(m.pending))
+		if len(m.queues[id]) > 0 {
+			m.ready = append(m.ready, id)
+			m.cond.Signal()
+		} else {
+			delete(m.queues, id)
+		}
+		m.Unlock()
+	}
 }
 
-// PostEvent sends a event to monitor.
-func (m *ContainerMonitor) PostEvent(ev *ContainerEvent) {
-	m.c <- ev
+func (m *ContainerMonitor) handle(ev *ContainerEvent) {
+	log.With(nil).Debugf("receive event: %s", ev)
+
+	if ev.handle == nil {
+		return
+	}
+
+	log.With(nil).Infof("handle event: %s", ev)
+
+	start := time.Now()
+	if err := ev.handle(ev.c); err != nil {
+		log.With(nil).Errorf("failed to handle event: %s: %v", ev, err)
+	}
+	metrics.ContainerMonitorHandleTimer.WithLabelValues(ev.KindName()).Observe(time.Since(start).Seconds())
 }
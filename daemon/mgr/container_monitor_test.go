@@ -0,0 +1,77 @@
+package mgr
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerMonitorSerializePerContainer(t *testing.T) {
+	m := NewContainerMonitor(4)
+	c := &Container{ID: "c1"}
+
+	var (
+		lock    sync.Mutex
+		handled []int
+		wg      sync.WaitGroup
+	)
+
+	for i := 0; i < 20; i++ {
+		i := i
+		wg.Add(1)
+		m.PostEvent(ContainerExitEvent(c).WithHandle(func(*Container) error {
+			defer wg.Done()
+			// the later event should not overtake the slow one.
+			if i%5 == 0 {
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			lock.Lock()
+			handled = append(handled, i)
+			lock.Unlock()
+			return nil
+		}))
+	}
+	wg.Wait()
+
+	expected := make([]int, 20)
+	for i := range expected {
+		expected[i] = i
+	}
+	assert.Equal(t, expected, handled)
+}
+
+func TestContainerMonitorConcurrentContainers(t *testing.T) {
+	m := NewContainerMonitor(2)
+
+	block := make(chan struct{})
+	done := make(chan struct{})
+
+	// the slow handler of c1 should not delay the events of c2.
+	m.PostEvent(ContainerExitEvent(&Container{ID: "c1"}).WithHandle(func(*Container) error {
+		<-block
+		return nil
+	}))
+	m.PostEvent(ContainerOOMEvent(&Container{ID: "c2"}).WithHandle(func(*Container) error {
+		close(done)
+		return nil
+	}))
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("event of c2 is blocked by the handler of c1")
+	}
+	close(block)
+}
+
+func TestContainerEventString(t *testing.T) {
+	c := &Container{ID: "c1"}
+
+	assert.Equal(t, "c1 exit", ContainerExitEvent(c).String())
+	assert.Equal(t, "c1 oom", ContainerOOMEvent(c).String())
+	assert.Equal(t, "c1 health_change to unhealthy", ContainerHealthChangeEvent(c, "unhealthy").String())
+	assert.Equal(t, "c1 exec_exit e1", ContainerExecExitEvent(c, "e1").String())
+}
@@ -0,0 +1,316 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
+)
+
+const (
+	// defaultRestartBackoff is the delay before the first restart.
+	defaultRestartBackoff = 100 * time.Millisecond
+
+	// maxRestartBackoff is the max delay between two restarts.
+	maxRestartBackoff = time.Minute
+
+	// restartBackoffMultiplier is the multiplier of delay for the next restart.
+	restartBackoffMultiplier = 2
+
+	// stableRuntime is the runtime window after which the container is
+	// treated as running stably, and the backoff is reset.
+	stableRuntime = 10 * time.Second
+
+	// crashLoopThreshold is the number of consecutive quick exits after
+	// which the container is marked as crash looping.
+	crashLoopThreshold = 5
+)
+
+// errRestartCanceled is returned when the pending restart is canceled by
+// user's action, such as stop or remove.
+var errRestartCanceled = fmt.Errorf("restart canceled")
+
+// IsUnlessStopped returns the container need to be restarted unless it is
+// stopped by user.
+func (p ContainerRestartPolicy) IsUnlessStopped() bool {
+	return p.Name == "unless-stopped"
+}
+
+// IsOnFailure returns the container need to be restarted when exits with
+// non-zero code.
+func (p ContainerRestartPolicy) IsOnFailure() bool {
+	return p.Name == "on-failure"
+}
+
+// restartManager decides whether and when to restart a container by the
+// restart policy after the container exits.
+type restartManager struct {
+	sync.Mutex
+
+	// restartCount is the number of restarts since the container is
+	// started by user, which is compared with MaximumRetryCount.
+	restartCount int64
+
+	// quickExits is the number of consecutive exits in stableRuntime.
+	quickExits int
+
+	backoff  time.Duration
+	active   bool
+	canceled bool
+	cancelCh chan struct{}
+}
+
+func newRestartManager() *restartManager {
+	return &restartManager{
+		cancelCh: make(chan struct{}),
+	}
+}
+
+// ShouldRestart returns whether the container should be restarted, and the
+// channel which receives nil after the backoff or an error if the restart
+// is canceled.
+func (rm *restartManager) ShouldRestart(policy *ContainerRestartPolicy, exitCode int64, manuallyStopped bool, runtime time.Duration) (bool, chan error, error) {
+	rm.Lock()
+	defer rm.Unlock()
+
+	if policy == nil || policy.IsNone() || rm.canceled {
+		return false, nil, nil
+	}
+
+	if rm.active {
+		return false, nil, fmt.Errorf("invalid call on an active restart manager")
+	}
+
+	// the container runs stably, so reset the backoff.
+	if runtime >= stableRuntime {
+		rm.backoff = 0
+		rm.quickExits = 0
+	} else {
+		rm.quickExits++
+	}
+
+	if rm.backoff == 0 {
+		rm.backoff = defaultRestartBackoff
+	} else {
+		rm.backoff *= restartBackoffMultiplier
+	}
+	if rm.backoff > maxRestartBackoff {
+		rm.backoff = maxRestartBackoff
+	}
+
+	var restart bool
+	switch {
+	case policy.IsAlways():
+		restart = true
+	case policy.IsUnlessStopped():
+		restart = !manuallyStopped
+	case policy.IsOnFailure():
+		if max := policy.MaximumRetryCount; max == 0 || rm.restartCount < max {
+			restart = exitCode != 0
+		}
+	}
+
+	if !restart {
+		return false, nil, nil
+	}
+
+	rm.restartCount++
+	rm.active = true
+
+	ch := make(chan error, 1)
+	go func(backoff time.Duration, cancelCh chan struct{}) {
+		select {
+		case <-cancelCh:
+			ch <- errRestartCanceled
+		case <-time.After(backoff):
+			rm.Lock()
+			rm.active = false
+			rm.Unlock()
+			ch <- nil
+		}
+	}(rm.backoff, rm.cancelCh)
+
+	return true, ch, nil
+}
+
+// CrashLooping returns true if the container keeps exiting soon after
+// being restarted.
+func (rm *restartManager) CrashLooping() bool {
+	rm.Lock()
+	defer rm.Unlock()
+
+	return rm.quickExits >= crashLoopThreshold
+}
+
+// Cancel cancels the pending restart and stops restarting the container
+// until it is reset.
+func (rm *restartManager) Cancel() {
+	rm.Lock()
+	defer rm.Unlock()
+
+	if !rm.canceled {
+		rm.canceled = true
+		close(rm.cancelCh)
+	}
+	rm.active = false
+}
+
+// Canceled returns whether the restart is canceled by user's action.
+func (rm *restartManager) Canceled() bool {
+	rm.Lock()
+	defer rm.Unlock()
+
+	return rm.canceled
+}
+
+// Reset cancels the pending restart and resets the counters, which is
+// called when the container is started by user.
+func (rm *restartManager) Reset() {
+	rm.Lock()
+	defer rm.Unlock()
+
+	if !rm.canceled {
+		close(rm.cancelCh)
+	}
+	rm.cancelCh = make(chan struct{})
+	rm.canceled = false
+	rm.active = false
+	rm.restartCount = 0
+	rm.quickExits = 0
+	rm.backoff = 0
+}
+
+// restartManagers keeps the restart manager of each container.
+type restartManagers struct {
+	sync.Mutex
+	managers map[string]*restartManager
+}
+
+func newRestartManagers() *restartManagers {
+	return &restartManagers{
+		managers: map[string]*restartManager{},
+	}
+}
+
+// Get returns the restart manager of container, a new one is created if not exist.
+func (rms *restartManagers) Get(id string) *restartManager {
+	rms.Lock()
+	defer rms.Unlock()
+
+	rm, ok := rms.managers[id]
+	if !ok {
+		rm = newRestartManager()
+		rms.managers[id] = rm
+	}
+	return rm
+}
+
+// Remove cancels and removes the restart manager of container.
+func (rms *restartManagers) Remove(id string) {
+	rms.Lock()
+	defer rms.Unlock()
+
+	if rm, ok := rms.managers[id]; ok {
+		rm.Cancel()
+		delete(rms.managers, id)
+	}
+}
+
+// handleContainerExit restarts the exited container by its restart policy.
+// The container is marked as restarting during the backoff, and it is
+// started in background so that the monitor is not blocked.
+func (mgr *ContainerManager) handleContainerExit(c *Container) error {
+	c.Lock()
+
+	// the container has been stopped or started by user.
+	if !c.State.Exited {
+		c.Unlock()
+		return nil
+	}
+
+	ctx := log.NewContext(context.Background(), map[string]interface{}{
+		"ContainerID": c.ID,
+	})
+	ctx = ctrd.WithSnapshotter(ctx, c.Config.Snapshotter)
+
+	rm := mgr.restartManagers.Get(c.ID)
+	policy := (*ContainerRestartPolicy)(c.HostConfig.RestartPolicy)
+	restart, wait, err := rm.ShouldRestart(policy, c.State.ExitCode, c.HasBeenManuallyStopped, containerRuntime(c))
+	if err != nil || !restart {
+		c.Unlock()
+		return err
+	}
+
+	crashLooping := rm.CrashLooping()
+	if crashLooping && !c.State.CrashLooping {
+		log.With(ctx).Warnf("container keeps exiting after restarted, it is crash looping")
+	}
+	c.State.CrashLooping = crashLooping
+	c.SetStatusRestarting()
+	if err := c.Write(mgr.Store); err != nil {
+		log.With(ctx).Errorf("failed to update meta: %v", err)
+	}
+	keys := c.DetachKeys
+	c.Unlock()
+
+	go func() {
+		err := <-wait
+
+		c.Lock()
+		defer c.Unlock()
+
+		if err == nil {
+			// the container may be stopped, removed or started by user
+			// after the backoff, then the restart is dropped.
+			if !c.IsRestarting() || rm.Canceled() {
+				log.With(ctx).Infof("container is not restarting any more, drop the restart by policy")
+				return
+			}
+			err = mgr.startLocked(ctx, c, &types.ContainerStartOptions{DetachKeys: keys})
+		}
+
+		if err != nil {
+			if err != errRestartCanceled {
+				log.With(ctx).Errorf("failed to restart container by policy: %v", err)
+			}
+
+			// the container may be stopped or removed during the backoff.
+			if c.IsRestarting() {
+				c.SetStatusExited(c.State.ExitCode, err.Error())
+				if err := c.Write(mgr.Store); err != nil {
+					log.With(ctx).Errorf("failed to update meta: %v", err)
+				}
+			}
+			return
+		}
+
+		c.RestartCount++
+		if err := c.Write(mgr.Store); err != nil {
+			log.With(ctx).Errorf("failed to update meta: %v", err)
+		}
+		mgr.LogContainerEventWithAttributes(ctx, c, "restart", map[string]string{
+			"restartCount": strconv.FormatInt(c.RestartCount, 10),
+		})
+	}()
+
+	return nil
+}
+
+// containerRuntime returns how long the container ran before it exited.
+func containerRuntime(c *Container) time.Duration {
+	start, err := time.Parse(utils.TimeLayout, c.State.StartedAt)
+	if err != nil {
+		return 0
+	}
+	finish, err := time.Parse(utils.TimeLayout, c.State.FinishedAt)
+	if err != nil || finish.Before(start) {
+		return 0
+	}
+	return finish.Sub(start)
+}
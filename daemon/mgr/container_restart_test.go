@@ -0,0 +1,197 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartManagerShouldRestart(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		policy          *ContainerRestartPolicy
+		exitCode        int64
+		manuallyStopped bool
+		expected        bool
+	}{
+		{"nil policy", nil, 1, false, false},
+		{"no", &ContainerRestartPolicy{Name: "no"}, 1, false, false},
+		{"always", &ContainerRestartPolicy{Name: "always"}, 0, false, true},
+		{"always manually stopped", &ContainerRestartPolicy{Name: "always"}, 0, true, true},
+		{"unless-stopped", &ContainerRestartPolicy{Name: "unless-stopped"}, 0, false, true},
+		{"unless-stopped manually stopped", &ContainerRestartPolicy{Name: "unless-stopped"}, 0, true, false},
+		{"on-failure with zero code", &ContainerRestartPolicy{Name: "on-failure"}, 0, false, false},
+		{"on-failure with non-zero code", &ContainerRestartPolicy{Name: "on-failure"}, 1, false, true},
+	} {
+		rm := newRestartManager()
+		restart, wait, err := rm.ShouldRestart(tc.policy, tc.exitCode, tc.manuallyStopped, time.Second)
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, tc.expected, restart, tc.name)
+		if restart {
+			assert.NoError(t, <-wait, tc.name)
+		}
+	}
+}
+
+func TestRestartManagerMaximumRetryCount(t *testing.T) {
+	rm := newRestartManager()
+	policy := &ContainerRestartPolicy{Name: "on-failure", MaximumRetryCount: 2}
+
+	for i := 0; i < 2; i++ {
+		restart, wait, err := rm.ShouldRestart(policy, 1, false, stableRuntime)
+		assert.NoError(t, err)
+		assert.True(t, restart)
+		assert.NoError(t, <-wait)
+	}
+
+	restart, _, err := rm.ShouldRestart(policy, 1, false, stableRuntime)
+	assert.NoError(t, err)
+	assert.False(t, restart)
+
+	// started by user again.
+	rm.Reset()
+	restart, wait, err := rm.ShouldRestart(policy, 1, false, stableRuntime)
+	assert.NoError(t, err)
+	assert.True(t, restart)
+	assert.NoError(t, <-wait)
+}
+
+func TestRestartManagerBackoff(t *testing.T) {
+	rm := newRestartManager()
+	policy := &ContainerRestartPolicy{Name: "always"}
+
+	expected := defaultRestartBackoff
+	for i := 0; i < crashLoopThreshold; i++ {
+		assert.False(t, rm.CrashLooping())
+
+		restart, wait, err := rm.ShouldRestart(policy, 1, false, time.Second)
+		assert.NoError(t, err)
+		assert.True(t, restart)
+		assert.Equal(t, expected, rm.backoff)
+
+		// the manager is active before the backoff ends.
+		_, _, err = rm.ShouldRestart(policy, 1, false, time.Second)
+		assert.Error(t, err)
+
+		assert.NoError(t, <-wait)
+		expected *= restartBackoffMultiplier
+	}
+	assert.True(t, rm.CrashLooping())
+
+	// reset the backoff after the container runs stably.
+	restart, wait, err := rm.ShouldRestart(policy, 1, false, stableRuntime)
+	assert.NoError(t, err)
+	assert.True(t, restart)
+	assert.Equal(t, defaultRestartBackoff, rm.backoff)
+	assert.False(t, rm.CrashLooping())
+	assert.NoError(t, <-wait)
+
+	// the backoff is limited by maxRestartBackoff.
+	rm.backoff = maxRestartBackoff
+	restart, _, err = rm.ShouldRestart(policy, 1, false, time.Second)
+	assert.NoError(t, err)
+	assert.True(t, restart)
+	assert.Equal(t, maxRestartBackoff, rm.backoff)
+	rm.Cancel()
+}
+
+func TestRestartManagerCancel(t *testing.T) {
+	rm := newRestartManager()
+	policy := &ContainerRestartPolicy{Name: "always"}
+
+	rm.backoff = maxRestartBackoff
+	restart, wait, err := rm.ShouldRestart(policy, 1, false, time.Second)
+	assert.NoError(t, err)
+	assert.True(t, restart)
+
+	rm.Cancel()
+	assert.Equal(t, errRestartCanceled, <-wait)
+	assert.True(t, rm.Canceled())
+
+	// canceled manager doesn't restart the container until reset.
+	restart, _, err = rm.ShouldRestart(policy, 1, false, time.Second)
+	assert.NoError(t, err)
+	assert.False(t, restart)
+
+	rm.Reset()
+	restart, wait, err = rm.ShouldRestart(policy, 1, false, time.Second)
+	assert.NoError(t, err)
+	assert.True(t, restart)
+	assert.NoError(t, <-wait)
+}
+
+func TestHandleContainerExitStoppedAfterBackoff(t *testing.T) {
+	dir, err := ioutil.TempDir("", "restart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: dir,
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(Container{}),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mgr := &ContainerManager{
+		Store:           store,
+		restartManagers: newRestartManagers(),
+	}
+	c := &Container{
+		ID:     "c1",
+		Config: &types.ContainerConfig{},
+		HostConfig: &types.HostConfig{
+			RestartPolicy: &types.RestartPolicy{Name: "always"},
+		},
+		State: &types.ContainerState{},
+	}
+	c.SetStatusExited(1, "")
+
+	assert.NoError(t, mgr.handleContainerExit(c))
+
+	// the container is stopped by user after the backoff, but before the
+	// container is started by policy.
+	c.Lock()
+	assert.True(t, c.IsRestarting())
+	time.Sleep(2 * defaultRestartBackoff)
+	mgr.restartManagers.Get(c.ID).Cancel()
+	c.SetStatusStopped(1, "")
+	c.Unlock()
+
+	// the restart is dropped, the container is not started.
+	time.Sleep(defaultRestartBackoff)
+	c.Lock()
+	defer c.Unlock()
+	assert.Equal(t, types.StatusStopped, c.State.Status)
+	assert.Equal(t, int64(0), c.RestartCount)
+}
+
+func TestContainerRuntime(t *testing.T) {
+	now := time.Now().UTC()
+	c := &Container{
+		State: &types.ContainerState{
+			StartedAt:  now.Add(-time.Minute).Format(utils.TimeLayout),
+			FinishedAt: now.Format(utils.TimeLayout),
+		},
+	}
+	assert.Equal(t, time.Minute, containerRuntime(c))
+
+	c.State.StartedAt = ""
+	assert.Equal(t, time.Duration(0), containerRuntime(c))
+}
@@ -0,0 +1,171 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd/mount"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultSecretDir is the directory of secret files in container.
+	defaultSecretDir = "/run/secrets"
+
+	// defaultSecretMode is the default file mode of secret files.
+	defaultSecretMode = 0444
+)
+
+// validateSecretReferences checks the secrets referenced by the container
+// exist, and fills the default target of secret files.
+func (mgr *ContainerManager) validateSecretReferences(ctx context.Context, hostConfig *types.HostConfig) error {
+	if len(hostConfig.Secrets) == 0 {
+		return nil
+	}
+
+	if mgr.SecretMgr == nil {
+		return errors.Wrap(errtypes.ErrInvalidParam, "secret is not supported")
+	}
+
+	targets := make(map[string]string, len(hostConfig.Secrets))
+	for _, ref := range hostConfig.Secrets {
+		if ref == nil {
+			return errors.Wrap(errtypes.ErrInvalidParam, "secret reference cannot be empty")
+		}
+
+		secret, err := mgr.SecretMgr.Get(ctx, ref.Name)
+		if err != nil {
+			return err
+		}
+		// always refer to the secret by name, which is checked when
+		// removing the secret.
+		ref.Name = secret.Name
+
+		if ref.Target == "" {
+			ref.Target = path.Join(defaultSecretDir, secret.Name)
+		}
+		if !path.IsAbs(ref.Target) {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "target of secret %s must be an absolute path: %s", ref.Name, ref.Target)
+		}
+		ref.Target = path.Clean(ref.Target)
+
+		if name, ok := targets[ref.Target]; ok {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "secrets %s and %s have the same target %s", name, ref.Name, ref.Target)
+		}
+		targets[ref.Target] = ref.Name
+
+		if ref.Mode == 0 {
+			ref.Mode = defaultSecretMode
+		}
+		if ref.Mode&^0777 != 0 {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid file mode %o of secret %s", ref.Mode, ref.Name)
+		}
+	}
+
+	return nil
+}
+
+// setupSecrets mounts a tmpfs for the container and writes the secret files
+// into it, the secret files are bind mounted into the container, so that the
+// values of secrets are never written to disk.
+func (mgr *ContainerManager) setupSecrets(ctx context.Context, c *Container) (err error) {
+	if c.HostConfig == nil || len(c.HostConfig.Secrets) == 0 {
+		return nil
+	}
+
+	if mgr.SecretMgr == nil {
+		return fmt.Errorf("secret is not supported")
+	}
+
+	// clean up the tmpfs left by last run, such as pouchd was killed.
+	if err := mgr.releaseSecrets(ctx, c); err != nil {
+		return err
+	}
+
+	dir := path.Join(mgr.Store.Path(c.ID), "secrets")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	tmpfs := mount.Mount{
+		Type:    "tmpfs",
+		Source:  "tmpfs",
+		Options: []string{"nosuid", "nodev", "noexec", "mode=0700"},
+	}
+	if err := tmpfs.Mount(dir); err != nil {
+		return errors.Wrapf(err, "failed to mount tmpfs for secrets of container %s", c.ID)
+	}
+	c.SecretsPath = dir
+
+	defer func() {
+		if err != nil {
+			if rerr := mgr.releaseSecrets(ctx, c); rerr != nil {
+				log.With(ctx).Errorf("failed to release secrets of container %s: %v", c.ID, rerr)
+			}
+		}
+	}()
+
+	for _, ref := range c.HostConfig.Secrets {
+		_, value, err := mgr.SecretMgr.Value(ctx, ref.Name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get secret %s", ref.Name)
+		}
+
+		file := filepath.Join(dir, ref.Name)
+		if err := ioutil.WriteFile(file, value, 0600); err != nil {
+			return errors.Wrapf(err, "failed to write secret %s", ref.Name)
+		}
+		if err := os.Chown(file, int(ref.UID), int(ref.GID)); err != nil {
+			return errors.Wrapf(err, "failed to chown secret %s", ref.Name)
+		}
+		if err := os.Chmod(file, os.FileMode(ref.Mode)); err != nil {
+			return errors.Wrapf(err, "failed to chmod secret %s", ref.Name)
+		}
+	}
+
+	return nil
+}
+
+// releaseSecrets unmounts the tmpfs of secrets of the container.
+func (mgr *ContainerManager) releaseSecrets(ctx context.Context, c *Container) error {
+	if c.SecretsPath == "" {
+		return nil
+	}
+
+	if err := mount.UnmountAll(c.SecretsPath, 0); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to unmount secrets of container %s", c.ID)
+	}
+	if err := os.RemoveAll(c.SecretsPath); err != nil {
+		return err
+	}
+
+	c.SecretsPath = ""
+	return nil
+}
+
+// generateSecretMounts generates the read-only bind mounts of secret files.
+func generateSecretMounts(c *Container) []specs.Mount {
+	if c.SecretsPath == "" || c.HostConfig == nil {
+		return nil
+	}
+
+	mounts := make([]specs.Mount, 0, len(c.HostConfig.Secrets))
+	for _, ref := range c.HostConfig.Secrets {
+		mounts = append(mounts, specs.Mount{
+			Source:      filepath.Join(c.SecretsPath, ref.Name),
+			Destination: ref.Target,
+			Type:        "bind",
+			Options:     []string{"rbind", "ro", "rprivate"},
+		})
+	}
+	return mounts
+}
@@ -0,0 +1,286 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	daemon_config "github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/log"
+)
+
+const (
+	// ShutdownPriorityLabel is the label of container to specify the order
+	// to stop containers when pouchd stops, the container with lower
+	// priority is stopped earlier. The default priority is 0.
+	ShutdownPriorityLabel = "pouch.shutdown.priority"
+
+	// ShutdownDependsOnLabel is the label of container to specify the names
+	// or IDs of containers it depends on, separated by comma. The container
+	// is stopped before the containers it depends on when pouchd stops.
+	ShutdownDependsOnLabel = "pouch.shutdown.depends-on"
+)
+
+// Shutdown handles the running containers by the shutdown mode when pouchd
+// stops. With live-restore, the containers keep running and are recovered
+// when pouchd starts again. With stop, the containers are stopped in order
+// of dependency and priority, and the containers in the same order are
+// stopped in parallel.
+func (mgr *ContainerManager) Shutdown(ctx context.Context) error {
+	if mgr.Config.ShutdownMode != daemon_config.ShutdownModeStop {
+		log.With(ctx).Infof("keep containers running when pouchd stops")
+		return nil
+	}
+
+	containers, err := mgr.List(ctx, &ContainerListOption{
+		All: true,
+		FilterFunc: func(c *Container) bool {
+			return c.IsRunningOrPaused() || c.IsRestarting()
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if mgr.Config.ShutdownTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(mgr.Config.ShutdownTimeout)*time.Second)
+		defer cancel()
+	}
+
+	var missed []string
+	for _, group := range shutdownOrder(containers) {
+		if ctx.Err() != nil {
+			for _, c := range group {
+				missed = append(missed, c.Name)
+			}
+			continue
+		}
+		missed = append(missed, mgr.shutdownContainers(ctx, group)...)
+	}
+
+	if len(missed) > 0 {
+		sort.Strings(missed)
+		return fmt.Errorf("containers missed the shutdown deadline: %s", strings.Join(missed, ", "))
+	}
+	return nil
+}
+
+// shutdownContainers stops the containers in parallel, and returns the names
+// of the containers not stopped before deadline.
+func (mgr *ContainerManager) shutdownContainers(ctx context.Context, containers []*Container) []string {
+	var (
+		wg      sync.WaitGroup
+		lock    sync.Mutex
+		stopped = map[string]bool{}
+	)
+
+	for _, c := range containers {
+		wg.Add(1)
+		go func(c *Container) {
+			defer wg.Done()
+
+			timeout := c.StopTimeout()
+			if deadline, ok := ctx.Deadline(); ok {
+				left := int64(time.Until(deadline) / time.Second)
+				if left < 1 {
+					left = 1
+				}
+				if left < timeout {
+					timeout = left
+				}
+			}
+
+			if err := mgr.Stop(ctx, c.ID, timeout); err != nil {
+				log.With(ctx).Errorf("failed to stop container %s when pouchd stops: %v", c.ID, err)
+				return
+			}
+
+			// the container is not stopped by user, so that it can be
+			// started by restart policy when pouchd starts again.
+			c.Lock()
+			c.HasBeenManuallyStopped = false
+			c.StoppedByShutdown = true
+			if err := c.Write(mgr.Store); err != nil {
+				log.With(ctx).Errorf("failed to update meta of container %s: %v", c.ID, err)
+			}
+			c.Unlock()
+
+			lock.Lock()
+			stopped[c.ID] = true
+			lock.Unlock()
+		}(c)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	var missed []string
+	for _, c := range containers {
+		if !stopped[c.ID] {
+			missed = append(missed, c.Name)
+		}
+	}
+	return missed
+}
+
+// shutdownOrder groups the containers in the order to stop. A container is
+// stopped after all the containers depending on it, and the containers with
+// lower priority are stopped first. The containers in a dependency cycle are
+// stopped together.
+func shutdownOrder(containers []*Container) [][]*Container {
+	lookup := map[string]*Container{}
+	for _, c := range containers {
+		lookup[c.ID] = c
+		lookup[strings.TrimPrefix(c.Name, "/")] = c
+	}
+
+	// dependents is the number of containers depending on the container
+	// which have not been stopped.
+	var (
+		dependents = map[string]int{}
+		dependsOn  = map[string][]*Container{}
+	)
+	for _, c := range containers {
+		for _, dep := range shutdownDependencies(c) {
+			d, ok := lookup[dep]
+			if !ok || d.ID == c.ID {
+				continue
+			}
+			dependsOn[c.ID] = append(dependsOn[c.ID], d)
+			dependents[d.ID]++
+		}
+	}
+
+	remaining := append([]*Container{}, containers...)
+	var groups [][]*Container
+	for len(remaining) > 0 {
+		var available []*Container
+		for _, c := range remaining {
+			if dependents[c.ID] == 0 {
+				available = append(available, c)
+			}
+		}
+
+		// dependency cycle, stop the remaining containers together.
+		if len(available) == 0 {
+			available = remaining
+		}
+
+		min := shutdownPriority(available[0])
+		for _, c := range available[1:] {
+			if p := shutdownPriority(c); p < min {
+				min = p
+			}
+		}
+
+		var group []*Container
+		for _, c := range available {
+			if shutdownPriority(c) == min {
+				group = append(group, c)
+			}
+		}
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].Name < group[j].Name
+		})
+		groups = append(groups, group)
+
+		inGroup := map[string]bool{}
+		for _, c := range group {
+			inGroup[c.ID] = true
+			for _, d := range dependsOn[c.ID] {
+				dependents[d.ID]--
+			}
+		}
+
+		var rest []*Container
+		for _, c := range remaining {
+			if !inGroup[c.ID] {
+				rest = append(rest, c)
+			}
+		}
+		remaining = rest
+	}
+
+	return groups
+}
+
+// shutdownPriority returns the priority of container in ShutdownPriorityLabel.
+func shutdownPriority(c *Container) int {
+	if c.Config == nil {
+		return 0
+	}
+
+	v, ok := c.Config.Labels[ShutdownPriorityLabel]
+	if !ok {
+		return 0
+	}
+
+	p, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil {
+		log.With(nil).Warnf("invalid label %s=%s of container %s", ShutdownPriorityLabel, v, c.ID)
+		return 0
+	}
+	return p
+}
+
+// shutdownDependencies returns the containers in ShutdownDependsOnLabel.
+func shutdownDependencies(c *Container) []string {
+	if c.Config == nil {
+		return nil
+	}
+
+	var deps []string
+	for _, dep := range strings.Split(c.Config.Labels[ShutdownDependsOnLabel], ",") {
+		if dep = strings.TrimPrefix(strings.TrimSpace(dep), "/"); dep != "" {
+			deps = append(deps, dep)
+		}
+	}
+	return deps
+}
+
+// shouldStartAfterShutdown returns true if the container stopped by pouchd
+// shutdown should be started again by its restart policy.
+func shouldStartAfterShutdown(c *Container) bool {
+	if !c.StoppedByShutdown || c.State.Status != types.StatusStopped || c.HostConfig == nil {
+		return false
+	}
+
+	policy := (*ContainerRestartPolicy)(c.HostConfig.RestartPolicy)
+	return policy != nil && (policy.IsAlways() || policy.IsUnlessStopped())
+}
+
+// startAfterShutdown starts the container stopped by pouchd shutdown.
+func (mgr *ContainerManager) startAfterShutdown(c *Container) error {
+	c.Lock()
+	start := shouldStartAfterShutdown(c)
+	keys := c.DetachKeys
+	c.Unlock()
+
+	if !start {
+		return nil
+	}
+
+	ctx := log.NewContext(context.Background(), map[string]interface{}{
+		"ContainerID": c.ID,
+	})
+	log.With(ctx).Infof("start container stopped by pouchd shutdown")
+
+	return mgr.Start(ctx, c.ID, &types.ContainerStartOptions{DetachKeys: keys})
+}
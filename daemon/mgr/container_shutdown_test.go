@@ -0,0 +1,108 @@
+package mgr
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newShutdownContainer(name string, labels map[string]string) *Container {
+	return &Container{
+		ID:   name + "-id",
+		Name: name,
+		Config: &types.ContainerConfig{
+			Labels: labels,
+		},
+	}
+}
+
+func shutdownOrderNames(groups [][]*Container) [][]string {
+	var names [][]string
+	for _, group := range groups {
+		var g []string
+		for _, c := range group {
+			g = append(g, c.Name)
+		}
+		names = append(names, g)
+	}
+	return names
+}
+
+func TestShutdownOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		containers []*Container
+		expected   [][]string
+	}{
+		{
+			name: "no labels",
+			containers: []*Container{
+				newShutdownContainer("b", nil),
+				newShutdownContainer("a", nil),
+			},
+			expected: [][]string{{"a", "b"}},
+		},
+		{
+			name: "priority",
+			containers: []*Container{
+				newShutdownContainer("db", map[string]string{ShutdownPriorityLabel: "10"}),
+				newShutdownContainer("web", map[string]string{ShutdownPriorityLabel: "-1"}),
+				newShutdownContainer("cache", nil),
+				newShutdownContainer("bad", map[string]string{ShutdownPriorityLabel: "x"}),
+			},
+			expected: [][]string{{"web"}, {"bad", "cache"}, {"db"}},
+		},
+		{
+			name: "dependency",
+			containers: []*Container{
+				newShutdownContainer("db", nil),
+				newShutdownContainer("app", map[string]string{ShutdownDependsOnLabel: "db, cache-id"}),
+				newShutdownContainer("cache", nil),
+				newShutdownContainer("proxy", map[string]string{ShutdownDependsOnLabel: "/app,missing"}),
+			},
+			expected: [][]string{{"proxy"}, {"app"}, {"cache", "db"}},
+		},
+		{
+			name: "dependency before priority",
+			containers: []*Container{
+				newShutdownContainer("db", map[string]string{ShutdownPriorityLabel: "-10"}),
+				newShutdownContainer("app", map[string]string{ShutdownDependsOnLabel: "db"}),
+			},
+			expected: [][]string{{"app"}, {"db"}},
+		},
+		{
+			name: "cycle",
+			containers: []*Container{
+				newShutdownContainer("a", map[string]string{ShutdownDependsOnLabel: "b"}),
+				newShutdownContainer("b", map[string]string{ShutdownDependsOnLabel: "a"}),
+				newShutdownContainer("c", map[string]string{ShutdownDependsOnLabel: "a"}),
+			},
+			expected: [][]string{{"c"}, {"a", "b"}},
+		},
+	} {
+		assert.Equal(t, tc.expected, shutdownOrderNames(shutdownOrder(tc.containers)), tc.name)
+	}
+}
+
+func TestShouldStartAfterShutdown(t *testing.T) {
+	c := &Container{
+		State:      &types.ContainerState{Status: types.StatusStopped},
+		HostConfig: &types.HostConfig{RestartPolicy: &types.RestartPolicy{Name: "always"}},
+	}
+	assert.False(t, shouldStartAfterShutdown(c))
+
+	c.StoppedByShutdown = true
+	assert.True(t, shouldStartAfterShutdown(c))
+
+	c.HostConfig.RestartPolicy.Name = "unless-stopped"
+	assert.True(t, shouldStartAfterShutdown(c))
+
+	c.HostConfig.RestartPolicy.Name = "on-failure"
+	assert.False(t, shouldStartAfterShutdown(c))
+
+	c.HostConfig.RestartPolicy.Name = "always"
+	c.State.Status = types.StatusRunning
+	assert.False(t, shouldStartAfterShutdown(c))
+}
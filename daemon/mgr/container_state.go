@@ -73,6 +73,19 @@ func (c *Container) SetStatusExited(exitCode int64, errMsg string) {
 	c.setStatusFlags(types.StatusExited)
 }
 
+// SetStatusRestarting sets a container to be status restarting, which means
+// the container has exited and is waiting to be restarted by restart policy.
+func (c *Container) SetStatusRestarting() {
+	c.State.Status = types.StatusRestarting
+	c.State.Pid = 0
+	c.setStatusFlags(types.StatusRestarting)
+}
+
+// IsRestarting returns container is restarting or not.
+func (c *Container) IsRestarting() bool {
+	return c.State.Restarting
+}
+
 // SetStatusPaused sets a container to be status paused.
 func (c *Container) SetStatusPaused() {
 	c.State.Status = types.StatusPaused
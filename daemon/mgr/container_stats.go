@@ -12,13 +12,14 @@ import (
 
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/system"
 
 	"github.com/containerd/cgroups"
 	containerdtypes "github.com/containerd/containerd/api/types"
 	"github.com/containerd/typeurl"
 	"github.com/docker/docker/pkg/ioutils"
 	"github.com/go-openapi/strfmt"
-	"github.com/opencontainers/runc/libcontainer/system"
+	rsystem "github.com/opencontainers/runc/libcontainer/system"
 	"github.com/pkg/errors"
 )
 
@@ -37,13 +38,8 @@ func (mgr *ContainerManager) StreamStats(ctx context.Context, name string, confi
 
 	var preCPUStats *types.CPUStats
 
-	wrapContainerStats := func(metricMeta *containerdtypes.Metric, metric *cgroups.Metrics) (*types.ContainerStats, error) {
-		stats := toContainerStats(c, metricMeta, metric)
-
-		// if the container does not set memory limit, use the machineMemory
-		if stats.MemoryStats.Limit > mgr.Config.MachineMemory && mgr.Config.MachineMemory > 0 {
-			stats.MemoryStats.Limit = mgr.Config.MachineMemory
-		}
+	wrapContainerStats := func(metricMeta *containerdtypes.Metric, metric *ContainerMetrics) (*types.ContainerStats, error) {
+		stats := mgr.wrapContainerStats(c, metricMeta, metric)
 
 		systemCPUUsage, err := getSystemCPUUsage()
 		if err != nil {
@@ -52,13 +48,6 @@ func (mgr *ContainerManager) StreamStats(ctx context.Context, name string, confi
 		stats.PrecpuStats = preCPUStats
 		stats.CPUStats.SyetemCPUUsage = systemCPUUsage
 		preCPUStats = stats.CPUStats
-
-		networkStat, err := mgr.NetworkMgr.GetNetworkStats(c.NetworkSettings.SandboxID)
-		if err != nil {
-			// --net=none or disconnect from network, the sandbox will be nil
-			log.With(nil).Debugf("failed to get network stats from container %s: %v", name, err)
-		}
-		stats.Networks = networkStat
 		return stats, nil
 	}
 
@@ -107,8 +96,15 @@ func (mgr *ContainerManager) StreamStats(ctx context.Context, name string, confi
 	}
 }
 
+// ContainerMetrics is the cgroup metrics of a container. Only one of V1 and
+// V2 is set, which depends on the cgroup hierarchy of host.
+type ContainerMetrics struct {
+	V1 *cgroups.Metrics
+	V2 *system.CgroupV2Metrics
+}
+
 // Stats gets the stat of a container.
-func (mgr *ContainerManager) Stats(ctx context.Context, name string) (*containerdtypes.Metric, *cgroups.Metrics, error) {
+func (mgr *ContainerManager) Stats(ctx context.Context, name string) (*containerdtypes.Metric, *ContainerMetrics, error) {
 	c, err := mgr.container(name)
 	if err != nil {
 		return nil, nil, err
@@ -124,6 +120,12 @@ func (mgr *ContainerManager) Stats(ctx context.Context, name string) (*container
 		return nil, nil, nil
 	}
 
+	// the metrics of cgroup v2 are read from the container's cgroup
+	// directly, since the runtime only reports the v1 metrics.
+	if system.IsCgroup2UnifiedMode() {
+		return mgr.cgroupV2Stats(c)
+	}
+
 	metric, err := mgr.Client.ContainerStats(ctx, c.ID)
 	if err != nil {
 		return nil, nil, err
@@ -134,10 +136,31 @@ func (mgr *ContainerManager) Stats(ctx context.Context, name string) (*container
 		return nil, nil, err
 	}
 
-	return metric, v.(*cgroups.Metrics), nil
+	return metric, &ContainerMetrics{V1: v.(*cgroups.Metrics)}, nil
 }
 
-func toContainerStats(container *Container, metricMeta *containerdtypes.Metric, metric *cgroups.Metrics) *types.ContainerStats {
+// wrapContainerStats converts the metrics into container stats, and fills
+// the network stats of container.
+func (mgr *ContainerManager) wrapContainerStats(c *Container, metricMeta *containerdtypes.Metric, metric *ContainerMetrics) *types.ContainerStats {
+	stats := toContainerStats(c, metricMeta, metric)
+
+	// if the container does not set memory limit, use the machineMemory
+	if stats.MemoryStats.Limit > mgr.Config.MachineMemory && mgr.Config.MachineMemory > 0 {
+		stats.MemoryStats.Limit = mgr.Config.MachineMemory
+	}
+
+	if mgr.NetworkMgr != nil && c.NetworkSettings != nil {
+		networkStat, err := mgr.NetworkMgr.GetNetworkStats(c.NetworkSettings.SandboxID)
+		if err != nil {
+			// --net=none or disconnect from network, the sandbox will be nil
+			log.With(nil).Debugf("failed to get network stats from container %s: %v", c.ID, err)
+		}
+		stats.Networks = networkStat
+	}
+	return stats
+}
+
+func toContainerStats(container *Container, metricMeta *containerdtypes.Metric, metrics *ContainerMetrics) *types.ContainerStats {
 	res := &types.ContainerStats{
 		ID:          container.ID,
 		Name:        container.Name,
@@ -153,6 +176,13 @@ func toContainerStats(container *Container, metricMeta *containerdtypes.Metric,
 
 	res.Read = strfmt.DateTime(metricMeta.Timestamp)
 
+	if metrics.V2 != nil {
+		setCgroupV2Stats(res, metrics.V2)
+		return res
+	}
+
+	metric := metrics.V1
+
 	if metric.Pids != nil {
 		res.PidsStats = &types.PidsStats{
 			Current: metric.Pids.Current,
@@ -289,7 +319,7 @@ func getSystemCPUUsage() (uint64, error) {
 				totalClockTicks += v
 			}
 			return (totalClockTicks * nanoSecondsPerSecond) /
-				uint64(system.GetClockTicks()), nil
+				uint64(rsystem.GetClockTicks()), nil
 		}
 	}
 	return 0, fmt.Errorf("invalid stat format, fail to parse the '/proc/stat' file")
@@ -135,6 +135,12 @@ type ContainerExecConfig struct {
 
 	// Exited means exec process exit or not
 	Exited bool
+
+	// StartedAt is the time when exec process started.
+	StartedAt time.Time
+
+	// FinishedAt is the time when exec process exited.
+	FinishedAt time.Time
 }
 
 // AttachConfig wraps some infos of attaching.
@@ -177,6 +183,16 @@ type ContainerStatsConfig struct {
 	OutStream io.Writer
 }
 
+// ContainerSchemaVersion is the schema version of the container meta data
+// in meta store. When the stored json of Container changes incompatibly, it
+// should be increased with a migration appended to ContainerMigrations.
+const ContainerSchemaVersion = 1
+
+// ContainerMigrations upgrade the container meta data written by older
+// pouchd. The meta data before the version is stamped is version 0, which
+// is compatible with version 1, so there is no migration to version 1.
+var ContainerMigrations []meta.Migration
+
 // Container represents the container's meta data.
 type Container struct {
 	sync.Mutex
@@ -254,6 +270,9 @@ type Container struct {
 	// resolv conf path
 	ResolvConfPath string `json:"ResolvConfPath,omitempty"`
 
+	// the tmpfs of secret files
+	SecretsPath string `json:"SecretsPath,omitempty"`
+
 	// restart count
 	RestartCount int64 `json:"RestartCount,omitempty"`
 
@@ -272,6 +291,15 @@ type Container struct {
 	// Escape keys for detach
 	DetachKeys string
 
+	// HasBeenManuallyStopped is true if the container is stopped by user,
+	// which is used by restart policy unless-stopped.
+	HasBeenManuallyStopped bool
+
+	// StoppedByShutdown is true if the container is stopped when pouchd
+	// stops with shutdown mode stop, which is started again by restart
+	// policy when pouchd starts.
+	StoppedByShutdown bool
+
 	// RootFSProvided is a flag to point the container is created by specify rootfs
 	RootFSProvided bool
 
@@ -406,7 +434,7 @@ func (c *Container) FormatStatus() (string, error) {
 			status += "(paused)"
 		}
 
-	case types.StatusStopped, types.StatusExited:
+	case types.StatusStopped, types.StatusExited, types.StatusRestarting:
 		finish, err := time.Parse(utils.TimeLayout, c.State.FinishedAt)
 		if err != nil {
 			return "", err
@@ -425,6 +453,12 @@ func (c *Container) FormatStatus() (string, error) {
 		if c.State.Status == types.StatusExited {
 			status = fmt.Sprintf("Exited (%d) %s", exitCode, finishAt)
 		}
+		if c.State.Status == types.StatusRestarting {
+			status = fmt.Sprintf("Restarting (%d) %s", exitCode, finishAt)
+			if c.State.CrashLooping {
+				status += "(crash loop)"
+			}
+		}
 	}
 
 	if status == "" {
@@ -58,6 +58,31 @@ func TestContainer_FormatStatus(t *testing.T) {
 			expected: "Stopped (1) 1 minute",
 			err:      nil,
 		},
+		{
+			name: "Restarting",
+			input: &Container{
+				State: &types.ContainerState{
+					Status:     types.StatusRestarting,
+					FinishedAt: time.Now().Add(0 - utils.Minute).UTC().Format(utils.TimeLayout),
+					ExitCode:   1,
+				},
+			},
+			expected: "Restarting (1) 1 minute",
+			err:      nil,
+		},
+		{
+			name: "CrashLooping",
+			input: &Container{
+				State: &types.ContainerState{
+					Status:       types.StatusRestarting,
+					FinishedAt:   time.Now().Add(0 - utils.Minute).UTC().Format(utils.TimeLayout),
+					ExitCode:     1,
+					CrashLooping: true,
+				},
+			},
+			expected: "Restarting (1) 1 minute(crash loop)",
+			err:      nil,
+		},
 		{
 			name: "Running",
 			input: &Container{
@@ -11,12 +11,14 @@ import (
 	"github.com/alibaba/pouch/daemon/logger"
 	"github.com/alibaba/pouch/daemon/logger/jsonfile"
 	"github.com/alibaba/pouch/daemon/logger/syslog"
+	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/system"
 	"github.com/alibaba/pouch/pkg/utils"
 	"github.com/alibaba/pouch/storage/quota"
 
 	"github.com/docker/go-units"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 )
 
@@ -62,6 +64,13 @@ func (mgr *ContainerManager) validateConfig(c *Container, update bool) ([]string
 	}
 	warnings = append(warnings, warns...)
 
+	// validates CDI devices
+	if !update {
+		if err := mgr.validateCDIDevices(hostConfig.Devices); err != nil {
+			return warnings, err
+		}
+	}
+
 	if hostConfig.OomScoreAdj < -1000 || hostConfig.OomScoreAdj > 1000 {
 		return warnings, fmt.Errorf("oom score should be in range [-1000, 1000]")
 	}
@@ -357,6 +366,28 @@ func (mgr *ContainerManager) validateLogConfig(c *Container) error {
 	}
 }
 
+// validateCDIDevices checks the requested CDI devices can be resolved.
+func (mgr *ContainerManager) validateCDIDevices(mappings []*types.DeviceMapping) error {
+	devices := cdiDevices(mappings)
+	if len(devices) == 0 {
+		return nil
+	}
+
+	cache := mgr.cdiCache
+	if cache == nil {
+		cache = newCDICache(mgr.Config.CDISpecDirs)
+	}
+	if err := cache.Refresh(); err != nil {
+		return errors.Wrap(err, "failed to load CDI specs")
+	}
+
+	// inject into an empty spec to check devices are resolvable.
+	if err := cache.InjectDevices(&specs.Spec{}, devices...); err != nil {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "invalid CDI devices: %v", err)
+	}
+	return nil
+}
+
 // validateNvidiaConfig
 func validateNvidiaConfig(r *types.Resources) error {
 	if r.NvidiaConfig == nil {
@@ -102,6 +102,11 @@ func copyAttributes(attributes, labels map[string]string) {
 
 // publishContainerdEvent sends containerd events to pouchd event service.
 func (mgr *ContainerManager) publishContainerdEvent(ctx context.Context, id, action string, attributes map[string]string) error {
+	if c, ok := mgr.buildContainer(id); ok {
+		mgr.LogContainerEventWithAttributes(ctx, c, action, attributes)
+		return nil
+	}
+
 	c, err := mgr.container(id)
 	if err != nil {
 		return err
@@ -119,6 +124,11 @@ func (mgr *ContainerManager) publishContainerdEvent(ctx context.Context, id, act
 
 // updateContainerState update container's state according to the containerd events.
 func (mgr *ContainerManager) updateContainerState(ctx context.Context, id, action string, attributes map[string]string) error {
+	// the build step container has no state in meta store.
+	if _, ok := mgr.buildContainer(id); ok {
+		return nil
+	}
+
 	c, err := mgr.container(id)
 	if err != nil {
 		return err
@@ -145,6 +155,7 @@ func (mgr *ContainerManager) updateContainerState(ctx context.Context, id, actio
 	switch action {
 	case "oom":
 		c.SetStatusOOM()
+		mgr.monitor.PostEvent(ContainerOOMEvent(c).WithHandle(mgr.handleContainerOOM))
 	default:
 		dirty = false
 	}
@@ -157,3 +168,43 @@ func (mgr *ContainerManager) updateContainerState(ctx context.Context, id, actio
 
 	return nil
 }
+
+// handleContainerOOM handles the oom event of container in monitor.
+func (mgr *ContainerManager) handleContainerOOM(c *Container) error {
+	if mgr.metricsCollector != nil {
+		mgr.metricsCollector.IncOOM(c.ID)
+	}
+	return nil
+}
+
+// handleExecExit handles the exit event of exec process in monitor, the
+// exec process exited with non-zero code is counted as a failure.
+func (mgr *ContainerManager) handleExecExit(c *Container, execConfig *ContainerExecConfig) error {
+	execConfig.Lock()
+	exitCode := execConfig.ExitCode
+	execConfig.Unlock()
+
+	if exitCode != 0 && mgr.metricsCollector != nil {
+		mgr.metricsCollector.IncExecFailure(c.ID)
+	}
+	return nil
+}
+
+// postHealthChange posts the change of container's health status to
+// monitor, so that it's handled in order with the other events of
+// container.
+//
+// NOTE: pouchd doesn't probe the health of container yet, the event is
+// reserved for the health check.
+func (mgr *ContainerManager) postHealthChange(c *Container, health string) {
+	mgr.monitor.PostEvent(ContainerHealthChangeEvent(c, health).WithHandle(func(c *Container) error {
+		return mgr.handleContainerHealthChange(c, health)
+	}))
+}
+
+// handleContainerHealthChange handles the change of container's health
+// status in monitor, it publishes the health_status event.
+func (mgr *ContainerManager) handleContainerHealthChange(c *Container, health string) error {
+	mgr.LogContainerEvent(context.Background(), c, "health_status: "+health)
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/alibaba/pouch/pkg/jsonstream"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/reference"
+	"github.com/alibaba/pouch/pkg/trace"
 	"github.com/alibaba/pouch/pkg/utils"
 	searchtypes "github.com/alibaba/pouch/registry/types"
 
@@ -190,7 +191,12 @@ func (mgr *ImageManager) LookupImageReferences(ref string) []string {
 }
 
 // PullImage pulls images from specified registry.
-func (mgr *ImageManager) PullImage(ctx context.Context, ref string, authConfig *types.AuthConfig, out io.Writer) error {
+func (mgr *ImageManager) PullImage(ctx context.Context, ref string, authConfig *types.AuthConfig, out io.Writer) (err error) {
+	ctx, span := trace.StartSpan(ctx, "ImageManager.PullImage", trace.WithAttribute("image.ref", ref))
+	defer func() {
+		span.Finish(err)
+	}()
+
 	namedRef, err := reference.Parse(ref)
 	if err != nil {
 		return err
@@ -652,6 +658,11 @@ func (mgr *ImageManager) ImageHistory(ctx context.Context, idOrRef string) ([]ty
 
 // CheckReference returns image ID and actual reference.
 func (mgr *ImageManager) CheckReference(ctx context.Context, idOrRef string) (actualID digest.Digest, actualRef reference.Named, primaryRef reference.Named, err error) {
+	ctx, span := trace.StartSpan(ctx, "ImageManager.CheckReference", trace.WithAttribute("image.ref", idOrRef))
+	defer func() {
+		span.Finish(err)
+	}()
+
 	var namedRef reference.Named
 
 	namedRef, err = reference.Parse(idOrRef)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"path"
 	"strconv"
 	"strings"
 
@@ -17,6 +16,7 @@ import (
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/meta"
 	"github.com/alibaba/pouch/pkg/randomid"
+	"github.com/alibaba/pouch/pkg/trace"
 	"github.com/alibaba/pouch/pkg/utils"
 
 	"github.com/docker/go-connections/nat"
@@ -73,7 +73,7 @@ type NetworkManager struct {
 func NewNetworkManager(cfg *config.Config, store *meta.Store, ctrMgr ContainerMgr, eventsService *events.Events) (*NetworkManager, error) {
 	// Create a new controller instance
 	if cfg.NetworkConfig.MetaPath == "" {
-		cfg.NetworkConfig.MetaPath = path.Dir(store.BaseDir)
+		cfg.NetworkConfig.MetaPath = cfg.HomeDir
 	}
 
 	if cfg.NetworkConfig.ExecRoot == "" {
@@ -276,6 +276,16 @@ func isNoSuchNetworkError(err error) bool {
 
 // EndpointCreate is used to create network endpoint.
 func (nm *NetworkManager) EndpointCreate(ctx context.Context, endpoint *types.Endpoint) (string, error) {
+	ctx, span := trace.StartSpan(ctx, "NetworkManager.EndpointCreate",
+		trace.WithAttribute("container.id", endpoint.Owner),
+		trace.WithAttribute("network.name", endpoint.Name),
+	)
+	endpointName, err := nm.endpointCreate(ctx, endpoint)
+	span.Finish(err)
+	return endpointName, err
+}
+
+func (nm *NetworkManager) endpointCreate(ctx context.Context, endpoint *types.Endpoint) (string, error) {
 	containerID := endpoint.Owner
 	network := endpoint.Name
 	networkConfig := endpoint.NetworkConfig
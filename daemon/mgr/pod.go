@@ -0,0 +1,675 @@
+package mgr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/daemon/events"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/pkg/randomid"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+)
+
+const (
+	// PodIDLabel is the label of the pod which the container belongs to.
+	PodIDLabel = "pouch.pod.id"
+	// PodInfraLabel is the label to mark the infra container of pod.
+	PodInfraLabel = "pouch.pod.infra"
+)
+
+// PodMgr defines interface to manage pods, a pod is a group of containers
+// sharing the namespaces held by an infra container.
+type PodMgr interface {
+	// Create creates a pod with its infra container.
+	Create(ctx context.Context, config *types.PodCreateConfig) (*types.PodCreateResp, error)
+
+	// Get returns the information of pod by name or ID.
+	Get(ctx context.Context, name string) (*types.PodInfo, error)
+
+	// List returns all pods.
+	List(ctx context.Context) ([]*types.PodInfo, error)
+
+	// Start starts the infra container and then the containers in pod.
+	Start(ctx context.Context, name string) error
+
+	// Stop stops the containers in pod and then the infra container.
+	Stop(ctx context.Context, name string, timeout int64) error
+
+	// Remove removes the pod with its infra container.
+	Remove(ctx context.Context, name string, force bool) error
+
+	// JoinPod sets the namespaces and cgroup parent of pod into the config of
+	// the container which is going to be created in pod.
+	JoinPod(ctx context.Context, config *types.ContainerCreateConfig) error
+
+	// Dump writes the metadata of all pods into w.
+	Dump(ctx context.Context, w io.Writer) error
+}
+
+// Pod is the metadata of pod.
+type Pod struct {
+	ID               string            `json:"ID"`
+	Name             string            `json:"Name"`
+	Created          string            `json:"Created"`
+	Labels           map[string]string `json:"Labels,omitempty"`
+	Hostname         string            `json:"Hostname,omitempty"`
+	InfraImage       string            `json:"InfraImage"`
+	InfraContainerID string            `json:"InfraContainerID"`
+	CgroupParent     string            `json:"CgroupParent"`
+	SharePID         bool              `json:"SharePID,omitempty"`
+	NetworkMode      string            `json:"NetworkMode"`
+	PortBindings     types.PortMap     `json:"PortBindings,omitempty"`
+}
+
+// Key returns the key of pod in meta store.
+func (p *Pod) Key() string {
+	return p.ID
+}
+
+// PodManager is the default implement of interface PodMgr.
+type PodManager struct {
+	// lock serializes the operations of pods.
+	lock sync.Mutex
+	pods map[string]*Pod
+
+	store         *meta.Store
+	config        *config.Config
+	ctrMgr        ContainerMgr
+	imgMgr        ImageMgr
+	eventsService *events.Events
+}
+
+// PodMetaConfig returns the config of the meta store of pods.
+func PodMetaConfig(homeDir string) meta.Config {
+	return meta.Config{
+		Driver:  "local",
+		BaseDir: path.Join(homeDir, "pods"),
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(Pod{}),
+			},
+		},
+	}
+}
+
+// NewPodManager creates a brand new pod manager, and loads the pods from
+// meta store.
+func NewPodManager(ctx context.Context, cfg *config.Config, ctrMgr ContainerMgr, imgMgr ImageMgr, eventsService *events.Events) (*PodManager, error) {
+	store, err := meta.NewStore(PodMetaConfig(cfg.HomeDir))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create pod meta store")
+	}
+
+	pm := &PodManager{
+		pods:          make(map[string]*Pod),
+		store:         store,
+		config:        cfg,
+		ctrMgr:        ctrMgr,
+		imgMgr:        imgMgr,
+		eventsService: eventsService,
+	}
+
+	if err := store.ForEach(func(obj meta.Object) error {
+		pod, ok := obj.(*Pod)
+		if !ok {
+			return fmt.Errorf("failed to get pod object from meta store")
+		}
+		pm.pods[pod.ID] = pod
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to load pods")
+	}
+
+	go pm.watchInfraContainers(ctx)
+
+	return pm, nil
+}
+
+// Create creates a pod with its infra container.
+func (pm *PodManager) Create(ctx context.Context, podConfig *types.PodCreateConfig) (*types.PodCreateResp, error) {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	id := randomid.Generate()
+	name := podConfig.Name
+	if name == "" {
+		name = "pod-" + utils.TruncateID(id)
+	} else if !config.ValidNamePattern.MatchString(name) {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid pod name (%s), only %s are allowed", name, config.ValidNameChars)
+	}
+
+	for _, p := range pm.pods {
+		if p.Name == name {
+			return nil, errors.Wrapf(errtypes.ErrAlreadyExisted, "pod name %s", name)
+		}
+	}
+
+	pod := &Pod{
+		ID:           id,
+		Name:         name,
+		Created:      time.Now().UTC().Format(utils.TimeLayout),
+		Labels:       podConfig.Labels,
+		Hostname:     podConfig.Hostname,
+		InfraImage:   podConfig.InfraImage,
+		CgroupParent: podConfig.CgroupParent,
+		SharePID:     podConfig.SharePID,
+		NetworkMode:  podConfig.NetworkMode,
+		PortBindings: podConfig.PortBindings,
+	}
+	if pod.Hostname == "" {
+		pod.Hostname = name
+	}
+	if pod.InfraImage == "" {
+		pod.InfraImage = pm.config.CriConfig.SandboxImage
+	}
+	if pod.CgroupParent == "" {
+		pod.CgroupParent = podCgroupParent(pm.config.CgroupParent, id, pm.config.UseSystemd())
+	}
+	if pod.NetworkMode == "" {
+		pod.NetworkMode = "bridge"
+	}
+	if IsContainer(pod.NetworkMode) {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "pod can't join the network of container")
+	}
+
+	if err := pm.ensureInfraImage(ctx, pod.InfraImage); err != nil {
+		return nil, err
+	}
+
+	resp, err := pm.ctrMgr.Create(ctx, name+"-infra", pm.infraContainerConfig(pod))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create infra container of pod %s", name)
+	}
+	pod.InfraContainerID = resp.ID
+
+	if err := pm.store.Put(pod); err != nil {
+		if rerr := pm.ctrMgr.Remove(ctx, resp.ID, &types.ContainerRemoveOptions{Force: true}); rerr != nil {
+			log.With(ctx).Errorf("failed to remove infra container %s: %v", resp.ID, rerr)
+		}
+		return nil, errors.Wrapf(err, "failed to save pod %s", name)
+	}
+	pm.pods[id] = pod
+
+	log.With(ctx).Infof("pod %s (%s) created with infra container %s", name, id, resp.ID)
+
+	return &types.PodCreateResp{ID: id, Name: name}, nil
+}
+
+// ensureInfraImage pulls the infra image if it doesn't exist.
+func (pm *PodManager) ensureInfraImage(ctx context.Context, image string) error {
+	_, _, _, err := pm.imgMgr.CheckReference(ctx, image)
+	if err == nil {
+		return nil
+	}
+
+	if !errtypes.IsNotfound(err) {
+		return errors.Wrapf(err, "failed to check infra image %s", image)
+	}
+
+	if err := pm.imgMgr.PullImage(ctx, image, nil, bytes.NewBuffer([]byte{})); err != nil {
+		return errors.Wrapf(err, "failed to pull infra image %s", image)
+	}
+	return nil
+}
+
+// infraContainerConfig returns the config of the infra container, which holds
+// the network, port mappings and restarts always.
+func (pm *PodManager) infraContainerConfig(pod *Pod) *types.ContainerCreateConfig {
+	exposedPorts := make(map[string]interface{}, len(pod.PortBindings))
+	for port := range pod.PortBindings {
+		exposedPorts[port] = struct{}{}
+	}
+
+	return &types.ContainerCreateConfig{
+		ContainerConfig: types.ContainerConfig{
+			Image:        pod.InfraImage,
+			Hostname:     strfmt.Hostname(pod.Hostname),
+			ExposedPorts: exposedPorts,
+			Labels: map[string]string{
+				PodIDLabel:    pod.ID,
+				PodInfraLabel: "true",
+			},
+		},
+		HostConfig: &types.HostConfig{
+			NetworkMode:   pod.NetworkMode,
+			PortBindings:  pod.PortBindings,
+			CgroupParent:  pod.CgroupParent,
+			RestartPolicy: &types.RestartPolicy{Name: "always"},
+		},
+		NetworkingConfig: &types.NetworkingConfig{},
+	}
+}
+
+// podCgroupParent returns the default cgroup parent of pod, which is under
+// the cgroup parent of daemon.
+func podCgroupParent(parent, id string, useSystemd bool) string {
+	if useSystemd {
+		// systemd expands it into pouchpod.slice/pouchpod-<id>.slice
+		return "pouchpod-" + id + ".slice"
+	}
+
+	if parent == "" {
+		parent = "/default"
+	}
+	return filepath.Clean(filepath.Join("/", parent, "pod-"+id))
+}
+
+// Get returns the information of pod by name or ID.
+func (pm *PodManager) Get(ctx context.Context, name string) (*types.PodInfo, error) {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	pod, err := pm.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return pm.podInfo(ctx, pod)
+}
+
+// List returns all pods sorted by name.
+func (pm *PodManager) List(ctx context.Context) ([]*types.PodInfo, error) {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	infos := make([]*types.PodInfo, 0, len(pm.pods))
+	for _, pod := range pm.pods {
+		info, err := pm.podInfo(ctx, pod)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos, nil
+}
+
+// podInfo converts the pod into its information with the states of
+// containers.
+func (pm *PodManager) podInfo(ctx context.Context, pod *Pod) (*types.PodInfo, error) {
+	info := &types.PodInfo{
+		ID:               pod.ID,
+		Name:             pod.Name,
+		Created:          pod.Created,
+		Labels:           pod.Labels,
+		Hostname:         pod.Hostname,
+		InfraImage:       pod.InfraImage,
+		InfraContainerID: pod.InfraContainerID,
+		CgroupParent:     pod.CgroupParent,
+		SharePID:         pod.SharePID,
+		NetworkMode:      pod.NetworkMode,
+		PortBindings:     pod.PortBindings,
+		Containers:       []*types.PodContainer{},
+		State:            "missing",
+	}
+
+	if infra, err := pm.ctrMgr.Get(ctx, pod.InfraContainerID); err == nil {
+		info.State = string(infra.State.Status)
+	} else if !errtypes.IsNotfound(err) {
+		return nil, err
+	}
+
+	members, err := pm.members(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range members {
+		info.Containers = append(info.Containers, &types.PodContainer{
+			ID:    c.ID,
+			Name:  c.Name,
+			State: string(c.State.Status),
+		})
+	}
+	return info, nil
+}
+
+// Start starts the infra container and then the containers in pod.
+func (pm *PodManager) Start(ctx context.Context, name string) error {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	pod, err := pm.lookup(name)
+	if err != nil {
+		return err
+	}
+
+	infra, err := pm.ctrMgr.Get(ctx, pod.InfraContainerID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get infra container of pod %s", pod.Name)
+	}
+
+	if !infra.IsRunningOrPaused() {
+		if err := pm.ctrMgr.Start(ctx, infra.ID, &types.ContainerStartOptions{}); err != nil {
+			return errors.Wrapf(err, "failed to start infra container of pod %s", pod.Name)
+		}
+	}
+
+	members, err := pm.members(ctx, pod)
+	if err != nil {
+		return err
+	}
+
+	var errMsgs []string
+	for _, c := range members {
+		if c.IsRunningOrPaused() {
+			continue
+		}
+		if err := pm.ctrMgr.Start(ctx, c.ID, &types.ContainerStartOptions{}); err != nil {
+			errMsgs = append(errMsgs, fmt.Sprintf("%s: %v", c.Name, err))
+		}
+	}
+
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("failed to start containers of pod %s: %s", pod.Name, strings.Join(errMsgs, "; "))
+	}
+	return nil
+}
+
+// Stop stops the containers in pod and then the infra container.
+func (pm *PodManager) Stop(ctx context.Context, name string, timeout int64) error {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	pod, err := pm.lookup(name)
+	if err != nil {
+		return err
+	}
+	return pm.stop(ctx, pod, timeout)
+}
+
+func (pm *PodManager) stop(ctx context.Context, pod *Pod, timeout int64) error {
+	members, err := pm.members(ctx, pod)
+	if err != nil {
+		return err
+	}
+
+	var (
+		wg      sync.WaitGroup
+		errLock sync.Mutex
+		errMsgs []string
+	)
+	for _, c := range members {
+		if !c.IsRunningOrPaused() {
+			continue
+		}
+
+		wg.Add(1)
+		go func(c *Container) {
+			defer wg.Done()
+
+			if err := pm.ctrMgr.Stop(ctx, c.ID, timeout); err != nil {
+				errLock.Lock()
+				errMsgs = append(errMsgs, fmt.Sprintf("%s: %v", c.Name, err))
+				errLock.Unlock()
+			}
+		}(c)
+	}
+	wg.Wait()
+
+	if len(errMsgs) > 0 {
+		sort.Strings(errMsgs)
+		return fmt.Errorf("failed to stop containers of pod %s: %s", pod.Name, strings.Join(errMsgs, "; "))
+	}
+
+	infra, err := pm.ctrMgr.Get(ctx, pod.InfraContainerID)
+	if err != nil {
+		if errtypes.IsNotfound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if infra.IsRunningOrPaused() {
+		if err := pm.ctrMgr.Stop(ctx, infra.ID, timeout); err != nil {
+			return errors.Wrapf(err, "failed to stop infra container of pod %s", pod.Name)
+		}
+	}
+	return nil
+}
+
+// Remove removes the pod with its infra container, the containers in pod
+// are removed as well if force is set.
+func (pm *PodManager) Remove(ctx context.Context, name string, force bool) error {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	pod, err := pm.lookup(name)
+	if err != nil {
+		return err
+	}
+
+	members, err := pm.members(ctx, pod)
+	if err != nil {
+		return err
+	}
+
+	infra, err := pm.ctrMgr.Get(ctx, pod.InfraContainerID)
+	if err != nil && !errtypes.IsNotfound(err) {
+		return err
+	}
+
+	if !force {
+		if len(members) > 0 {
+			return errors.Wrapf(errtypes.ErrConflict, "pod %s has %d containers, remove them first or use force", pod.Name, len(members))
+		}
+		if infra != nil && infra.IsRunningOrPaused() {
+			return errors.Wrapf(errtypes.ErrConflict, "pod %s is running, stop it first or use force", pod.Name)
+		}
+	}
+
+	for _, c := range members {
+		if err := pm.ctrMgr.Remove(ctx, c.ID, &types.ContainerRemoveOptions{Force: true}); err != nil {
+			return errors.Wrapf(err, "failed to remove container %s of pod %s", c.Name, pod.Name)
+		}
+	}
+
+	if infra != nil {
+		if err := pm.ctrMgr.Remove(ctx, infra.ID, &types.ContainerRemoveOptions{Force: true}); err != nil {
+			return errors.Wrapf(err, "failed to remove infra container of pod %s", pod.Name)
+		}
+	}
+
+	if err := pm.store.Remove(pod.ID); err != nil {
+		return errors.Wrapf(err, "failed to remove meta of pod %s", pod.Name)
+	}
+	delete(pm.pods, pod.ID)
+
+	log.With(ctx).Infof("pod %s (%s) removed", pod.Name, pod.ID)
+	return nil
+}
+
+// JoinPod sets the namespaces and cgroup parent of pod into the config of
+// the container which is going to be created in pod.
+func (pm *PodManager) JoinPod(ctx context.Context, config *types.ContainerCreateConfig) error {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	hostConfig := config.HostConfig
+	pod, err := pm.lookup(hostConfig.Pod)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case hostConfig.NetworkMode != "" ||
+		(config.NetworkingConfig != nil && len(config.NetworkingConfig.EndpointsConfig) > 0):
+		return errors.Wrapf(errtypes.ErrInvalidParam, "container in pod can't set network, it shares the network of pod")
+	case hostConfig.IpcMode != "":
+		return errors.Wrapf(errtypes.ErrInvalidParam, "container in pod can't set ipc mode, it shares the ipc namespace of pod")
+	case hostConfig.PidMode != "" && pod.SharePID:
+		return errors.Wrapf(errtypes.ErrInvalidParam, "container in pod can't set pid mode, it shares the pid namespace of pod")
+	case len(hostConfig.PortBindings) > 0 || hostConfig.PublishAllPorts:
+		return errors.Wrapf(errtypes.ErrInvalidParam, "container in pod can't publish ports, set the port mappings of pod instead")
+	case hostConfig.CgroupParent != "":
+		return errors.Wrapf(errtypes.ErrInvalidParam, "container in pod can't set cgroup parent, it is placed under the cgroup parent of pod")
+	}
+
+	infraMode := "container:" + pod.InfraContainerID
+	hostConfig.Pod = pod.ID
+	hostConfig.NetworkMode = infraMode
+	hostConfig.IpcMode = infraMode
+	if pod.SharePID {
+		hostConfig.PidMode = infraMode
+	}
+	hostConfig.CgroupParent = pod.CgroupParent
+
+	if config.Hostname.String() == "" {
+		config.Hostname = strfmt.Hostname(pod.Hostname)
+	}
+
+	if config.Labels == nil {
+		config.Labels = make(map[string]string)
+	}
+	config.Labels[PodIDLabel] = pod.ID
+
+	return nil
+}
+
+// Dump writes the metadata of all pods into w.
+func (pm *PodManager) Dump(ctx context.Context, w io.Writer) error {
+	return pm.store.Dump(w)
+}
+
+// lookup finds the pod by name, ID or the prefix of ID, it must be called
+// with lock held.
+func (pm *PodManager) lookup(name string) (*Pod, error) {
+	if name == "" {
+		return nil, errors.Wrap(errtypes.ErrInvalidParam, "pod name cannot be empty")
+	}
+
+	if pod, ok := pm.pods[name]; ok {
+		return pod, nil
+	}
+
+	var matched []*Pod
+	for _, pod := range pm.pods {
+		if pod.Name == name {
+			return pod, nil
+		}
+		if strings.HasPrefix(pod.ID, name) {
+			matched = append(matched, pod)
+		}
+	}
+
+	switch len(matched) {
+	case 0:
+		return nil, errors.Wrapf(errtypes.ErrNotfound, "pod %s", name)
+	case 1:
+		return matched[0], nil
+	default:
+		return nil, errors.Wrapf(errtypes.ErrTooMany, "pod %s", name)
+	}
+}
+
+// members returns the containers in pod, except the infra container.
+func (pm *PodManager) members(ctx context.Context, pod *Pod) ([]*Container, error) {
+	containers, err := pm.ctrMgr.List(ctx, &ContainerListOption{
+		All: true,
+		FilterFunc: func(c *Container) bool {
+			return c.HostConfig != nil && c.HostConfig.Pod == pod.ID
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list containers of pod %s", pod.Name)
+	}
+
+	sort.Slice(containers, func(i, j int) bool {
+		return containers[i].Name < containers[j].Name
+	})
+	return containers, nil
+}
+
+// watchInfraContainers restarts the running containers in pod when their
+// infra container restarts, since they are left in the namespaces of the
+// dead infra process and have to join the new ones. The infra container
+// restarted by restart policy only logs the restart event.
+func (pm *PodManager) watchInfraContainers(ctx context.Context) {
+	ef := events.NewFilter(filters.NewArgs(
+		filters.Arg("type", string(types.EventTypeContainer)),
+		filters.Arg("event", "start"),
+		filters.Arg("event", "restart"),
+	))
+	_, evch, errch := pm.eventsService.Subscribe(ctx, time.Time{}, time.Time{}, ef)
+
+	for {
+		select {
+		case ev := <-evch:
+			if ev.Actor == nil || ev.Actor.Attributes[PodInfraLabel] != "true" {
+				continue
+			}
+			pm.restartStaleMembers(ctx, ev.Actor.Attributes[PodIDLabel])
+		case err := <-errch:
+			if err != nil {
+				log.With(ctx).Errorf("stop watching infra containers of pods: %v", err)
+			}
+			return
+		}
+	}
+}
+
+// restartStaleMembers restarts the running containers in pod which are
+// started before the infra container.
+func (pm *PodManager) restartStaleMembers(ctx context.Context, id string) {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	pod, ok := pm.pods[id]
+	if !ok {
+		return
+	}
+
+	infra, err := pm.ctrMgr.Get(ctx, pod.InfraContainerID)
+	if err != nil || !infra.IsRunning() {
+		return
+	}
+
+	members, err := pm.members(ctx, pod)
+	if err != nil {
+		log.With(ctx).Errorf("failed to restart containers of pod %s: %v", pod.Name, err)
+		return
+	}
+
+	for _, c := range members {
+		if !c.IsRunningOrPaused() || !startedBefore(c.State.StartedAt, infra.State.StartedAt) {
+			continue
+		}
+
+		log.With(ctx).Infof("restart container %s since the infra container of pod %s restarted", c.ID, pod.Name)
+		if err := pm.ctrMgr.Restart(ctx, c.ID, c.StopTimeout()); err != nil {
+			log.With(ctx).Errorf("failed to restart container %s of pod %s: %v", c.ID, pod.Name, err)
+		}
+	}
+}
+
+// startedBefore returns true if the start time a is before b.
+func startedBefore(a, b string) bool {
+	ta, err := time.Parse(utils.TimeLayout, a)
+	if err != nil {
+		return false
+	}
+	tb, err := time.Parse(utils.TimeLayout, b)
+	if err != nil {
+		return false
+	}
+	return ta.Before(tb)
+}
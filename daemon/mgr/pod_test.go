@@ -0,0 +1,138 @@
+package mgr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/events"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodCgroupParent(t *testing.T) {
+	assert.Equal(t, "/default/pod-abc", podCgroupParent("", "abc", false))
+	assert.Equal(t, "/pouch/pod-abc", podCgroupParent("pouch", "abc", false))
+	assert.Equal(t, "pouchpod-abc.slice", podCgroupParent("pouch", "abc", true))
+}
+
+func TestPodManagerLookup(t *testing.T) {
+	pm := &PodManager{
+		pods: map[string]*Pod{
+			"abc123": {ID: "abc123", Name: "web"},
+			"abd456": {ID: "abd456", Name: "db"},
+		},
+	}
+
+	for _, name := range []string{"abc123", "web", "abc"} {
+		pod, err := pm.lookup(name)
+		assert.NoError(t, err, name)
+		assert.Equal(t, "abc123", pod.ID, name)
+	}
+
+	_, err := pm.lookup("ab")
+	assert.Error(t, err)
+
+	_, err = pm.lookup("cache")
+	assert.True(t, errtypes.IsNotfound(err))
+}
+
+func TestPodManagerJoinPod(t *testing.T) {
+	pm := &PodManager{
+		pods: map[string]*Pod{
+			"abc123": {
+				ID:               "abc123",
+				Name:             "web",
+				Hostname:         "web",
+				InfraContainerID: "infra",
+				CgroupParent:     "/default/pod-abc123",
+				SharePID:         true,
+			},
+		},
+	}
+
+	config := &types.ContainerCreateConfig{
+		HostConfig:       &types.HostConfig{Pod: "web"},
+		NetworkingConfig: &types.NetworkingConfig{},
+	}
+	assert.NoError(t, pm.JoinPod(context.Background(), config))
+	assert.Equal(t, "abc123", config.HostConfig.Pod)
+	assert.Equal(t, "container:infra", config.HostConfig.NetworkMode)
+	assert.Equal(t, "container:infra", config.HostConfig.IpcMode)
+	assert.Equal(t, "container:infra", config.HostConfig.PidMode)
+	assert.Equal(t, "/default/pod-abc123", config.HostConfig.CgroupParent)
+	assert.Equal(t, "web", config.Hostname.String())
+	assert.Equal(t, "abc123", config.Labels[PodIDLabel])
+
+	for _, hostConfig := range []*types.HostConfig{
+		{Pod: "web", NetworkMode: "bridge"},
+		{Pod: "web", IpcMode: "host"},
+		{Pod: "web", PidMode: "host"},
+		{Pod: "web", PublishAllPorts: true},
+		{Pod: "web", PortBindings: types.PortMap{"80/tcp": nil}},
+		{Pod: "web", CgroupParent: "/custom"},
+	} {
+		err := pm.JoinPod(context.Background(), &types.ContainerCreateConfig{
+			HostConfig:       hostConfig,
+			NetworkingConfig: &types.NetworkingConfig{},
+		})
+		assert.True(t, errtypes.IsInvalidParam(err), "%+v", hostConfig)
+	}
+}
+
+// infraContainerMgr records the containers got by pod manager.
+type infraContainerMgr struct {
+	ContainerMgr
+	got chan string
+}
+
+func (mgr *infraContainerMgr) Get(ctx context.Context, name string) (*Container, error) {
+	mgr.got <- name
+	return nil, errtypes.ErrNotfound
+}
+
+func TestPodManagerWatchInfraContainers(t *testing.T) {
+	for _, action := range []string{"start", "restart"} {
+		ctrMgr := &infraContainerMgr{got: make(chan string, 1)}
+		pm := &PodManager{
+			pods: map[string]*Pod{
+				"abc123": {ID: "abc123", Name: "web", InfraContainerID: "infra"},
+			},
+			ctrMgr:        ctrMgr,
+			eventsService: events.NewEvents(),
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go pm.watchInfraContainers(ctx)
+
+		actor := &types.EventsActor{
+			ID: "infra",
+			Attributes: map[string]string{
+				PodIDLabel:    "abc123",
+				PodInfraLabel: "true",
+			},
+		}
+
+		// the event is published until it's received, since the watcher
+		// subscribes in background.
+		ticker := time.NewTicker(10 * time.Millisecond)
+		timeout := time.After(5 * time.Second)
+	loop:
+		for {
+			select {
+			case name := <-ctrMgr.got:
+				assert.Equal(t, "infra", name, action)
+				break loop
+			case <-ticker.C:
+				pm.eventsService.Publish(ctx, action, types.EventTypeContainer, actor)
+			case <-timeout:
+				t.Errorf("members of pod are not restarted on %s event of infra container", action)
+				break loop
+			}
+		}
+		ticker.Stop()
+		cancel()
+	}
+}
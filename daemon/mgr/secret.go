@@ -0,0 +1,397 @@
+package mgr
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/pkg/randomid"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// secretKeySize is the size of AES-256 key to encrypt secrets.
+	secretKeySize = 32
+
+	// maxSecretSize is the max size of secret value.
+	maxSecretSize = 500 * 1024
+)
+
+// SecretMgr defines interface to manage secrets.
+type SecretMgr interface {
+	// Create creates a secret, the value of secret is encrypted before stored.
+	Create(ctx context.Context, config *types.SecretCreateConfig) (*types.SecretCreateResp, error)
+
+	// Get returns the information of secret by name or ID, without value.
+	Get(ctx context.Context, name string) (*types.SecretInfo, error)
+
+	// List returns all secrets without value.
+	List(ctx context.Context) ([]*types.SecretInfo, error)
+
+	// Remove removes the secret which is not used by any container.
+	Remove(ctx context.Context, name string) error
+
+	// Value returns the information and the decrypted value of secret, it
+	// is only used inside daemon to mount the secret into container.
+	Value(ctx context.Context, name string) (*types.SecretInfo, []byte, error)
+
+	// Dump writes the metadata of all secrets into w, the values are kept
+	// encrypted.
+	Dump(ctx context.Context, w io.Writer) error
+}
+
+// Secret is the metadata of secret, the value is encrypted.
+type Secret struct {
+	ID        string            `json:"ID"`
+	Name      string            `json:"Name"`
+	Labels    map[string]string `json:"Labels,omitempty"`
+	CreatedAt string            `json:"CreatedAt"`
+	Size      int64             `json:"Size"`
+
+	// Data is the value of secret encrypted by AES-GCM with the key of
+	// daemon, the nonce is prepended.
+	Data []byte `json:"Data"`
+}
+
+// Key returns the key of secret in meta store.
+func (s *Secret) Key() string {
+	return s.ID
+}
+
+func (s *Secret) info() *types.SecretInfo {
+	return &types.SecretInfo{
+		ID:        s.ID,
+		Name:      s.Name,
+		Labels:    s.Labels,
+		CreatedAt: s.CreatedAt,
+		Size:      s.Size,
+	}
+}
+
+// SecretManager is the default implement of interface SecretMgr.
+type SecretManager struct {
+	lock    sync.Mutex
+	secrets map[string]*Secret
+
+	store  *meta.Store
+	aead   cipher.AEAD
+	ctrMgr ContainerMgr
+}
+
+// SecretMetaConfig returns the config of the meta store of secrets.
+func SecretMetaConfig(homeDir string) meta.Config {
+	return meta.Config{
+		Driver:  "local",
+		BaseDir: path.Join(homeDir, "secrets"),
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(Secret{}),
+			},
+		},
+	}
+}
+
+// SecretKeyFile returns the key file to encrypt secrets, which is
+// <home-dir>/secrets/secret.key by default.
+func SecretKeyFile(cfg *config.Config) string {
+	if cfg.SecretKeyFile != "" {
+		return cfg.SecretKeyFile
+	}
+	return path.Join(cfg.HomeDir, "secrets", "secret.key")
+}
+
+// NewSecretManager creates a brand new secret manager, and loads the
+// secrets from meta store.
+func NewSecretManager(cfg *config.Config, ctrMgr ContainerMgr) (*SecretManager, error) {
+	key, err := loadSecretKey(SecretKeyFile(cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newSecretAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := meta.NewStore(SecretMetaConfig(cfg.HomeDir))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create secret meta store")
+	}
+
+	sm := &SecretManager{
+		secrets: make(map[string]*Secret),
+		store:   store,
+		aead:    aead,
+		ctrMgr:  ctrMgr,
+	}
+
+	if err := store.ForEach(func(obj meta.Object) error {
+		secret, ok := obj.(*Secret)
+		if !ok {
+			return fmt.Errorf("failed to get secret object from meta store")
+		}
+		sm.secrets[secret.ID] = secret
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to load secrets")
+	}
+
+	return sm, nil
+}
+
+// loadSecretKey loads the key from file, and generates one if the file
+// doesn't exist.
+func loadSecretKey(file string) ([]byte, error) {
+	key, err := ioutil.ReadFile(file)
+	if err == nil {
+		if len(key) != secretKeySize {
+			return nil, fmt.Errorf("invalid secret key file %s: the key must be %d bytes", file, secretKeySize)
+		}
+		return key, nil
+	}
+
+	if !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "failed to read secret key file %s", file)
+	}
+
+	key = make([]byte, secretKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, errors.Wrap(err, "failed to generate secret key")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(file, key, 0600); err != nil {
+		return nil, errors.Wrapf(err, "failed to write secret key file %s", file)
+	}
+
+	log.With(nil).Infof("secret key file %s is generated", file)
+	return key, nil
+}
+
+func newSecretAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encrypt encrypts the value of secret, the ID of secret is the additional
+// data so that the encrypted value can't be moved to another secret.
+func (sm *SecretManager) encrypt(id string, value []byte) ([]byte, error) {
+	nonce := make([]byte, sm.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return sm.aead.Seal(nonce, nonce, value, []byte(id)), nil
+}
+
+// decrypt decrypts the value of secret.
+func (sm *SecretManager) decrypt(id string, data []byte) ([]byte, error) {
+	size := sm.aead.NonceSize()
+	if len(data) < size {
+		return nil, fmt.Errorf("invalid encrypted value of secret %s", id)
+	}
+
+	value, err := sm.aead.Open(nil, data[:size], data[size:], []byte(id))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt secret %s, the secret key may be changed", id)
+	}
+	return value, nil
+}
+
+// Create creates a secret, the value of secret is encrypted before stored.
+func (sm *SecretManager) Create(ctx context.Context, secretConfig *types.SecretCreateConfig) (*types.SecretCreateResp, error) {
+	name := secretConfig.Name
+	if !config.ValidNamePattern.MatchString(name) {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid secret name (%s), only %s are allowed", name, config.ValidNameChars)
+	}
+
+	if len(secretConfig.Data) == 0 {
+		return nil, errors.Wrap(errtypes.ErrInvalidParam, "secret value cannot be empty")
+	}
+	if len(secretConfig.Data) > maxSecretSize {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "secret value is larger than %d bytes", maxSecretSize)
+	}
+
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+
+	for _, s := range sm.secrets {
+		if s.Name == name {
+			return nil, errors.Wrapf(errtypes.ErrAlreadyExisted, "secret name %s", name)
+		}
+	}
+
+	id := randomid.Generate()
+	data, err := sm.encrypt(id, secretConfig.Data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encrypt secret %s", name)
+	}
+
+	secret := &Secret{
+		ID:        id,
+		Name:      name,
+		Labels:    secretConfig.Labels,
+		CreatedAt: time.Now().UTC().Format(utils.TimeLayout),
+		Size:      int64(len(secretConfig.Data)),
+		Data:      data,
+	}
+	if err := sm.store.Put(secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to save secret %s", name)
+	}
+	sm.secrets[id] = secret
+
+	log.With(ctx).Infof("secret %s (%s) created", name, id)
+
+	return &types.SecretCreateResp{ID: id, Name: name}, nil
+}
+
+// Get returns the information of secret by name or ID, without value.
+func (sm *SecretManager) Get(ctx context.Context, name string) (*types.SecretInfo, error) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+
+	secret, err := sm.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return secret.info(), nil
+}
+
+// List returns all secrets without value, sorted by name.
+func (sm *SecretManager) List(ctx context.Context) ([]*types.SecretInfo, error) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+
+	infos := make([]*types.SecretInfo, 0, len(sm.secrets))
+	for _, secret := range sm.secrets {
+		infos = append(infos, secret.info())
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos, nil
+}
+
+// Remove removes the secret which is not used by any container.
+func (sm *SecretManager) Remove(ctx context.Context, name string) error {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+
+	secret, err := sm.lookup(name)
+	if err != nil {
+		return err
+	}
+
+	containers, err := sm.ctrMgr.List(ctx, &ContainerListOption{
+		All: true,
+		FilterFunc: func(c *Container) bool {
+			if c.HostConfig == nil {
+				return false
+			}
+			for _, ref := range c.HostConfig.Secrets {
+				if ref != nil && ref.Name == secret.Name {
+					return true
+				}
+			}
+			return false
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(containers) > 0 {
+		names := make([]string, 0, len(containers))
+		for _, c := range containers {
+			names = append(names, c.Name)
+		}
+		sort.Strings(names)
+		return errors.Wrapf(errtypes.ErrInUse, "secret %s is used by containers %s", secret.Name, strings.Join(names, ", "))
+	}
+
+	if err := sm.store.Remove(secret.ID); err != nil {
+		return errors.Wrapf(err, "failed to remove meta of secret %s", secret.Name)
+	}
+	delete(sm.secrets, secret.ID)
+
+	log.With(ctx).Infof("secret %s (%s) removed", secret.Name, secret.ID)
+	return nil
+}
+
+// Value returns the information and the decrypted value of secret.
+func (sm *SecretManager) Value(ctx context.Context, name string) (*types.SecretInfo, []byte, error) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+
+	secret, err := sm.lookup(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value, err := sm.decrypt(secret.ID, secret.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return secret.info(), value, nil
+}
+
+// Dump writes the metadata of all secrets into w.
+func (sm *SecretManager) Dump(ctx context.Context, w io.Writer) error {
+	return sm.store.Dump(w)
+}
+
+// lookup finds the secret by name, ID or the prefix of ID, it must be
+// called with lock held.
+func (sm *SecretManager) lookup(name string) (*Secret, error) {
+	if name == "" {
+		return nil, errors.Wrap(errtypes.ErrInvalidParam, "secret name cannot be empty")
+	}
+
+	if secret, ok := sm.secrets[name]; ok {
+		return secret, nil
+	}
+
+	var matched []*Secret
+	for _, secret := range sm.secrets {
+		if secret.Name == name {
+			return secret, nil
+		}
+		if strings.HasPrefix(secret.ID, name) {
+			matched = append(matched, secret)
+		}
+	}
+
+	switch len(matched) {
+	case 0:
+		return nil, errors.Wrapf(errtypes.ErrNotfound, "secret %s", name)
+	case 1:
+		return matched[0], nil
+	default:
+		return nil, errors.Wrapf(errtypes.ErrTooMany, "secret %s", name)
+	}
+}
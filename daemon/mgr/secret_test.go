@@ -0,0 +1,124 @@
+package mgr
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSecretKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secret-key")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "secrets", "secret.key")
+	key, err := loadSecretKey(file)
+	assert.NoError(t, err)
+	assert.Len(t, key, secretKeySize)
+
+	fi, err := os.Stat(file)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	// the generated key is loaded again.
+	loaded, err := loadSecretKey(file)
+	assert.NoError(t, err)
+	assert.Equal(t, key, loaded)
+
+	assert.NoError(t, ioutil.WriteFile(file, []byte("short"), 0600))
+	_, err = loadSecretKey(file)
+	assert.Error(t, err)
+}
+
+func TestSecretEncryptDecrypt(t *testing.T) {
+	aead, err := newSecretAEAD(bytes.Repeat([]byte{1}, secretKeySize))
+	assert.NoError(t, err)
+	sm := &SecretManager{aead: aead}
+
+	value := []byte("passw0rd")
+	data, err := sm.encrypt("id1", value)
+	assert.NoError(t, err)
+	assert.False(t, bytes.Contains(data, value))
+
+	decrypted, err := sm.decrypt("id1", data)
+	assert.NoError(t, err)
+	assert.Equal(t, value, decrypted)
+
+	// the encrypted value is bound to the secret ID.
+	_, err = sm.decrypt("id2", data)
+	assert.Error(t, err)
+
+	_, err = sm.decrypt("id1", data[:4])
+	assert.Error(t, err)
+}
+
+func TestSecretManagerLookup(t *testing.T) {
+	sm := &SecretManager{
+		secrets: map[string]*Secret{
+			"abc123": {ID: "abc123", Name: "db-password"},
+			"abd456": {ID: "abd456", Name: "tls-key"},
+		},
+	}
+
+	for _, name := range []string{"abc123", "db-password", "abc"} {
+		secret, err := sm.lookup(name)
+		assert.NoError(t, err, name)
+		assert.Equal(t, "abc123", secret.ID, name)
+	}
+
+	_, err := sm.lookup("ab")
+	assert.Error(t, err)
+
+	_, err = sm.lookup("cache")
+	assert.True(t, errtypes.IsNotfound(err))
+}
+
+func TestValidateSecretReferences(t *testing.T) {
+	mgr := &ContainerManager{}
+	err := mgr.validateSecretReferences(context.Background(), &types.HostConfig{
+		Secrets: []*types.SecretReference{{Name: "db-password"}},
+	})
+	assert.True(t, errtypes.IsInvalidParam(err))
+
+	aead, err := newSecretAEAD(bytes.Repeat([]byte{1}, secretKeySize))
+	assert.NoError(t, err)
+	mgr.SecretMgr = &SecretManager{
+		aead: aead,
+		secrets: map[string]*Secret{
+			"abc123": {ID: "abc123", Name: "db-password"},
+			"abd456": {ID: "abd456", Name: "tls-key"},
+		},
+	}
+
+	hostConfig := &types.HostConfig{
+		Secrets: []*types.SecretReference{
+			{Name: "abc123"},
+			{Name: "tls-key", Target: "/etc/tls/../tls/server.key", Mode: 0400},
+		},
+	}
+	assert.NoError(t, mgr.validateSecretReferences(context.Background(), hostConfig))
+	assert.Equal(t, &types.SecretReference{Name: "db-password", Target: "/run/secrets/db-password", Mode: defaultSecretMode}, hostConfig.Secrets[0])
+	assert.Equal(t, &types.SecretReference{Name: "tls-key", Target: "/etc/tls/server.key", Mode: 0400}, hostConfig.Secrets[1])
+
+	for _, refs := range [][]*types.SecretReference{
+		{{Name: "db-password", Target: "relative/path"}},
+		{{Name: "db-password", Target: "/run/a"}, {Name: "tls-key", Target: "/run/a"}},
+		{{Name: "db-password", Mode: 01777}},
+	} {
+		err := mgr.validateSecretReferences(context.Background(), &types.HostConfig{Secrets: refs})
+		assert.True(t, errtypes.IsInvalidParam(err))
+	}
+
+	err = mgr.validateSecretReferences(context.Background(), &types.HostConfig{
+		Secrets: []*types.SecretReference{{Name: "unknown"}},
+	})
+	assert.True(t, errtypes.IsNotfound(err))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/alibaba/pouch/oci"
+	"github.com/alibaba/pouch/pkg/cdi"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -18,6 +19,7 @@ type SpecWrapper struct {
 	prioArr    []int
 	argsArr    [][]string
 	useSystemd bool
+	cdiCache   *cdi.Cache
 }
 
 // All the functions related to the spec is lock-free for container instance,
@@ -58,5 +60,10 @@ func createSpec(ctx context.Context, c *Container, specWrapper *SpecWrapper) err
 
 	// platform-specified spec setting
 	// TODO: support window and Solaris platform
-	return populatePlatform(ctx, c, specWrapper)
+	if err := populatePlatform(ctx, c, specWrapper); err != nil {
+		return err
+	}
+
+	// apply the container edits of CDI devices
+	return setupCDIDevices(ctx, c, specWrapper)
 }
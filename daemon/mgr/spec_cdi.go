@@ -0,0 +1,87 @@
+package mgr
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/cdi"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// nvidiaHookName is a custom OCI prestart hook binary to runc in order to enable GPU containers.
+	nvidiaHookName = cdi.NvidiaHookName
+)
+
+// newCDICache returns the CDI cache which loads specs from the given
+// directories, and the NVIDIA GPUs are provided as built-in CDI provider.
+func newCDICache(dirs []string) *cdi.Cache {
+	return cdi.NewCache(dirs, cdi.NewNvidiaProvider(nvidiaHookName, ""))
+}
+
+// setupCDIDevices applies the container edits of the CDI devices which are
+// requested by --device vendor.com/class=name or by the legacy NVIDIA config.
+func setupCDIDevices(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
+	devices := cdiDevices(c.HostConfig.Devices)
+	useNvidia := requireNvidia(c)
+	if len(devices) == 0 && !useNvidia {
+		return nil
+	}
+
+	cache := specWrapper.cdiCache
+	if cache == nil {
+		cache = newCDICache(nil)
+	}
+	if err := cache.Refresh(); err != nil {
+		return errors.Wrap(err, "failed to load CDI specs")
+	}
+
+	if err := cache.InjectDevices(specWrapper.s, devices...); err != nil {
+		return errors.Wrap(err, "failed to inject CDI devices")
+	}
+
+	// NOTE: the NVIDIA hook reads the NVIDIA_* env set by user, so only the
+	// container edits of kind is applied for the legacy NVIDIA config.
+	if useNvidia && !hasCDIKind(devices, cdi.NvidiaGPUKind) {
+		if err := cache.InjectKind(specWrapper.s, cdi.NvidiaGPUKind); err != nil {
+			return errors.Wrap(err, "failed to set nvidia prestart hook")
+		}
+	}
+	return nil
+}
+
+// requireNvidia checks whether the container uses the legacy NVIDIA config.
+func requireNvidia(c *Container) bool {
+	if c.HostConfig.NvidiaConfig != nil {
+		return true
+	}
+
+	// to make compatible for k8s.
+	// if user set environments of NVIDIA, then set prestart hook
+	kv := utils.ConvertKVStrToMapWithNoErr(c.Config.Env)
+	_, hasEnvCapabilities := kv[cdi.NvidiaDriverCapabilitiesEnv]
+	_, hasEnvDevices := kv[cdi.NvidiaVisibleDevicesEnv]
+	return hasEnvCapabilities || hasEnvDevices
+}
+
+// cdiDevices returns the qualified CDI device names in device mappings.
+func cdiDevices(mappings []*types.DeviceMapping) []string {
+	var devices []string
+	for _, m := range mappings {
+		if m != nil && cdi.IsQualifiedName(m.PathOnHost) {
+			devices = append(devices, m.PathOnHost)
+		}
+	}
+	return devices
+}
+
+func hasCDIKind(devices []string, kind string) bool {
+	for _, d := range devices {
+		if k, _, err := cdi.ParseQualifiedName(d); err == nil && k == kind {
+			return true
+		}
+	}
+	return false
+}
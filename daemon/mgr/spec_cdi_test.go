@@ -1,6 +1,8 @@
 package mgr
 
 import (
+	"context"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"path"
@@ -11,7 +13,7 @@ import (
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
-func Test_setNvidiaHook(t *testing.T) {
+func Test_setupCDIDevicesWithNvidia(t *testing.T) {
 	nvidiaHookName = "test-nvidia-container-runtime-hook"
 	installDir := "/usr/local/bin"
 	fullname := path.Join(installDir, nvidiaHookName)
@@ -109,12 +111,64 @@ func Test_setNvidiaHook(t *testing.T) {
 		},
 	}
 	for _, tt := range tests {
-		err := setNvidiaHook(tt.c, tt.specWrapper)
+		err := setupCDIDevices(context.TODO(), tt.c, tt.specWrapper)
 		if err != nil {
-			t.Errorf("setNvidiaHook = %v, want %v", err, nil)
+			t.Errorf("setupCDIDevices = %v, want %v", err, nil)
 		}
 		if !reflect.DeepEqual(tt.specWrapper.s.Hooks.Prestart, tt.expectedPrestart) {
-			t.Errorf("setNvidiaHook = %v, want %v", tt.specWrapper.s.Hooks.Poststart, tt.expectedPrestart)
+			t.Errorf("setupCDIDevices = %v, want %v", tt.specWrapper.s.Hooks.Prestart, tt.expectedPrestart)
 		}
 	}
 }
+
+func Test_setupCDIDevices(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	spec := `{
+	"cdiVersion": "0.3.0",
+	"kind": "vendor.com/device",
+	"devices": [{
+		"name": "dev0",
+		"containerEdits": {
+			"env": ["VENDOR_DEVICE=dev0"],
+			"deviceNodes": [{"path": "/dev/vendor0", "hostPath": "/dev/null"}]
+		}
+	}]
+}`
+	if err := ioutil.WriteFile(path.Join(dir, "vendor.json"), []byte(spec), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Container{
+		HostConfig: &types.HostConfig{
+			Resources: types.Resources{
+				Devices: []*types.DeviceMapping{
+					{PathOnHost: "vendor.com/device=dev0", PathInContainer: "vendor.com/device=dev0", CgroupPermissions: "rwm"},
+				},
+			},
+		},
+		Config: &types.ContainerConfig{},
+	}
+	sw := &SpecWrapper{
+		s:        &specs.Spec{Process: &specs.Process{}},
+		cdiCache: newCDICache([]string{dir}),
+	}
+	if err := setupCDIDevices(context.TODO(), c, sw); err != nil {
+		t.Fatalf("setupCDIDevices = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(sw.s.Process.Env, []string{"VENDOR_DEVICE=dev0"}) {
+		t.Errorf("unexpected env %v", sw.s.Process.Env)
+	}
+	if len(sw.s.Linux.Devices) != 1 || sw.s.Linux.Devices[0].Path != "/dev/vendor0" {
+		t.Errorf("unexpected devices %v", sw.s.Linux.Devices)
+	}
+
+	c.HostConfig.Devices[0].PathOnHost = "vendor.com/device=dev1"
+	if err := setupCDIDevices(context.TODO(), c, sw); err == nil {
+		t.Errorf("setupCDIDevices should fail for unknown device")
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/opencontainers/runtime-spec/specs-go"
-	"github.com/pkg/errors"
 )
 
 //setup hooks specified by user via plugins, if set rich mode and init-script exists set init-script
@@ -47,11 +46,6 @@ func setupHook(ctx context.Context, c *Container, specWrapper *SpecWrapper) erro
 		}
 	}
 
-	// set nvidia config
-	if err := setNvidiaHook(c, specWrapper); err != nil {
-		return errors.Wrap(err, "failed to set nvidia prestart hook")
-	}
-
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/cdi"
 
 	"github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/opencontainers/runc/libcontainer/devices"
@@ -34,17 +35,12 @@ const (
 	defaultCgroupParent = "pouch"
 )
 
-// Setup linux-platform-sepecific specification.
-func populatePlatform(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
-	s := specWrapper.s
-	if s.Linux == nil {
-		s.Linux = &specs.Linux{}
-	}
-
+// containerCgroupsPath returns the cgroups path of container in OCI spec.
+func containerCgroupsPath(c *Container, useSystemd bool) string {
 	// same with containerd use. or make it a variable
 	// set default cgroup parent
 	cgroupsParent := "/default"
-	if specWrapper.useSystemd {
+	if useSystemd {
 		cgroupsParent = "system.slice"
 	}
 
@@ -52,12 +48,21 @@ func populatePlatform(ctx context.Context, c *Container, specWrapper *SpecWrappe
 		cgroupsParent = filepath.Clean(c.HostConfig.CgroupParent)
 	}
 
-	if specWrapper.useSystemd {
-		s.Linux.CgroupsPath = cgroupsParent + ":" + defaultCgroupParent + ":" + c.ID
-	} else {
-		s.Linux.CgroupsPath = filepath.Clean(filepath.Join("/", cgroupsParent, c.ID))
+	if useSystemd {
+		return cgroupsParent + ":" + defaultCgroupParent + ":" + c.ID
+	}
+	return filepath.Clean(filepath.Join("/", cgroupsParent, c.ID))
+}
+
+// Setup linux-platform-sepecific specification.
+func populatePlatform(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
+	s := specWrapper.s
+	if s.Linux == nil {
+		s.Linux = &specs.Linux{}
 	}
 
+	s.Linux.CgroupsPath = containerCgroupsPath(c, specWrapper.useSystemd)
+
 	s.Linux.Sysctl = c.HostConfig.Sysctls
 
 	if c.HostConfig.IntelRdtL3Cbm != "" {
@@ -240,6 +245,10 @@ func setupDevices(ctx context.Context, c *Container, s *specs.Spec) error {
 		}
 	} else {
 		for _, deviceMapping := range c.HostConfig.Devices {
+			// CDI devices are applied by setupCDIDevices.
+			if cdi.IsQualifiedName(deviceMapping.PathOnHost) {
+				continue
+			}
 			if !opts.ValidateDeviceMode(deviceMapping.CgroupPermissions) {
 				return fmt.Errorf("%s invalid device mode: %s", deviceMapping.PathOnHost, deviceMapping.CgroupPermissions)
 			}
@@ -115,6 +115,8 @@ func mergeContainerMount(mounts []specs.Mount, c *Container, s *specs.Spec) ([]s
 		mounts = append(mounts, generateNetworkMounts(c)...)
 	}
 
+	mounts = append(mounts, generateSecretMounts(c)...)
+
 	return mounts, nil
 }
 
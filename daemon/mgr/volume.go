@@ -2,6 +2,7 @@ package mgr
 
 import (
 	"context"
+	"io"
 	"strings"
 
 	"github.com/alibaba/pouch/apis/filters"
@@ -43,6 +44,9 @@ type VolumeMgr interface {
 
 	// Detach is used to unbind a volume from container.
 	Detach(ctx context.Context, name string, options map[string]string) (*types.Volume, error)
+
+	// Dump writes the metadata of all volumes into w.
+	Dump(ctx context.Context, w io.Writer) error
 }
 
 // VolumeManager is the default implement of interface VolumeMgr.
@@ -152,6 +156,11 @@ func (vm *VolumeManager) Remove(ctx context.Context, name string) error {
 	return nil
 }
 
+// Dump writes the metadata of all volumes into w.
+func (vm *VolumeManager) Dump(ctx context.Context, w io.Writer) error {
+	return vm.core.Dump(w)
+}
+
 // Path returns the mount path of volume.
 func (vm *VolumeManager) Path(ctx context.Context, name string) (string, error) {
 	id := types.VolumeContext{
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/client"
+	"github.com/alibaba/pouch/daemon"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/alibaba/pouch/storage/volume"
+
+	"github.com/spf13/cobra"
+)
+
+// FsckCommand is used to implement 'fsck' command.
+type FsckCommand struct {
+	cmd *cobra.Command
+
+	host            string
+	offline         bool
+	homeDir         string
+	pidfile         string
+	metaStoreDriver string
+	repair          bool
+	dryRun          bool
+}
+
+func init() {
+	fsckCommand := &FsckCommand{}
+	fsckCommand.cmd = &cobra.Command{
+		Use:   "fsck [OPTIONS]",
+		Short: "Check and repair the consistency of pouchd's meta data",
+		Long: "Cross-reference the container meta data with the snapshots, the containers and tasks of containerd, " +
+			"the references of volumes and the network sandboxes and endpoints, and report the orphans and dangling references. " +
+			"By default it asks the running pouchd to check, with '--offline' it checks the meta data of containers and volumes " +
+			"while pouchd is stopped. The repairable findings are repaired with '--repair', and '--dry-run' shows what would be repaired.",
+		Args:          cobra.NoArgs,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fsckCommand.runFsck(args)
+		},
+	}
+	fsckCommand.addFlags()
+
+	rootCmd.AddCommand(fsckCommand.cmd)
+}
+
+// addFlags adds flags for specific command.
+func (f *FsckCommand) addFlags() {
+	flagSet := f.cmd.Flags()
+
+	flagSet.StringVarP(&f.host, "host", "H", "unix:///var/run/pouchd.sock", "Specify the address of running pouchd")
+	flagSet.BoolVar(&f.offline, "offline", false, "Check the meta data while pouchd is stopped, containerd and network are not checked")
+	flagSet.StringVar(&f.homeDir, "home-dir", "/var/lib/pouch", "Specify root dir of pouchd, only for offline check")
+	flagSet.StringVar(&f.pidfile, "pidfile", "/var/run/pouch.pid", "Specify the pid file of pouchd to check pouchd is stopped, only for offline check")
+	flagSet.StringVar(&f.metaStoreDriver, "meta-store-driver", meta.DefaultStore, "Specify the backend of container meta store(local|boltdb), only for offline check")
+	flagSet.BoolVar(&f.repair, "repair", false, "Repair the repairable findings")
+	flagSet.BoolVar(&f.dryRun, "dry-run", false, "Only show what would be repaired, nothing is changed")
+}
+
+func (f *FsckCommand) runFsck(args []string) error {
+	var (
+		report *types.FsckReport
+		err    error
+	)
+
+	if f.offline {
+		report, err = f.fsckOffline()
+	} else {
+		var cli client.CommonAPIClient
+		if cli, err = client.NewAPIClient(f.host, client.TLSConfig{}); err != nil {
+			return fmt.Errorf("failed to connect to pouchd: %v", err)
+		}
+		report, err = cli.DaemonFsck(context.Background(), f.repair, f.dryRun)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check meta data: %v", err)
+	}
+
+	printFsckReport(report)
+	return nil
+}
+
+func (f *FsckCommand) fsckOffline() (*types.FsckReport, error) {
+	if pid, err := readPidfile(f.pidfile); err == nil && utils.IsProcessAlive(pid) {
+		return nil, fmt.Errorf("pouchd is running with pid %d, stop it or check without --offline", pid)
+	}
+
+	// the store is opened without schemas, so that the meta data is not
+	// upgraded by the check, the schema version is checked by fsck.
+	cfg := daemon.ContainerMetaConfig(f.homeDir, f.metaStoreDriver)
+	cfg.Schemas = nil
+	store, err := meta.NewStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Shutdown()
+
+	volumeStore, err := meta.NewStore(volume.MetaConfig(path.Join(f.homeDir, "volume", "volume.db")))
+	if err != nil {
+		return nil, err
+	}
+	defer volumeStore.Shutdown()
+
+	return mgr.FsckOffline(context.Background(), f.homeDir, store, volumeStore, &mgr.FsckOptions{
+		Repair: f.repair,
+		DryRun: f.dryRun,
+	})
+}
+
+// printFsckReport prints the findings in table and the summary.
+func printFsckReport(report *types.FsckReport) {
+	kinds := make([]string, 0, len(report.Checked))
+	for kind := range report.Checked {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		fmt.Printf("checked %d %s\n", report.Checked[kind], kind)
+	}
+
+	if len(report.Findings) == 0 {
+		fmt.Println("no inconsistency is found")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\nTYPE\tOBJECT\tCONTAINER\tACTION\tMESSAGE")
+
+	repaired := 0
+	for _, finding := range report.Findings {
+		action := fsckAction(report, finding)
+		if finding.Repaired {
+			repaired++
+		}
+
+		message := finding.Message
+		if finding.Error != "" {
+			message = fmt.Sprintf("%s: %s", message, finding.Error)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", finding.Type, finding.Object, utils.TruncateID(finding.Container), action, message)
+	}
+	w.Flush()
+
+	fmt.Printf("\n%d inconsistencies are found, %d are repaired\n", len(report.Findings), repaired)
+}
+
+// fsckAction describes what is done to the finding.
+func fsckAction(report *types.FsckReport, finding *types.FsckFinding) string {
+	switch {
+	case !finding.Repairable:
+		return "manual"
+	case finding.Repaired:
+		return "repaired"
+	case finding.Error != "":
+		return "failed"
+	case report.Repair && report.DryRun:
+		return "would repair"
+	default:
+		return "repairable"
+	}
+}
@@ -4,12 +4,15 @@ set -o errexit
 set -o nounset
 
 #
-# This script is used to regenerate api.pb.go.
+# This script is used to regenerate api.pb.go of CRI and gRPC API, and
+# hook.pb.go of hook plugins.
 #
 
 # Get the absolute path of this file
 DIR="$( cd "$( dirname "$0"  )" && pwd  )"/../..
 API_ROOT="${DIR}/cri/apis/v1alpha2"
+GRPC_API_ROOT="${DIR}/apis/grpc/v1"
+HOOK_PLUGIN_ROOT="${DIR}/hookplugins/remote/v1"
 
 if [[ -z "$(which protoc)" || "$(protoc --version)" != "libprotoc 3."* ]]; then
   echo "Generating protobuf requires protoc 3.0.0-beta1 or newer. Please download and"
@@ -59,12 +62,31 @@ protoc::generateproto(){
     gofmt -l -s -w "${API_ROOT}/api.pb.go"
 }
 
+protoc::generategrpcproto(){
+    protoc::install_gen_gogo
+
+    local proto root
+    for proto in "${GRPC_API_ROOT}/api.proto" "${HOOK_PLUGIN_ROOT}/hook.proto"; do
+        root="$(dirname "${proto}")"
+        protoc \
+            --proto_path="${root}" \
+            --proto_path="${DIR}/vendor" \
+            --gogo_out=plugins=grpc:"${root}" "${proto}"
+
+        # The vendored github.com/golang/protobuf only supports version 2.
+        sed -i 's/proto.ProtoPackageIsVersion3/proto.ProtoPackageIsVersion2/' "${proto%.proto}.pb.go"
+        gofmt -l -s -w "${proto%.proto}.pb.go"
+    done
+}
+
 main(){
     local operation
     operation=$1
 
     if [[ "${operation}" == "gen_proto" ]]; then
         protoc::generateproto
+    elif [[ "${operation}" == "gen_grpc_proto" ]]; then
+        protoc::generategrpcproto
     elif [[ "${operation}" == "gen_doc" ]]; then
         protoc::generatedoc
     else
@@ -0,0 +1,217 @@
+package hookplugins
+
+import (
+	"context"
+	"io"
+
+	servertypes "github.com/alibaba/pouch/apis/server/types"
+	"github.com/alibaba/pouch/apis/types"
+	networktypes "github.com/alibaba/pouch/network/types"
+
+	"github.com/containerd/containerd"
+)
+
+// NewContainerPluginChain returns a container plugin which calls the plugins
+// in order, the nil plugins are skipped. It returns nil if there is no plugin.
+func NewContainerPluginChain(plugins ...ContainerPlugin) ContainerPlugin {
+	var chain containerPluginChain
+	for _, p := range plugins {
+		if p != nil {
+			chain = append(chain, p)
+		}
+	}
+
+	switch len(chain) {
+	case 0:
+		return nil
+	case 1:
+		return chain[0]
+	}
+	return chain
+}
+
+type containerPluginChain []ContainerPlugin
+
+// PreCreate calls PreCreate of plugins in order, each plugin receives the
+// config changed by the previous ones.
+func (c containerPluginChain) PreCreate(ctx context.Context, config *types.ContainerCreateConfig) error {
+	for _, p := range c {
+		if err := p.PreCreate(ctx, config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PreStart returns the priorities and args of all plugins.
+func (c containerPluginChain) PreStart(ctx context.Context, config interface{}) ([]int, [][]string, error) {
+	var (
+		prioArr []int
+		argsArr [][]string
+	)
+	for _, p := range c {
+		prios, args, err := p.PreStart(ctx, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		prioArr = append(prioArr, prios...)
+		argsArr = append(argsArr, args...)
+	}
+	return prioArr, argsArr, nil
+}
+
+// PreCreateEndpoint calls PreCreateEndpoint of plugins in order.
+func (c containerPluginChain) PreCreateEndpoint(ctx context.Context, cid string, env []string, endpoint *networktypes.Endpoint) error {
+	for _, p := range c {
+		if err := p.PreCreateEndpoint(ctx, cid, env, endpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PreUpdate passes the update body through the plugins in order.
+func (c containerPluginChain) PreUpdate(ctx context.Context, in io.ReadCloser) (io.ReadCloser, error) {
+	for _, p := range c {
+		out, err := p.PreUpdate(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		in = out
+	}
+	return in, nil
+}
+
+// PostUpdate calls PostUpdate of plugins in order.
+func (c containerPluginChain) PostUpdate(ctx context.Context, rootfs string, env []string) error {
+	for _, p := range c {
+		if err := p.PostUpdate(ctx, rootfs, env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewVolumePluginChain returns a volume plugin which calls the plugins in
+// order, the nil plugins are skipped. It returns nil if there is no plugin.
+func NewVolumePluginChain(plugins ...VolumePlugin) VolumePlugin {
+	var chain volumePluginChain
+	for _, p := range plugins {
+		if p != nil {
+			chain = append(chain, p)
+		}
+	}
+
+	switch len(chain) {
+	case 0:
+		return nil
+	case 1:
+		return chain[0]
+	}
+	return chain
+}
+
+type volumePluginChain []VolumePlugin
+
+// PreVolumeCreate calls PreVolumeCreate of plugins in order.
+func (c volumePluginChain) PreVolumeCreate(ctx context.Context, config *types.VolumeCreateConfig) error {
+	for _, p := range c {
+		if err := p.PreVolumeCreate(ctx, config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewCriPluginChain returns a cri plugin which calls the plugins in order,
+// the nil plugins are skipped. It returns nil if there is no plugin.
+func NewCriPluginChain(plugins ...CriPlugin) CriPlugin {
+	var chain criPluginChain
+	for _, p := range plugins {
+		if p != nil {
+			chain = append(chain, p)
+		}
+	}
+
+	switch len(chain) {
+	case 0:
+		return nil
+	case 1:
+		return chain[0]
+	}
+	return chain
+}
+
+type criPluginChain []CriPlugin
+
+// PreCreateContainer calls PreCreateContainer of plugins in order.
+func (c criPluginChain) PreCreateContainer(ctx context.Context, config *types.ContainerCreateConfig, sandboxMeta interface{}) error {
+	for _, p := range c {
+		if err := p.PreCreateContainer(ctx, config, sandboxMeta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewImagePluginChain returns an image plugin which calls the plugins in
+// order, the nil plugins are skipped. It returns nil if there is no plugin.
+func NewImagePluginChain(plugins ...ImagePlugin) ImagePlugin {
+	var chain imagePluginChain
+	for _, p := range plugins {
+		if p != nil {
+			chain = append(chain, p)
+		}
+	}
+
+	switch len(chain) {
+	case 0:
+		return nil
+	case 1:
+		return chain[0]
+	}
+	return chain
+}
+
+type imagePluginChain []ImagePlugin
+
+// PostPull calls PostPull of plugins in order.
+func (c imagePluginChain) PostPull(ctx context.Context, snapshotter string, image containerd.Image) error {
+	for _, p := range c {
+		if err := p.PostPull(ctx, snapshotter, image); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewAPIPluginChain returns an api plugin which calls the plugins in order,
+// the nil plugins are skipped. It returns nil if there is no plugin.
+func NewAPIPluginChain(plugins ...APIPlugin) APIPlugin {
+	var chain apiPluginChain
+	for _, p := range plugins {
+		if p != nil {
+			chain = append(chain, p)
+		}
+	}
+
+	switch len(chain) {
+	case 0:
+		return nil
+	case 1:
+		return chain[0]
+	}
+	return chain
+}
+
+type apiPluginChain []APIPlugin
+
+// UpdateHandler passes the handlers through the plugins in order, each
+// plugin receives the handlers updated by the previous ones, so the handler
+// wrapped by the last plugin receives the request first.
+func (c apiPluginChain) UpdateHandler(ctx context.Context, handlers []*servertypes.HandlerSpec) []*servertypes.HandlerSpec {
+	for _, p := range c {
+		handlers = p.UpdateHandler(ctx, handlers)
+	}
+	return handlers
+}
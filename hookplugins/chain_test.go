@@ -0,0 +1,101 @@
+package hookplugins
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	servertypes "github.com/alibaba/pouch/apis/server/types"
+	"github.com/alibaba/pouch/apis/types"
+	networktypes "github.com/alibaba/pouch/network/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testContainerPlugin struct {
+	name  string
+	prio  int
+	calls *[]string
+	err   error
+}
+
+func (p *testContainerPlugin) PreCreate(ctx context.Context, config *types.ContainerCreateConfig) error {
+	*p.calls = append(*p.calls, p.name)
+	config.Env = append(config.Env, "BY="+p.name)
+	return p.err
+}
+
+func (p *testContainerPlugin) PreStart(ctx context.Context, c interface{}) ([]int, [][]string, error) {
+	return []int{p.prio}, [][]string{{p.name}}, p.err
+}
+
+func (p *testContainerPlugin) PreCreateEndpoint(ctx context.Context, cid string, env []string, endpoint *networktypes.Endpoint) error {
+	return p.err
+}
+
+func (p *testContainerPlugin) PreUpdate(ctx context.Context, in io.ReadCloser) (io.ReadCloser, error) {
+	body, _ := ioutil.ReadAll(in)
+	return ioutil.NopCloser(bytes.NewReader(append(body, p.name...))), p.err
+}
+
+func (p *testContainerPlugin) PostUpdate(ctx context.Context, rootfs string, env []string) error {
+	return p.err
+}
+
+func TestContainerPluginChain(t *testing.T) {
+	assert.Nil(t, NewContainerPluginChain())
+	assert.Nil(t, NewContainerPluginChain(nil, nil))
+
+	var calls []string
+	a := &testContainerPlugin{name: "a", prio: 1, calls: &calls}
+	b := &testContainerPlugin{name: "b", prio: 2, calls: &calls}
+
+	// a single plugin is not wrapped.
+	assert.Equal(t, a, NewContainerPluginChain(nil, a))
+
+	chain := NewContainerPluginChain(a, nil, b)
+	ctx := context.Background()
+
+	config := &types.ContainerCreateConfig{}
+	assert.NoError(t, chain.PreCreate(ctx, config))
+	assert.Equal(t, []string{"a", "b"}, calls)
+	assert.Equal(t, []string{"BY=a", "BY=b"}, config.Env)
+
+	prios, args, err := chain.PreStart(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, prios)
+	assert.Equal(t, [][]string{{"a"}, {"b"}}, args)
+
+	out, err := chain.PreUpdate(ctx, ioutil.NopCloser(bytes.NewReader([]byte("body-"))))
+	assert.NoError(t, err)
+	body, _ := ioutil.ReadAll(out)
+	assert.Equal(t, "body-ab", string(body))
+
+	// the plugins after the failed one are not called.
+	calls = nil
+	a.err = fmt.Errorf("denied")
+	assert.EqualError(t, chain.PreCreate(ctx, &types.ContainerCreateConfig{}), "denied")
+	assert.Equal(t, []string{"a"}, calls)
+}
+
+type testAPIPlugin struct {
+	path string
+}
+
+func (p *testAPIPlugin) UpdateHandler(ctx context.Context, handlers []*servertypes.HandlerSpec) []*servertypes.HandlerSpec {
+	return append(handlers, &servertypes.HandlerSpec{Path: p.path})
+}
+
+func TestAPIPluginChain(t *testing.T) {
+	assert.Nil(t, NewAPIPluginChain(nil))
+
+	chain := NewAPIPluginChain(&testAPIPlugin{path: "/a"}, nil, &testAPIPlugin{path: "/b"})
+	handlers := chain.UpdateHandler(context.Background(), nil)
+	if assert.Len(t, handlers, 2) {
+		assert.Equal(t, "/a", handlers[0].Path)
+		assert.Equal(t, "/b", handlers[1].Path)
+	}
+}
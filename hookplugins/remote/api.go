@@ -0,0 +1,188 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	servertypes "github.com/alibaba/pouch/apis/server/types"
+	"github.com/alibaba/pouch/hookplugins"
+	"github.com/alibaba/pouch/hookplugins/remote/v1"
+	"github.com/alibaba/pouch/pkg/httputils"
+
+	"github.com/pkg/errors"
+)
+
+var _ hookplugins.APIPlugin = &Plugin{}
+
+// pathParamRegexp matches the parameters in path template of API, so that
+// /containers/{id}/start matches /containers/{name:.*}/start.
+var pathParamRegexp = regexp.MustCompile(`\{[^}]*\}`)
+
+func normalizeAPIPath(path string) string {
+	return pathParamRegexp.ReplaceAllString(path, "{}")
+}
+
+// UpdateHandler proxies the requests and responses of the APIs in config of
+// plugin through hooks APIPlugin.PreHandle and APIPlugin.PostHandle, the
+// APIs not served by pouchd are added.
+func (p *Plugin) UpdateHandler(ctx context.Context, handlers []*servertypes.HandlerSpec) []*servertypes.HandlerSpec {
+	for _, api := range p.config.APIs {
+		// the config is validated.
+		method, path, _ := parseAPI(api)
+
+		found := false
+		for i, h := range handlers {
+			if h == nil || h.Method != method || normalizeAPIPath(h.Path) != normalizeAPIPath(path) {
+				continue
+			}
+			found = true
+
+			spec := *h
+			spec.HandlerFunc = p.proxyHandler(h.Path, h.HandlerFunc)
+			handlers[i] = &spec
+		}
+
+		if !found {
+			handlers = append(handlers, servertypes.NewHandlerSpec(method, path, p.proxyHandler(path, notFoundHandler)))
+		}
+	}
+	return handlers
+}
+
+// isStreamingAPI returns true if the API of path streams data or hijacks
+// the connection, it can't be proxied since the request and response are
+// buffered by proxy.
+func isStreamingAPI(path string) bool {
+	path = normalizeAPIPath(path)
+	for p := range servertypes.StreamingAPIs {
+		if normalizeAPIPath(p) == path {
+			return true
+		}
+	}
+	return false
+}
+
+func notFoundHandler(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	return httputils.NewHTTPError(errors.Errorf("%s %s is not answered by hook plugin", req.Method, req.URL.Path), http.StatusNotFound)
+}
+
+// proxyHandler passes the request through hook APIPlugin.PreHandle before
+// calling next, and the response through hook APIPlugin.PostHandle. The
+// response is buffered only if the plugin implements APIPlugin.PostHandle.
+func (p *Plugin) proxyHandler(path string, next servertypes.Handler) servertypes.Handler {
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		apiReq := &v1.APIRequest{
+			Method: req.Method,
+			Path:   path,
+			URL:    req.URL.String(),
+			Header: req.Header,
+			Body:   body,
+		}
+
+		var preResp v1.APIPreHandleResponse
+		called, err := p.call(ctx, v1.HookAPIPreHandle, &v1.APIPreHandleRequest{Request: apiReq}, &preResp)
+		if err != nil {
+			return err
+		}
+		if called && preResp.Response != nil {
+			writeAPIResponse(rw, preResp.Response)
+			return nil
+		}
+		if called && preResp.Request != nil {
+			if preResp.Request.Header != nil {
+				req.Header = preResp.Request.Header
+			}
+			if preResp.Request.Body != nil {
+				body = preResp.Request.Body
+			}
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+
+		if ok, err := p.implements(ctx, v1.HookAPIPostHandle); err != nil || !ok {
+			return next(ctx, rw, req)
+		}
+
+		buf := newResponseBuffer()
+		if err := next(ctx, buf, req); err != nil {
+			// the partial response is discarded, the error is written by server.
+			return err
+		}
+
+		apiReq.Header = req.Header
+		apiReq.Body = nil
+		resp := buf.response()
+
+		var postResp v1.APIPostHandleResponse
+		called, err = p.call(ctx, v1.HookAPIPostHandle, &v1.APIPostHandleRequest{Request: apiReq, Response: resp}, &postResp)
+		if err != nil {
+			return err
+		}
+		if called && postResp.Response != nil {
+			resp = postResp.Response
+		}
+		writeAPIResponse(rw, resp)
+		return nil
+	}
+}
+
+func writeAPIResponse(rw http.ResponseWriter, resp *v1.APIResponse) {
+	for k, v := range resp.Header {
+		rw.Header()[k] = v
+	}
+	if resp.StatusCode == 0 && len(resp.Body) == 0 {
+		return
+	}
+
+	status := resp.StatusCode
+	if status == 0 {
+		status = http.StatusOK
+	}
+	rw.WriteHeader(status)
+	rw.Write(resp.Body)
+}
+
+// responseBuffer buffers the response of handler.
+type responseBuffer struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newResponseBuffer() *responseBuffer {
+	return &responseBuffer{header: http.Header{}}
+}
+
+func (b *responseBuffer) Header() http.Header {
+	return b.header
+}
+
+func (b *responseBuffer) WriteHeader(status int) {
+	if b.status == 0 {
+		b.status = status
+	}
+}
+
+func (b *responseBuffer) Write(data []byte) (int, error) {
+	if b.status == 0 {
+		b.status = http.StatusOK
+	}
+	return b.body.Write(data)
+}
+
+func (b *responseBuffer) response() *v1.APIResponse {
+	return &v1.APIResponse{
+		StatusCode: b.status,
+		Header:     b.header,
+		Body:       b.body.Bytes(),
+	}
+}
@@ -0,0 +1,135 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	servertypes "github.com/alibaba/pouch/apis/server/types"
+	"github.com/alibaba/pouch/hookplugins/remote/v1"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func echoHandler(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	body, _ := ioutil.ReadAll(req.Body)
+	rw.Header().Set("X-Handler", req.Header.Get("X-Client"))
+	rw.WriteHeader(http.StatusCreated)
+	rw.Write(body)
+	return nil
+}
+
+func failHandler(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	rw.Write([]byte(`{"partial":`))
+	return errors.New("failed")
+}
+
+func serveAPI(h *servertypes.HandlerSpec, method, url, body string) *httptest.ResponseRecorder {
+	rw := httptest.NewRecorder()
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	req.Header.Set("X-Client", "cli")
+	h.HandlerFunc(context.Background(), rw, req)
+	return rw
+}
+
+func TestPluginUpdateHandler(t *testing.T) {
+	server, cleanup := newTestServer(t, testHooks{
+		v1.HookAPIPreHandle: func(ctx context.Context, body []byte) ([]byte, error) {
+			var req v1.APIPreHandleRequest
+			assert.NoError(t, json.Unmarshal(body, &req))
+
+			switch req.Request.Path {
+			case "/plugin/hello":
+				return json.Marshal(&v1.APIPreHandleResponse{Response: &v1.APIResponse{
+					StatusCode: http.StatusOK,
+					Body:       []byte("hello"),
+				}})
+			case "/containers/{name:.*}/start":
+				assert.Equal(t, "/containers/c1/start", req.Request.URL)
+				return json.Marshal(&v1.APIPreHandleResponse{Response: &v1.APIResponse{
+					StatusCode: http.StatusForbidden,
+					Body:       []byte("denied"),
+				}})
+			case "/containers/{name:.*}/kill":
+				return json.Marshal(&v1.APIPreHandleResponse{})
+			}
+
+			assert.Equal(t, "/containers/create", req.Request.Path)
+			assert.Equal(t, `{"Image":"busybox"}`, string(req.Request.Body))
+			return json.Marshal(&v1.APIPreHandleResponse{Request: &v1.APIRequest{
+				Body: []byte(`{"Image":"alpine"}`),
+			}})
+		},
+		v1.HookAPIPostHandle: func(ctx context.Context, body []byte) ([]byte, error) {
+			var req v1.APIPostHandleRequest
+			assert.NoError(t, json.Unmarshal(body, &req))
+			assert.Nil(t, req.Request.Body)
+			assert.Equal(t, http.StatusCreated, req.Response.StatusCode)
+
+			resp := req.Response
+			resp.Header.Set("X-Plugin", "test")
+			return json.Marshal(&v1.APIPostHandleResponse{Response: resp})
+		},
+	})
+	defer cleanup()
+
+	p := newTestPlugin(t, PluginConfig{
+		Address: server.address,
+		APIs: []string{
+			"POST /containers/create",
+			"post /containers/{id}/start",
+			"GET /plugin/hello",
+			"POST /containers/{id}/kill",
+		},
+	})
+	defer p.Close()
+
+	handlers := p.UpdateHandler(context.Background(), []*servertypes.HandlerSpec{
+		{Method: http.MethodPost, Path: "/containers/create", HandlerFunc: echoHandler, MinVersion: "1.24"},
+		{Method: http.MethodPost, Path: "/containers/{name:.*}/start", HandlerFunc: echoHandler},
+		{Method: http.MethodPost, Path: "/containers/{name:.*}/stop", HandlerFunc: echoHandler},
+		{Method: http.MethodPost, Path: "/containers/{name:.*}/kill", HandlerFunc: failHandler},
+	})
+	if !assert.Len(t, handlers, 5) {
+		return
+	}
+	assert.Equal(t, "1.24", handlers[0].MinVersion)
+	assert.Equal(t, "/plugin/hello", handlers[4].Path)
+
+	// the request and response are changed by plugin.
+	rw := serveAPI(handlers[0], http.MethodPost, "/containers/create", `{"Image":"busybox"}`)
+	assert.Equal(t, http.StatusCreated, rw.Code)
+	assert.Equal(t, `{"Image":"alpine"}`, rw.Body.String())
+	assert.Equal(t, "cli", rw.Header().Get("X-Handler"))
+	assert.Equal(t, "test", rw.Header().Get("X-Plugin"))
+
+	// the request is answered by plugin.
+	rw = serveAPI(handlers[1], http.MethodPost, "/containers/c1/start", "")
+	assert.Equal(t, http.StatusForbidden, rw.Code)
+	assert.Equal(t, "denied", rw.Body.String())
+
+	// the APIs not in config are not proxied.
+	rw = serveAPI(handlers[2], http.MethodPost, "/containers/c1/stop", "body")
+	assert.Equal(t, "body", rw.Body.String())
+	assert.Equal(t, "", rw.Header().Get("X-Plugin"))
+
+	rw = serveAPI(handlers[4], http.MethodGet, "/plugin/hello", "")
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, "hello", rw.Body.String())
+
+	// the partial response of failed handler is discarded, the error is
+	// written by server.
+	rw = httptest.NewRecorder()
+	err := handlers[3].HandlerFunc(context.Background(), rw, httptest.NewRequest(http.MethodPost, "/containers/c1/kill", nil))
+	assert.EqualError(t, err, "failed")
+	assert.False(t, rw.Flushed)
+	assert.Equal(t, "", rw.Body.String())
+	assert.Equal(t, "", rw.Header().Get("Content-Type"))
+}
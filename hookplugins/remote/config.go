@@ -0,0 +1,169 @@
+package remote
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/alibaba/pouch/hookplugins/remote/v1"
+)
+
+const (
+	// ProtocolJSON is the protocol of JSON requests over http.
+	ProtocolJSON = "json"
+	// ProtocolGRPC is the protocol of gRPC service HookPlugin.
+	ProtocolGRPC = "grpc"
+
+	// FailurePolicyFail fails the operation if the hook call fails.
+	FailurePolicyFail = "fail"
+	// FailurePolicyIgnore logs the failed hook call and goes on as if the
+	// hook is not implemented.
+	FailurePolicyIgnore = "ignore"
+
+	defaultTimeout = 10
+)
+
+var validPluginName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// PluginConfig is the configuration of an out-of-process hook plugin.
+type PluginConfig struct {
+	// Name identifies the plugin in logs and metrics.
+	Name string `json:"name"`
+
+	// Address is the address of plugin, unix:///path/to/socket or tcp://host:port.
+	Address string `json:"address"`
+
+	// Protocol is the protocol to call plugin, json or grpc, json by default.
+	Protocol string `json:"protocol,omitempty"`
+
+	// Priority orders the plugins, the plugin with larger priority is called
+	// first, and the plugins with the same priority are called in config order.
+	Priority int `json:"priority,omitempty"`
+
+	// Timeout is the timeout in seconds of each hook call.
+	Timeout int `json:"timeout,omitempty"`
+
+	// FailurePolicy is what to do if a hook call fails, fail or ignore,
+	// fail by default.
+	FailurePolicy string `json:"failure-policy,omitempty"`
+
+	// Hooks overrides the timeout and failure policy of hooks, the key is
+	// the name of hook, such as ContainerPlugin.PreCreate.
+	Hooks map[string]HookConfig `json:"hooks,omitempty"`
+
+	// APIs are the APIs proxied through the APIPlugin hooks of plugin, such
+	// as "POST /containers/create", the path is the one in API reference.
+	// The APIs not served by pouchd are added, and the plugin must answer
+	// them in APIPlugin.PreHandle. The streaming APIs, such as attach and
+	// events, can't be proxied.
+	APIs []string `json:"apis,omitempty"`
+}
+
+// HookConfig is the configuration of a hook of plugin, the zero values
+// inherit the ones of plugin.
+type HookConfig struct {
+	// Timeout is the timeout in seconds of hook call.
+	Timeout int `json:"timeout,omitempty"`
+
+	// FailurePolicy is what to do if the hook call fails, fail or ignore.
+	FailurePolicy string `json:"failure-policy,omitempty"`
+}
+
+// Validate validates the plugin config, and fills the default values.
+func (c *PluginConfig) Validate() error {
+	if !validPluginName.MatchString(c.Name) {
+		return fmt.Errorf("invalid hook plugin name (%s), only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed", c.Name)
+	}
+
+	u, err := url.Parse(c.Address)
+	if err != nil || !((u.Scheme == "unix" && u.Path != "") || (u.Scheme == "tcp" && u.Host != "")) {
+		return fmt.Errorf("invalid address (%s) of hook plugin %s, must be unix:///path or tcp://host:port", c.Address, c.Name)
+	}
+
+	switch c.Protocol {
+	case "":
+		c.Protocol = ProtocolJSON
+	case ProtocolJSON, ProtocolGRPC:
+	default:
+		return fmt.Errorf("invalid protocol (%s) of hook plugin %s, must be %s or %s", c.Protocol, c.Name, ProtocolJSON, ProtocolGRPC)
+	}
+
+	if c.Timeout < 0 {
+		return fmt.Errorf("timeout of hook plugin %s cannot be negative", c.Name)
+	}
+	if c.Timeout == 0 {
+		c.Timeout = defaultTimeout
+	}
+
+	if c.FailurePolicy == "" {
+		c.FailurePolicy = FailurePolicyFail
+	}
+	if err := validateFailurePolicy(c.FailurePolicy); err != nil {
+		return fmt.Errorf("invalid failure policy of hook plugin %s: %v", c.Name, err)
+	}
+
+	for _, api := range c.APIs {
+		_, path, err := parseAPI(api)
+		if err != nil {
+			return fmt.Errorf("invalid api (%s) of hook plugin %s: %v", api, c.Name, err)
+		}
+		if isStreamingAPI(path) {
+			return fmt.Errorf("invalid api (%s) of hook plugin %s: the streaming api can't be proxied", api, c.Name)
+		}
+	}
+
+	for hook, hc := range c.Hooks {
+		if !isKnownHook(hook) {
+			return fmt.Errorf("unknown hook %s of hook plugin %s", hook, c.Name)
+		}
+		if hc.Timeout < 0 {
+			return fmt.Errorf("timeout of hook %s of hook plugin %s cannot be negative", hook, c.Name)
+		}
+		if hc.FailurePolicy != "" {
+			if err := validateFailurePolicy(hc.FailurePolicy); err != nil {
+				return fmt.Errorf("invalid failure policy of hook %s of hook plugin %s: %v", hook, c.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// hookConfig returns the timeout and failure policy of hook.
+func (c *PluginConfig) hookConfig(hook string) HookConfig {
+	hc := HookConfig{Timeout: c.Timeout, FailurePolicy: c.FailurePolicy}
+	if override, ok := c.Hooks[hook]; ok {
+		if override.Timeout != 0 {
+			hc.Timeout = override.Timeout
+		}
+		if override.FailurePolicy != "" {
+			hc.FailurePolicy = override.FailurePolicy
+		}
+	}
+	return hc
+}
+
+func validateFailurePolicy(policy string) error {
+	if policy != FailurePolicyFail && policy != FailurePolicyIgnore {
+		return fmt.Errorf("%s, must be %s or %s", policy, FailurePolicyFail, FailurePolicyIgnore)
+	}
+	return nil
+}
+
+// parseAPI parses the method and path of api in form "METHOD /path".
+func parseAPI(api string) (string, string, error) {
+	fields := strings.Fields(api)
+	if len(fields) != 2 || !strings.HasPrefix(fields[1], "/") {
+		return "", "", fmt.Errorf("must be in form \"METHOD /path\"")
+	}
+	return strings.ToUpper(fields[0]), fields[1], nil
+}
+
+func isKnownHook(hook string) bool {
+	for _, h := range v1.Hooks {
+		if h == hook {
+			return true
+		}
+	}
+	return false
+}
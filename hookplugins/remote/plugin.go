@@ -0,0 +1,366 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/apis/metrics"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/hookplugins"
+	"github.com/alibaba/pouch/hookplugins/remote/v1"
+	networktypes "github.com/alibaba/pouch/network/types"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/trace"
+
+	"github.com/containerd/containerd"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ hookplugins.ContainerPlugin = &Plugin{}
+	_ hookplugins.VolumePlugin    = &Plugin{}
+	_ hookplugins.CriPlugin       = &Plugin{}
+	_ hookplugins.ImagePlugin     = &Plugin{}
+)
+
+// Plugin is an out-of-process hook plugin, it implements the container,
+// volume, cri and image plugins by calling the hooks of plugin. The plugin
+// is activated on the first hook call, and the hooks not implemented by
+// plugin are skipped.
+type Plugin struct {
+	config    PluginConfig
+	transport transport
+
+	sync.Mutex
+	// hooks are the hooks implemented by plugin, it's nil until the plugin
+	// is activated.
+	hooks map[string]bool
+	// activating is the in-flight activation, which the concurrent hook
+	// calls wait for.
+	activating *activation
+}
+
+// activation is the result of activating plugin, it's set before done is
+// closed.
+type activation struct {
+	done  chan struct{}
+	hooks map[string]bool
+	err   error
+}
+
+// NewPlugin creates a hook plugin, the config should be validated.
+func NewPlugin(config PluginConfig) (*Plugin, error) {
+	t, err := newTransport(config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create transport of hook plugin %s", config.Name)
+	}
+
+	return &Plugin{
+		config:    config,
+		transport: t,
+	}, nil
+}
+
+// NewPlugins creates the hook plugins in priority order.
+func NewPlugins(configs []PluginConfig) ([]*Plugin, error) {
+	sorted := make([]PluginConfig, len(configs))
+	copy(sorted, configs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority > sorted[j].Priority
+	})
+
+	plugins := make([]*Plugin, 0, len(sorted))
+	for _, config := range sorted {
+		p, err := NewPlugin(config)
+		if err != nil {
+			for _, p := range plugins {
+				p.Close()
+			}
+			return nil, err
+		}
+		plugins = append(plugins, p)
+	}
+	return plugins, nil
+}
+
+// Name returns the name of plugin.
+func (p *Plugin) Name() string {
+	return p.config.Name
+}
+
+// Close closes the connection to plugin.
+func (p *Plugin) Close() error {
+	return p.transport.close()
+}
+
+// implements activates the plugin if it's not activated, and returns whether
+// the plugin implements the hook. The plugin is activated out of lock by
+// only one of the concurrent hook calls, the others wait for it. The failed
+// activation is retried on the next hook call.
+func (p *Plugin) implements(ctx context.Context, hook string) (bool, error) {
+	p.Lock()
+	if p.hooks != nil {
+		defer p.Unlock()
+		return p.hooks[hook], nil
+	}
+
+	a := p.activating
+	if a == nil {
+		a = &activation{done: make(chan struct{})}
+		p.activating = a
+		p.Unlock()
+
+		a.hooks, a.err = p.activate(ctx)
+
+		p.Lock()
+		p.activating = nil
+		if a.err == nil {
+			p.hooks = a.hooks
+		}
+		p.Unlock()
+		close(a.done)
+	} else {
+		p.Unlock()
+
+		select {
+		case <-a.done:
+		case <-ctx.Done():
+			return false, errors.Wrap(ctx.Err(), "failed to wait for activation")
+		}
+	}
+
+	if a.err != nil {
+		return false, a.err
+	}
+	return a.hooks[hook], nil
+}
+
+// activate activates the plugin and returns the hooks implemented by it.
+func (p *Plugin) activate(ctx context.Context) (map[string]bool, error) {
+	resp, err := p.transport.activate(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to activate")
+	}
+
+	supported := false
+	for _, v := range resp.Versions {
+		if v == v1.Version {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return nil, fmt.Errorf("unsupported protocol versions %v, %s is required", resp.Versions, v1.Version)
+	}
+
+	hooks := make(map[string]bool, len(resp.Hooks))
+	for _, h := range resp.Hooks {
+		hooks[h] = true
+	}
+	log.With(ctx).Infof("hook plugin %s is activated with hooks %v", p.config.Name, resp.Hooks)
+	return hooks, nil
+}
+
+// call calls the hook of plugin in timeout of hook, and decodes the response
+// into resp if it's not empty. It returns false if the hook is not called,
+// because the plugin doesn't implement it or the failed call is ignored by
+// failure policy, then the input of hook should be left unchanged.
+func (p *Plugin) call(ctx context.Context, hook string, req, resp interface{}) (called bool, err0 error) {
+	hc := p.config.hookConfig(hook)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(hc.Timeout)*time.Second)
+	defer cancel()
+
+	ctx, span := trace.StartSpan(ctx, "HookPlugin."+hook,
+		trace.WithKind(trace.SpanKindClient),
+		trace.WithAttribute("hook.plugin", p.config.Name),
+	)
+
+	start := time.Now()
+	result := "skipped"
+	defer func() {
+		span.Finish(err0)
+		metrics.HookPluginCallsCounter.WithLabelValues(p.config.Name, hook, result).Inc()
+		if result != "skipped" {
+			metrics.HookPluginCallsTimer.WithLabelValues(p.config.Name, hook).Observe(time.Since(start).Seconds())
+		}
+	}()
+
+	err := func() error {
+		ok, err := p.implements(ctx, hook)
+		if err != nil || !ok {
+			return err
+		}
+
+		result = "success"
+		body, err := json.Marshal(req)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode request")
+		}
+
+		body, err = p.transport.call(ctx, hook, body)
+		if err != nil {
+			return err
+		}
+
+		called = true
+		if len(bytes.TrimSpace(body)) == 0 || resp == nil {
+			return nil
+		}
+		if err := json.Unmarshal(body, resp); err != nil {
+			called = false
+			return errors.Wrap(err, "failed to decode response")
+		}
+		return nil
+	}()
+	if err == nil {
+		return called, nil
+	}
+
+	result = "failure"
+	if ctx.Err() == context.DeadlineExceeded {
+		result = "timeout"
+		err = errors.Wrapf(err, "timeout after %ds", hc.Timeout)
+	}
+	err = errors.Wrapf(err, "failed to call hook %s of hook plugin %s", hook, p.config.Name)
+
+	if hc.FailurePolicy == FailurePolicyIgnore {
+		log.With(ctx).Warnf("%v, ignored by failure policy", err)
+		return false, nil
+	}
+	return false, err
+}
+
+// PreCreate calls hook ContainerPlugin.PreCreate of plugin.
+func (p *Plugin) PreCreate(ctx context.Context, config *types.ContainerCreateConfig) error {
+	var resp v1.ContainerPreCreateResponse
+	called, err := p.call(ctx, v1.HookContainerPreCreate, &v1.ContainerPreCreateRequest{Config: config}, &resp)
+	if err != nil {
+		return err
+	}
+
+	if called && resp.Config != nil {
+		*config = *resp.Config
+	}
+	return nil
+}
+
+// PreStart calls hook ContainerPlugin.PreStart of plugin, and returns the
+// priorities and args of prestart hooks.
+func (p *Plugin) PreStart(ctx context.Context, c interface{}) ([]int, [][]string, error) {
+	var resp v1.ContainerPreStartResponse
+	called, err := p.call(ctx, v1.HookContainerPreStart, &v1.ContainerPreStartRequest{Container: c}, &resp)
+	if err != nil || !called {
+		return nil, nil, err
+	}
+
+	var (
+		prioArr []int
+		argsArr [][]string
+	)
+	for _, h := range resp.Hooks {
+		prioArr = append(prioArr, h.Priority)
+		argsArr = append(argsArr, h.Args)
+	}
+	return prioArr, argsArr, nil
+}
+
+// PreCreateEndpoint calls hook ContainerPlugin.PreCreateEndpoint of plugin.
+func (p *Plugin) PreCreateEndpoint(ctx context.Context, cid string, env []string, endpoint *networktypes.Endpoint) error {
+	var resp v1.ContainerPreCreateEndpointResponse
+	called, err := p.call(ctx, v1.HookContainerPreCreateEndpoint, &v1.ContainerPreCreateEndpointRequest{
+		ContainerID: cid,
+		Env:         env,
+		Endpoint:    endpoint,
+	}, &resp)
+	if err != nil {
+		return err
+	}
+
+	if called && resp.Endpoint != nil {
+		*endpoint = *resp.Endpoint
+	}
+	return nil
+}
+
+// PreUpdate calls hook ContainerPlugin.PreUpdate of plugin, the update body
+// is read and passed to plugin.
+func (p *Plugin) PreUpdate(ctx context.Context, in io.ReadCloser) (io.ReadCloser, error) {
+	body, err := ioutil.ReadAll(in)
+	in.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &v1.ContainerPreUpdateRequest{}
+	if len(bytes.TrimSpace(body)) != 0 {
+		req.Body = body
+	}
+
+	var resp v1.ContainerPreUpdateResponse
+	called, err := p.call(ctx, v1.HookContainerPreUpdate, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if called && resp.Body != nil && string(bytes.TrimSpace(resp.Body)) != "null" {
+		body = resp.Body
+	}
+	return ioutil.NopCloser(bytes.NewReader(body)), nil
+}
+
+// PostUpdate calls hook ContainerPlugin.PostUpdate of plugin.
+func (p *Plugin) PostUpdate(ctx context.Context, rootfs string, env []string) error {
+	_, err := p.call(ctx, v1.HookContainerPostUpdate, &v1.ContainerPostUpdateRequest{Rootfs: rootfs, Env: env}, nil)
+	return err
+}
+
+// PreVolumeCreate calls hook VolumePlugin.PreVolumeCreate of plugin.
+func (p *Plugin) PreVolumeCreate(ctx context.Context, config *types.VolumeCreateConfig) error {
+	var resp v1.VolumePreVolumeCreateResponse
+	called, err := p.call(ctx, v1.HookVolumePreVolumeCreate, &v1.VolumePreVolumeCreateRequest{Config: config}, &resp)
+	if err != nil {
+		return err
+	}
+
+	if called && resp.Config != nil {
+		*config = *resp.Config
+	}
+	return nil
+}
+
+// PreCreateContainer calls hook CriPlugin.PreCreateContainer of plugin.
+func (p *Plugin) PreCreateContainer(ctx context.Context, config *types.ContainerCreateConfig, sandboxMeta interface{}) error {
+	var resp v1.CriPreCreateContainerResponse
+	called, err := p.call(ctx, v1.HookCriPreCreateContainer, &v1.CriPreCreateContainerRequest{
+		Config:      config,
+		SandboxMeta: sandboxMeta,
+	}, &resp)
+	if err != nil {
+		return err
+	}
+
+	if called && resp.Config != nil {
+		*config = *resp.Config
+	}
+	return nil
+}
+
+// PostPull calls hook ImagePlugin.PostPull of plugin.
+func (p *Plugin) PostPull(ctx context.Context, snapshotter string, image containerd.Image) error {
+	_, err := p.call(ctx, v1.HookImagePostPull, &v1.ImagePostPullRequest{
+		Snapshotter: snapshotter,
+		Image: v1.Image{
+			Name:   image.Name(),
+			Target: image.Target(),
+		},
+	}, nil)
+	return err
+}
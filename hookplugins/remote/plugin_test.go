@@ -0,0 +1,400 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/hookplugins/remote/v1"
+	networktypes "github.com/alibaba/pouch/network/types"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// testHooks are the hooks of test plugins, the key is the name of hook.
+type testHooks map[string]func(ctx context.Context, req []byte) ([]byte, error)
+
+// testServer is a JSON plugin serving on unix socket.
+type testServer struct {
+	address    string
+	activated  int32
+	activateFn func() error
+}
+
+func newTestServer(t *testing.T, hooks testHooks) (*testServer, func()) {
+	dir, err := ioutil.TempDir("", "hook-plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sock := filepath.Join(dir, "plugin.sock")
+	l, err := net.Listen("unix", sock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &testServer{address: "unix://" + sock}
+	mux := http.NewServeMux()
+	mux.HandleFunc(activatePath, func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&s.activated, 1)
+		if s.activateFn != nil {
+			if err := s.activateFn(); err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				return
+			}
+		}
+		resp := &v1.ActivateResponse{Versions: []string{v1.Version}}
+		for hook := range hooks {
+			resp.Hooks = append(resp.Hooks, hook)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	for hook, fn := range hooks {
+		fn := fn
+		mux.HandleFunc("/"+v1.Version+"/"+hook, func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, jsonContentType, req.Header.Get("Content-Type"))
+			body, _ := ioutil.ReadAll(req.Body)
+			resp, err := fn(req.Context(), body)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				json.NewEncoder(w).Encode(&v1.ErrorResponse{Err: err.Error()})
+				return
+			}
+			w.Write(resp)
+		})
+	}
+
+	server := &http.Server{Handler: mux}
+	go server.Serve(l)
+	return s, func() {
+		server.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func newTestPlugin(t *testing.T, config PluginConfig) *Plugin {
+	if config.Name == "" {
+		config.Name = "test"
+	}
+	assert.NoError(t, config.Validate())
+	p, err := NewPlugin(config)
+	assert.NoError(t, err)
+	return p
+}
+
+func TestPluginConfigValidate(t *testing.T) {
+	c := PluginConfig{Name: "quota", Address: "unix:///run/pouch/quota.sock"}
+	assert.NoError(t, c.Validate())
+	assert.Equal(t, ProtocolJSON, c.Protocol)
+	assert.Equal(t, defaultTimeout, c.Timeout)
+	assert.Equal(t, FailurePolicyFail, c.FailurePolicy)
+
+	c = PluginConfig{
+		Name:          "quota",
+		Address:       "tcp://127.0.0.1:9000",
+		Protocol:      ProtocolGRPC,
+		Timeout:       3,
+		FailurePolicy: FailurePolicyIgnore,
+		Hooks: map[string]HookConfig{
+			v1.HookContainerPreCreate: {FailurePolicy: FailurePolicyFail},
+		},
+		APIs: []string{"POST /containers/create"},
+	}
+	assert.NoError(t, c.Validate())
+	assert.Equal(t, HookConfig{Timeout: 3, FailurePolicy: FailurePolicyFail}, c.hookConfig(v1.HookContainerPreCreate))
+	assert.Equal(t, HookConfig{Timeout: 3, FailurePolicy: FailurePolicyIgnore}, c.hookConfig(v1.HookImagePostPull))
+
+	for _, c := range []PluginConfig{
+		{Name: "", Address: "unix:///run/quota.sock"},
+		{Name: "quota", Address: "/run/quota.sock"},
+		{Name: "quota", Address: "http://127.0.0.1:9000"},
+		{Name: "quota", Address: "unix:///run/quota.sock", Protocol: "http"},
+		{Name: "quota", Address: "unix:///run/quota.sock", Timeout: -1},
+		{Name: "quota", Address: "unix:///run/quota.sock", FailurePolicy: "retry"},
+		{Name: "quota", Address: "unix:///run/quota.sock", Hooks: map[string]HookConfig{"ContainerPlugin.PreStop": {}}},
+		{Name: "quota", Address: "unix:///run/quota.sock", Hooks: map[string]HookConfig{v1.HookContainerPreCreate: {FailurePolicy: "retry"}}},
+		{Name: "quota", Address: "unix:///run/quota.sock", APIs: []string{"/containers/create"}},
+		{Name: "quota", Address: "unix:///run/quota.sock", APIs: []string{"POST containers/create"}},
+		{Name: "quota", Address: "unix:///run/quota.sock", APIs: []string{"POST /containers/{id}/attach"}},
+		{Name: "quota", Address: "unix:///run/quota.sock", APIs: []string{"GET /events"}},
+	} {
+		assert.Error(t, c.Validate(), c.Name+" "+c.Address)
+	}
+}
+
+func TestNewPlugins(t *testing.T) {
+	plugins, err := NewPlugins([]PluginConfig{
+		{Name: "a", Address: "unix:///run/a.sock", Protocol: ProtocolJSON},
+		{Name: "b", Address: "unix:///run/b.sock", Protocol: ProtocolJSON, Priority: 10},
+		{Name: "c", Address: "unix:///run/c.sock", Protocol: ProtocolGRPC},
+	})
+	assert.NoError(t, err)
+
+	var names []string
+	for _, p := range plugins {
+		names = append(names, p.Name())
+		p.Close()
+	}
+	assert.Equal(t, []string{"b", "a", "c"}, names)
+}
+
+func TestJSONPlugin(t *testing.T) {
+	server, cleanup := newTestServer(t, testHooks{
+		v1.HookContainerPreCreate: func(ctx context.Context, body []byte) ([]byte, error) {
+			var req v1.ContainerPreCreateRequest
+			assert.NoError(t, json.Unmarshal(body, &req))
+			req.Config.Env = append(req.Config.Env, "HOOK=1")
+			return json.Marshal(&v1.ContainerPreCreateResponse{Config: req.Config})
+		},
+		v1.HookContainerPreStart: func(ctx context.Context, body []byte) ([]byte, error) {
+			return json.Marshal(&v1.ContainerPreStartResponse{Hooks: []v1.PreStartHook{
+				{Priority: 1, Args: []string{"/usr/bin/prestart", "quota"}},
+			}})
+		},
+		v1.HookContainerPreUpdate: func(ctx context.Context, body []byte) ([]byte, error) {
+			var req v1.ContainerPreUpdateRequest
+			assert.NoError(t, json.Unmarshal(body, &req))
+			assert.JSONEq(t, `{"CpuShares":2}`, string(req.Body))
+			return []byte(`{"Body":{"CpuShares":4}}`), nil
+		},
+		// the empty response leaves the input unchanged.
+		v1.HookVolumePreVolumeCreate: func(ctx context.Context, body []byte) ([]byte, error) {
+			return nil, nil
+		},
+	})
+	defer cleanup()
+
+	p := newTestPlugin(t, PluginConfig{Address: server.address})
+	defer p.Close()
+	ctx := context.Background()
+
+	config := &types.ContainerCreateConfig{}
+	config.Image = "busybox"
+	assert.NoError(t, p.PreCreate(ctx, config))
+	assert.Equal(t, "busybox", config.Image)
+	assert.Equal(t, []string{"HOOK=1"}, config.Env)
+
+	prios, args, err := p.PreStart(ctx, map[string]string{"ID": "c1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, prios)
+	assert.Equal(t, [][]string{{"/usr/bin/prestart", "quota"}}, args)
+
+	out, err := p.PreUpdate(ctx, ioutil.NopCloser(strings.NewReader(`{"CpuShares":2}`)))
+	assert.NoError(t, err)
+	body, _ := ioutil.ReadAll(out)
+	assert.JSONEq(t, `{"CpuShares":4}`, string(body))
+
+	volume := &types.VolumeCreateConfig{Name: "v1"}
+	assert.NoError(t, p.PreVolumeCreate(ctx, volume))
+	assert.Equal(t, "v1", volume.Name)
+
+	// the hooks not implemented are not called.
+	endpoint := &networktypes.Endpoint{Name: "bridge"}
+	assert.NoError(t, p.PreCreateEndpoint(ctx, "c1", nil, endpoint))
+	assert.Equal(t, "bridge", endpoint.Name)
+	assert.NoError(t, p.PostUpdate(ctx, "/rootfs", nil))
+
+	// the plugin is activated once.
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.activated))
+}
+
+func TestPluginFailurePolicy(t *testing.T) {
+	server, cleanup := newTestServer(t, testHooks{
+		v1.HookContainerPreCreate: func(ctx context.Context, body []byte) ([]byte, error) {
+			return nil, errors.New("quota exceeded")
+		},
+		v1.HookContainerPreUpdate: func(ctx context.Context, body []byte) ([]byte, error) {
+			return nil, errors.New("not allowed")
+		},
+		v1.HookContainerPostUpdate: func(ctx context.Context, body []byte) ([]byte, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	})
+	defer cleanup()
+
+	p := newTestPlugin(t, PluginConfig{
+		Address: server.address,
+		Timeout: 1,
+		Hooks: map[string]HookConfig{
+			v1.HookContainerPreUpdate: {FailurePolicy: FailurePolicyIgnore},
+		},
+	})
+	defer p.Close()
+	ctx := context.Background()
+
+	err := p.PreCreate(ctx, &types.ContainerCreateConfig{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to call hook ContainerPlugin.PreCreate of hook plugin test")
+		assert.Contains(t, err.Error(), "quota exceeded")
+	}
+
+	// the failed call is ignored, and the body is passed on unchanged.
+	out, err := p.PreUpdate(ctx, ioutil.NopCloser(strings.NewReader(`{"CpuShares":2}`)))
+	assert.NoError(t, err)
+	body, _ := ioutil.ReadAll(out)
+	assert.Equal(t, `{"CpuShares":2}`, string(body))
+
+	err = p.PostUpdate(ctx, "/rootfs", nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "timeout after 1s")
+	}
+}
+
+func TestPluginActivateRetry(t *testing.T) {
+	var calls int32
+	server, cleanup := newTestServer(t, testHooks{
+		v1.HookContainerPreCreate: func(ctx context.Context, body []byte) ([]byte, error) {
+			atomic.AddInt32(&calls, 1)
+			return nil, nil
+		},
+	})
+	defer cleanup()
+
+	server.activateFn = func() error {
+		if atomic.LoadInt32(&server.activated) == 1 {
+			return errors.New("starting")
+		}
+		return nil
+	}
+
+	p := newTestPlugin(t, PluginConfig{Address: server.address})
+	defer p.Close()
+
+	err := p.PreCreate(context.Background(), &types.ContainerCreateConfig{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to activate")
+	}
+	assert.NoError(t, p.PreCreate(context.Background(), &types.ContainerCreateConfig{}))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&server.activated))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestPluginConcurrentActivate(t *testing.T) {
+	var calls int32
+	server, cleanup := newTestServer(t, testHooks{
+		v1.HookContainerPreCreate: func(ctx context.Context, body []byte) ([]byte, error) {
+			atomic.AddInt32(&calls, 1)
+			return nil, nil
+		},
+	})
+	defer cleanup()
+
+	release := make(chan struct{})
+	server.activateFn = func() error {
+		<-release
+		return nil
+	}
+
+	p := newTestPlugin(t, PluginConfig{Address: server.address})
+	defer p.Close()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- p.PreCreate(context.Background(), &types.ContainerCreateConfig{})
+		}()
+	}
+
+	for atomic.LoadInt32(&server.activated) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the hook call waiting for activation gives up on its own deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := p.PreCreate(ctx, &types.ContainerCreateConfig{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to wait for activation")
+	}
+
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.activated))
+	assert.Equal(t, int32(5), atomic.LoadInt32(&calls))
+}
+
+// testGRPCServer is a gRPC plugin.
+type testGRPCServer struct {
+	hooks testHooks
+}
+
+func (s *testGRPCServer) Activate(ctx context.Context, req *v1.ActivateRequest) (*v1.ActivateResponse, error) {
+	resp := &v1.ActivateResponse{Versions: []string{"v2", v1.Version}}
+	for hook := range s.hooks {
+		resp.Hooks = append(resp.Hooks, hook)
+	}
+	return resp, nil
+}
+
+func (s *testGRPCServer) Call(ctx context.Context, req *v1.CallRequest) (*v1.CallResponse, error) {
+	resp, err := s.hooks[req.Hook](ctx, req.Request)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CallResponse{Response: resp}, nil
+}
+
+func TestGRPCPlugin(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hook-plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	sock := filepath.Join(dir, "plugin.sock")
+	l, err := net.Listen("unix", sock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := grpc.NewServer()
+	v1.RegisterHookPluginServer(server, &testGRPCServer{hooks: testHooks{
+		v1.HookCriPreCreateContainer: func(ctx context.Context, body []byte) ([]byte, error) {
+			var req v1.CriPreCreateContainerRequest
+			assert.NoError(t, json.Unmarshal(body, &req))
+			assert.Equal(t, map[string]interface{}{"ID": "sandbox"}, req.SandboxMeta)
+			req.Config.Labels = map[string]string{"sandbox": "sandbox"}
+			return json.Marshal(&v1.CriPreCreateContainerResponse{Config: req.Config})
+		},
+		v1.HookContainerPostUpdate: func(ctx context.Context, body []byte) ([]byte, error) {
+			return nil, errors.New("rootfs is readonly")
+		},
+	}})
+	go server.Serve(l)
+	defer server.Stop()
+
+	p := newTestPlugin(t, PluginConfig{Address: "unix://" + sock, Protocol: ProtocolGRPC})
+	defer p.Close()
+	ctx := context.Background()
+
+	config := &types.ContainerCreateConfig{}
+	assert.NoError(t, p.PreCreateContainer(ctx, config, map[string]string{"ID": "sandbox"}))
+	assert.Equal(t, map[string]string{"sandbox": "sandbox"}, config.Labels)
+
+	err = p.PostUpdate(ctx, "/rootfs", nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "rootfs is readonly")
+	}
+
+	// the hooks not implemented are not called.
+	assert.NoError(t, p.PreCreate(ctx, &types.ContainerCreateConfig{}))
+}
@@ -0,0 +1,172 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/alibaba/pouch/hookplugins/remote/v1"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/trace"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// jsonContentType is the Content-Type of requests and responses of JSON protocol.
+	jsonContentType = "application/json"
+
+	// activatePath is the path to activate plugin in JSON protocol, the hooks
+	// are called on /<version>/<hook>.
+	activatePath = "/HookPlugin.Activate"
+
+	// maxErrorSize is the max size of the error message of a failed JSON call.
+	maxErrorSize = 4096
+
+	dialTimeout = 10 * time.Second
+)
+
+// transport calls the plugin in a protocol, the deadline of call is set in ctx.
+type transport interface {
+	activate(ctx context.Context) (*v1.ActivateResponse, error)
+	call(ctx context.Context, hook string, request []byte) ([]byte, error)
+	close() error
+}
+
+func newTransport(config PluginConfig) (transport, error) {
+	u, err := url.Parse(config.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Protocol == ProtocolGRPC {
+		return newGRPCTransport(u)
+	}
+	return newJSONTransport(u)
+}
+
+// jsonTransport posts the JSON requests over http.
+type jsonTransport struct {
+	baseURL string
+	client  *http.Client
+}
+
+func newJSONTransport(u *url.URL) (*jsonTransport, error) {
+	_, baseURL, _, err := httputils.ParseHost(u.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonTransport{
+		baseURL: baseURL,
+		client:  httputils.NewHTTPClient(u, nil, dialTimeout, 0),
+	}, nil
+}
+
+func (t *jsonTransport) activate(ctx context.Context) (*v1.ActivateResponse, error) {
+	body, err := t.post(ctx, activatePath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ActivateResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, fmt.Errorf("failed to decode activate response: %v", err)
+	}
+	return resp, nil
+}
+
+func (t *jsonTransport) call(ctx context.Context, hook string, request []byte) ([]byte, error) {
+	return t.post(ctx, "/"+v1.Version+"/"+hook, request)
+}
+
+func (t *jsonTransport) post(ctx context.Context, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodPost, t.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", jsonContentType)
+	req.Header.Set("Accept", jsonContentType)
+	trace.InjectHTTP(ctx, req.Header)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorSize))
+		var errResp v1.ErrorResponse
+		if json.Unmarshal(msg, &errResp) == nil && errResp.Err != "" {
+			return nil, fmt.Errorf("%s", errResp.Err)
+		}
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (t *jsonTransport) close() error {
+	if tr, ok := t.client.Transport.(*http.Transport); ok {
+		tr.CloseIdleConnections()
+	}
+	return nil
+}
+
+// grpcTransport calls the gRPC service HookPlugin.
+type grpcTransport struct {
+	conn   *grpc.ClientConn
+	client v1.HookPluginClient
+}
+
+func newGRPCTransport(u *url.URL) (*grpcTransport, error) {
+	network, addr := "tcp", u.Host
+	if u.Scheme == "unix" {
+		network, addr = "unix", u.Path
+	}
+
+	// the connection is established in background, and reconnected if the
+	// plugin restarts.
+	conn, err := grpc.Dial(addr,
+		grpc.WithInsecure(),
+		grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+			return net.DialTimeout(network, addr, dialTimeout)
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcTransport{
+		conn:   conn,
+		client: v1.NewHookPluginClient(conn),
+	}, nil
+}
+
+func (t *grpcTransport) activate(ctx context.Context) (*v1.ActivateResponse, error) {
+	return t.client.Activate(trace.InjectGRPC(ctx), &v1.ActivateRequest{})
+}
+
+func (t *grpcTransport) call(ctx context.Context, hook string, request []byte) ([]byte, error) {
+	resp, err := t.client.Call(trace.InjectGRPC(ctx), &v1.CallRequest{
+		Version: v1.Version,
+		Hook:    hook,
+		Request: request,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response, nil
+}
+
+func (t *grpcTransport) close() error {
+	return t.conn.Close()
+}
@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/alibaba/pouch/apis/types"
+	networktypes "github.com/alibaba/pouch/network/types"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// Version is the version of hook plugin protocol in this package.
+const Version = "v1"
+
+// The names of hooks, a plugin returns the names of hooks it implements on
+// activation, and the other hooks are not called.
+const (
+	HookContainerPreCreate         = "ContainerPlugin.PreCreate"
+	HookContainerPreStart          = "ContainerPlugin.PreStart"
+	HookContainerPreCreateEndpoint = "ContainerPlugin.PreCreateEndpoint"
+	HookContainerPreUpdate         = "ContainerPlugin.PreUpdate"
+	HookContainerPostUpdate        = "ContainerPlugin.PostUpdate"
+	HookVolumePreVolumeCreate      = "VolumePlugin.PreVolumeCreate"
+	HookCriPreCreateContainer      = "CriPlugin.PreCreateContainer"
+	HookImagePostPull              = "ImagePlugin.PostPull"
+	HookAPIPreHandle               = "APIPlugin.PreHandle"
+	HookAPIPostHandle              = "APIPlugin.PostHandle"
+)
+
+// Hooks are all the hooks of protocol v1.
+var Hooks = []string{
+	HookContainerPreCreate,
+	HookContainerPreStart,
+	HookContainerPreCreateEndpoint,
+	HookContainerPreUpdate,
+	HookContainerPostUpdate,
+	HookVolumePreVolumeCreate,
+	HookCriPreCreateContainer,
+	HookImagePostPull,
+	HookAPIPreHandle,
+	HookAPIPostHandle,
+}
+
+// The requests and responses of hooks below are encoded in JSON. In the
+// responses, a null or absent field leaves the input of hook unchanged.
+
+// ContainerPreCreateRequest is the request of ContainerPlugin.PreCreate.
+type ContainerPreCreateRequest struct {
+	Config *types.ContainerCreateConfig `json:"Config"`
+}
+
+// ContainerPreCreateResponse is the response of ContainerPlugin.PreCreate.
+type ContainerPreCreateResponse struct {
+	Config *types.ContainerCreateConfig `json:"Config,omitempty"`
+}
+
+// ContainerPreStartRequest is the request of ContainerPlugin.PreStart.
+type ContainerPreStartRequest struct {
+	Container interface{} `json:"Container"`
+}
+
+// ContainerPreStartResponse is the response of ContainerPlugin.PreStart.
+type ContainerPreStartResponse struct {
+	Hooks []PreStartHook `json:"Hooks,omitempty"`
+}
+
+// PreStartHook is a prestart hook of runc, the hooks are sorted by priority,
+// and network hook always has priority 0.
+type PreStartHook struct {
+	Priority int      `json:"Priority"`
+	Args     []string `json:"Args"`
+}
+
+// ContainerPreCreateEndpointRequest is the request of ContainerPlugin.PreCreateEndpoint.
+type ContainerPreCreateEndpointRequest struct {
+	ContainerID string                 `json:"ContainerID"`
+	Env         []string               `json:"Env"`
+	Endpoint    *networktypes.Endpoint `json:"Endpoint"`
+}
+
+// ContainerPreCreateEndpointResponse is the response of ContainerPlugin.PreCreateEndpoint.
+type ContainerPreCreateEndpointResponse struct {
+	Endpoint *networktypes.Endpoint `json:"Endpoint,omitempty"`
+}
+
+// ContainerPreUpdateRequest is the request of ContainerPlugin.PreUpdate,
+// Body is the body of container update request.
+type ContainerPreUpdateRequest struct {
+	Body json.RawMessage `json:"Body"`
+}
+
+// ContainerPreUpdateResponse is the response of ContainerPlugin.PreUpdate.
+type ContainerPreUpdateResponse struct {
+	Body json.RawMessage `json:"Body,omitempty"`
+}
+
+// ContainerPostUpdateRequest is the request of ContainerPlugin.PostUpdate.
+type ContainerPostUpdateRequest struct {
+	Rootfs string   `json:"Rootfs"`
+	Env    []string `json:"Env"`
+}
+
+// VolumePreVolumeCreateRequest is the request of VolumePlugin.PreVolumeCreate.
+type VolumePreVolumeCreateRequest struct {
+	Config *types.VolumeCreateConfig `json:"Config"`
+}
+
+// VolumePreVolumeCreateResponse is the response of VolumePlugin.PreVolumeCreate.
+type VolumePreVolumeCreateResponse struct {
+	Config *types.VolumeCreateConfig `json:"Config,omitempty"`
+}
+
+// CriPreCreateContainerRequest is the request of CriPlugin.PreCreateContainer,
+// SandboxMeta is the metadata of the sandbox which container belongs to.
+type CriPreCreateContainerRequest struct {
+	Config      *types.ContainerCreateConfig `json:"Config"`
+	SandboxMeta interface{}                  `json:"SandboxMeta"`
+}
+
+// CriPreCreateContainerResponse is the response of CriPlugin.PreCreateContainer.
+type CriPreCreateContainerResponse struct {
+	Config *types.ContainerCreateConfig `json:"Config,omitempty"`
+}
+
+// ImagePostPullRequest is the request of ImagePlugin.PostPull.
+type ImagePostPullRequest struct {
+	Snapshotter string `json:"Snapshotter"`
+	Image       Image  `json:"Image"`
+}
+
+// Image is the image pulled.
+type Image struct {
+	Name   string             `json:"Name"`
+	Target ocispec.Descriptor `json:"Target"`
+}
+
+// APIRequest is the http request of API proxied through plugin, Path is the
+// path template of API, such as /containers/{name:.*}/start.
+type APIRequest struct {
+	Method string      `json:"Method"`
+	Path   string      `json:"Path"`
+	URL    string      `json:"URL"`
+	Header http.Header `json:"Header"`
+	Body   []byte      `json:"Body"`
+}
+
+// APIResponse is the http response of API proxied through plugin.
+type APIResponse struct {
+	StatusCode int         `json:"StatusCode"`
+	Header     http.Header `json:"Header"`
+	Body       []byte      `json:"Body"`
+}
+
+// APIPreHandleRequest is the request of APIPlugin.PreHandle.
+type APIPreHandleRequest struct {
+	Request *APIRequest `json:"Request"`
+}
+
+// APIPreHandleResponse is the response of APIPlugin.PreHandle. The header
+// and body of Request replace the ones of request passed to pouchd, and
+// Response is returned to client without calling pouchd.
+type APIPreHandleResponse struct {
+	Request  *APIRequest  `json:"Request,omitempty"`
+	Response *APIResponse `json:"Response,omitempty"`
+}
+
+// APIPostHandleRequest is the request of APIPlugin.PostHandle, the body of
+// Request is left out.
+type APIPostHandleRequest struct {
+	Request  *APIRequest  `json:"Request"`
+	Response *APIResponse `json:"Response"`
+}
+
+// APIPostHandleResponse is the response of APIPlugin.PostHandle, Response
+// replaces the one returned to client.
+type APIPostHandleResponse struct {
+	Response *APIResponse `json:"Response,omitempty"`
+}
+
+// ErrorResponse is the body of the failed hook call of JSON protocol.
+type ErrorResponse struct {
+	Err string `json:"Err"`
+}
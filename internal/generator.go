@@ -52,3 +52,13 @@ func GenVolumeMgr(cfg *config.Config, d DaemonProvider) (mgr.VolumeMgr, error) {
 func GenNetworkMgr(cfg *config.Config, d DaemonProvider) (mgr.NetworkMgr, error) {
 	return mgr.NewNetworkManager(cfg, d.MetaStore(), d.CtrMgr(), d.EventsService())
 }
+
+// GenPodMgr generates a PodMgr instance according to config cfg.
+func GenPodMgr(ctx context.Context, cfg *config.Config, d DaemonProvider) (mgr.PodMgr, error) {
+	return mgr.NewPodManager(ctx, cfg, d.CtrMgr(), d.ImgMgr(), d.EventsService())
+}
+
+// GenSecretMgr generates a SecretMgr instance according to config cfg.
+func GenSecretMgr(cfg *config.Config, d DaemonProvider) (mgr.SecretMgr, error) {
+	return mgr.NewSecretManager(cfg, d.CtrMgr())
+}
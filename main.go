@@ -15,9 +15,12 @@ import (
 	"github.com/alibaba/pouch/daemon"
 	"github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/lxcfs"
+	"github.com/alibaba/pouch/pkg/audit"
+	"github.com/alibaba/pouch/pkg/cdi"
 	"github.com/alibaba/pouch/pkg/debug"
 	"github.com/alibaba/pouch/pkg/kernel"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/meta"
 	"github.com/alibaba/pouch/pkg/system"
 	"github.com/alibaba/pouch/pkg/utils"
 	"github.com/alibaba/pouch/storage/quota"
@@ -34,6 +37,7 @@ import (
 var (
 	sigHandles   []func() error
 	printVersion bool
+	restoreFrom  string
 	logOpts      []string
 	cfg          = &config.Config{}
 )
@@ -73,9 +77,10 @@ func setupFlags(cmd *cobra.Command) {
 	flagSet := cmd.Flags()
 
 	flagSet.StringVar(&cfg.HomeDir, "home-dir", "/var/lib/pouch", "Specify root dir of pouchd")
-	flagSet.StringArrayVarP(&cfg.Listen, "listen", "l", []string{"unix:///var/run/pouchd.sock"}, "Specify listening addresses of Pouchd")
+	flagSet.StringArrayVarP(&cfg.Listen, "listen", "l", []string{"unix:///var/run/pouchd.sock"}, "Specify listening addresses of Pouchd(tcp|unix|fd|vsock), options of listener are set in query, such as unix:///var/run/pouchd.sock?group=docker&mode=0660")
 	flagSet.BoolVar(&cfg.IsCriEnabled, "enable-cri", false, "Specify whether enable the cri part of pouchd which is used to support Kubernetes")
 	flagSet.StringVar(&cfg.CriConfig.CriVersion, "cri-version", "v1alpha2", "Specify the version of cri which is used to support Kubernetes")
+	flagSet.StringArrayVar(&cfg.GRPCListen, "grpc-listen", nil, "Specify listening addresses of gRPC API(tcp|unix|fd|vsock) in the same format as --listen, gRPC API is disabled if not set")
 	flagSet.StringVar(&cfg.CriConfig.Listen, "listen-cri", "unix:///var/run/pouchcri.sock", "Specify listening address of CRI")
 	flagSet.StringVar(&cfg.CriConfig.NetworkPluginBinDir, "cni-bin-dir", "/opt/cni/bin", "The directory for putting cni plugin binaries.")
 	flagSet.StringVar(&cfg.CriConfig.NetworkPluginConfDir, "cni-conf-dir", "/etc/cni/net.d", "The directory for putting cni plugin configuration files.")
@@ -103,6 +108,7 @@ func setupFlags(cmd *cobra.Command) {
 	flagSet.StringVar(&cfg.ImageProxy, "image-proxy", "", "Http proxy to pull image")
 	flagSet.StringVar(&cfg.QuotaDriver, "quota-driver", "", "Set quota driver(grpquota/prjquota), if not set, it will set by kernel version")
 	flagSet.StringVar(&cfg.ConfigFile, "config-file", "/etc/pouch/config.json", "Configuration file of pouchd")
+	flagSet.StringVar(&restoreFrom, "restore-from", "", "Restore the state of pouchd from the archive of 'pouch system backup' before starting, home dir must be fresh")
 	flagSet.StringVar(&cfg.Snapshotter, "snapshotter", "overlayfs", "Snapshotter driver of pouchd, it will be passed to containerd")
 	flagSet.BoolVar(&cfg.AllowMultiSnapshotter, "allow-multi-snapshotter", false, "If set true, pouchd will allow multi snapshotter")
 
@@ -144,9 +150,38 @@ func setupFlags(cmd *cobra.Command) {
 	// registry
 	flagSet.StringArrayVar(&cfg.InsecureRegistries, "insecure-registries", []string{}, "enable insecure registry")
 	flagSet.StringArrayVar(&cfg.RegistryMirrors, "registry-mirrors", []string{}, "preferred mirror registry list")
+	flagSet.StringArrayVar(&cfg.CDISpecDirs, "cdi-spec-dir", cdi.DefaultSpecDirs, "Set directories to load Container Device Interface specs")
 
 	// buildkit
 	flagSet.BoolVar(&cfg.EnableBuilder, "enable-builder", false, "Enable buildkit functionality")
+	flagSet.StringVar(&cfg.BuilderDiskQuota, "builder-disk-quota", "", "Set disk quota for the rootfs of build step container")
+
+	// secret
+	flagSet.StringVar(&cfg.MetaStoreDriver, "meta-store-driver", meta.DefaultStore, "Set the backend of container meta store(local|boltdb), use 'pouchd meta migrate' to change it of an existing host")
+	flagSet.StringVar(&cfg.SecretKeyFile, "secret-key-file", "", "Key file to encrypt secrets at rest, <home-dir>/secrets/secret.key by default")
+
+	// per-container metrics
+	flagSet.BoolVar(&cfg.EnableContainerMetrics, "enable-container-metrics", false, "Enable exporting per-container metrics on /metrics")
+	flagSet.IntVar(&cfg.ContainerMetricsCollectPeriod, "container-metrics-collect-period", 10, "The time duration (in time.Second) to collect per-container metrics")
+	flagSet.StringArrayVar(&cfg.ContainerMetricsLabels, "container-metrics-label", []string{}, "Container label exported as the label of per-container metrics")
+
+	// shutdown
+	flagSet.StringVar(&cfg.ShutdownMode, "shutdown-mode", config.ShutdownModeLiveRestore, "Set what to do with the running containers when pouchd stops(live-restore|stop)")
+	flagSet.IntVar(&cfg.ShutdownTimeout, "shutdown-timeout", 60, "The time duration (in time.Second) to stop all the containers when pouchd stops with shutdown mode stop")
+
+	// audit log
+	flagSet.BoolVar(&cfg.Audit.Enable, "enable-audit-log", false, "Enable recording API calls in audit log")
+	flagSet.StringVar(&cfg.Audit.Driver, "audit-log-driver", audit.FileDriver, "Set where to write audit log(file|syslog)")
+	flagSet.StringVar(&cfg.Audit.Path, "audit-log-path", "/var/log/pouch/audit.log", "Set the file path of audit log")
+	flagSet.IntVar(&cfg.Audit.MaxSize, "audit-log-max-size", 100, "Set the max size (in megabytes) of audit log file before rotated")
+	flagSet.IntVar(&cfg.Audit.MaxBackups, "audit-log-max-backups", 5, "Set the max number of rotated audit log files to retain")
+	flagSet.StringVar(&cfg.Audit.SyslogAddress, "audit-log-syslog-address", "", "Set the address of syslog server for audit log, such as udp://127.0.0.1:514")
+	flagSet.StringVar(&cfg.Audit.SyslogTag, "audit-log-syslog-tag", "pouchd-audit", "Set the tag of syslog message for audit log")
+	flagSet.BoolVar(&cfg.Audit.IncludeReads, "audit-log-include-reads", false, "Record the read-only API calls in audit log as well")
+
+	// tracing
+	flagSet.StringVar(&cfg.Tracing.Endpoint, "tracing-endpoint", "", "Set the OTLP/HTTP endpoint to export the spans of requests to, such as http://127.0.0.1:4318, tracing is disabled if not set")
+	flagSet.Float64Var(&cfg.Tracing.SampleRatio, "tracing-sample-ratio", 1, "Set the ratio of the traces started by pouchd to be sampled, the traces from clients follow their sampled flag")
 }
 
 // runDaemon prepares configs, setups essential details and runs pouchd daemon.
@@ -240,6 +275,13 @@ func runDaemon(cmd *cobra.Command) error {
 		signalCh = make(chan os.Signal, 1)
 	)
 
+	// restore the state of pouchd before the meta stores are opened.
+	if restoreFrom != "" {
+		if err := daemon.RestoreFrom(cfg, restoreFrom); err != nil {
+			return err
+		}
+	}
+
 	// new daemon instance, this is core.
 	d := daemon.NewDaemon(cfg)
 	if d == nil {
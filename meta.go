@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/daemon"
+	"github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// MetaCommand is used to implement 'meta' command.
+type MetaCommand struct {
+	cmd *cobra.Command
+}
+
+// MetaMigrateCommand is used to implement 'meta migrate' command.
+type MetaMigrateCommand struct {
+	cmd *cobra.Command
+
+	homeDir string
+	pidfile string
+	from    string
+	to      string
+}
+
+func init() {
+	metaCommand := &MetaCommand{}
+	metaCommand.cmd = &cobra.Command{
+		Use:   "meta",
+		Short: "Manage the meta store of pouchd",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	migrateCommand := &MetaMigrateCommand{}
+	migrateCommand.cmd = &cobra.Command{
+		Use:   "migrate --from DRIVER --to DRIVER",
+		Short: "Migrate the container meta store to another backend",
+		Long: "Migrate the container meta store from a backend to another, such as from local to boltdb. " +
+			"It must be run while pouchd is stopped, the old meta data is saved in a backup file before migration, " +
+			"and pouchd should be started with '--meta-store-driver' of the new backend after migration.",
+		Args:          cobra.NoArgs,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return migrateCommand.runMetaMigrate(args)
+		},
+	}
+	migrateCommand.addFlags()
+
+	metaCommand.cmd.AddCommand(migrateCommand.cmd)
+	rootCmd.AddCommand(metaCommand.cmd)
+}
+
+// addFlags adds flags for specific command.
+func (m *MetaMigrateCommand) addFlags() {
+	flagSet := m.cmd.Flags()
+
+	flagSet.StringVar(&m.homeDir, "home-dir", "/var/lib/pouch", "Specify root dir of pouchd")
+	flagSet.StringVar(&m.pidfile, "pidfile", "/var/run/pouch.pid", "Specify the pid file of pouchd, to check pouchd is stopped")
+	flagSet.StringVar(&m.from, "from", "", "Specify the backend to migrate from(local|boltdb)")
+	flagSet.StringVar(&m.to, "to", "", "Specify the backend to migrate to(local|boltdb)")
+}
+
+func (m *MetaMigrateCommand) runMetaMigrate(args []string) error {
+	if m.from == "" || m.to == "" {
+		return fmt.Errorf("both --from and --to must be specified")
+	}
+	if m.from == m.to {
+		return fmt.Errorf("--from and --to must be different backends")
+	}
+
+	if pid, err := readPidfile(m.pidfile); err == nil && utils.IsProcessAlive(pid) {
+		return fmt.Errorf("pouchd is running with pid %d, stop it before migration", pid)
+	}
+
+	backupFile := path.Join(m.homeDir, fmt.Sprintf("containers-meta-%s-%s.backup", m.from, time.Now().Format("20060102150405")))
+
+	result, err := meta.Migrate(
+		daemon.ContainerMetaConfig(m.homeDir, m.from),
+		daemon.ContainerMetaConfig(m.homeDir, m.to),
+		backupFile,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to migrate container meta store from %s to %s: %v", m.from, m.to, err)
+	}
+
+	fmt.Printf("%d containers are migrated from %s to %s, the old meta data is saved in %s\n",
+		result.Objects[meta.MetaJSONFile], m.from, m.to, result.BackupFile)
+	fmt.Printf("start pouchd with --meta-store-driver %s to use the migrated meta store\n", m.to)
+	return nil
+}
+
+// readPidfile reads the pid of pouchd from pid file.
+func readPidfile(file string) (int, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(data)))
+}
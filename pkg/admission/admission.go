@@ -0,0 +1,288 @@
+package admission
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Class is the class of API endpoints which share the same limits.
+type Class string
+
+const (
+	// ClassMutating is the endpoints which change the state of daemon, such
+	// as creating container.
+	ClassMutating Class = "mutating"
+
+	// ClassStreaming is the endpoints which hold the connection for a long
+	// time, such as attach, logs and events.
+	ClassStreaming Class = "streaming"
+
+	// ClassRead is the endpoints which read the state of daemon.
+	ClassRead Class = "read"
+)
+
+// Classes are all the classes of API endpoints.
+var Classes = []Class{ClassMutating, ClassStreaming, ClassRead}
+
+var (
+	defaultQueueTimeout = 5
+	defaultRetryAfter   = 1
+)
+
+// ErrRejected represents the request is rejected since it's over limit.
+var ErrRejected = errors.New("too many requests")
+
+// Config is the configuration of API admission control, the classes without
+// limits are not controlled.
+type Config struct {
+	Mutating  Limit `json:"mutating,omitempty"`
+	Streaming Limit `json:"streaming,omitempty"`
+	Read      Limit `json:"read,omitempty"`
+}
+
+// Limit is the concurrency limits of a class of API endpoints.
+type Limit struct {
+	// MaxInflight is the max number of concurrent requests of the class,
+	// 0 means unlimited.
+	MaxInflight int `json:"max-inflight,omitempty"`
+
+	// MaxInflightPerClient is the max number of concurrent requests of the
+	// class from a client, 0 means unlimited.
+	MaxInflightPerClient int `json:"max-inflight-per-client,omitempty"`
+
+	// MaxQueued is the max number of requests waiting for admission, the
+	// requests over limit are rejected at once if it's 0.
+	MaxQueued int `json:"max-queued,omitempty"`
+
+	// QueueTimeout is the max seconds that a request waits for admission,
+	// 5 by default.
+	QueueTimeout int `json:"queue-timeout,omitempty"`
+
+	// RetryAfter is the seconds that the rejected client is asked to wait
+	// before retrying, 1 by default.
+	RetryAfter int `json:"retry-after,omitempty"`
+}
+
+// Enabled returns whether the class is limited.
+func (l *Limit) Enabled() bool {
+	return l.MaxInflight > 0 || l.MaxInflightPerClient > 0
+}
+
+// Limit returns the limit of class.
+func (c *Config) Limit(class Class) *Limit {
+	switch class {
+	case ClassMutating:
+		return &c.Mutating
+	case ClassStreaming:
+		return &c.Streaming
+	default:
+		return &c.Read
+	}
+}
+
+// Enabled returns whether any class is limited.
+func (c *Config) Enabled() bool {
+	for _, class := range Classes {
+		if c.Limit(class).Enabled() {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate validates the config, and fills the default values.
+func (c *Config) Validate() error {
+	for _, class := range Classes {
+		l := c.Limit(class)
+		if l.MaxInflight < 0 || l.MaxInflightPerClient < 0 || l.MaxQueued < 0 || l.QueueTimeout < 0 || l.RetryAfter < 0 {
+			return fmt.Errorf("limits of %s requests in admission control must not be negative", class)
+		}
+		if l.MaxQueued > 0 && !l.Enabled() {
+			return fmt.Errorf("max-queued of %s requests in admission control requires max-inflight or max-inflight-per-client", class)
+		}
+		if l.QueueTimeout == 0 {
+			l.QueueTimeout = defaultQueueTimeout
+		}
+		if l.RetryAfter == 0 {
+			l.RetryAfter = defaultRetryAfter
+		}
+	}
+	return nil
+}
+
+// Stats is the state of a class of requests.
+type Stats struct {
+	Inflight int
+	Queued   int
+}
+
+// Controller admits the requests by the limits of their classes.
+type Controller struct {
+	limiters map[Class]*limiter
+}
+
+// New creates the admission controller, observe is called with the stats
+// of class whenever it changes, it must not block.
+func New(cfg Config, observe func(Class, Stats)) *Controller {
+	c := &Controller{limiters: make(map[Class]*limiter)}
+	for _, class := range Classes {
+		if l := cfg.Limit(class); l.Enabled() {
+			c.limiters[class] = &limiter{
+				class:   class,
+				limit:   *l,
+				clients: make(map[string]int),
+				observe: observe,
+			}
+		}
+	}
+	return c
+}
+
+// RetryAfter returns the seconds that the client rejected by class should
+// wait before retrying.
+func (c *Controller) RetryAfter(class Class) int {
+	if l, ok := c.limiters[class]; ok {
+		return l.limit.RetryAfter
+	}
+	return defaultRetryAfter
+}
+
+// Acquire admits a request of class from client, it waits in queue if the
+// limits are reached and queue is enabled. ErrRejected is returned if the
+// request can't be admitted. The returned release must be called after the
+// request is done.
+func (c *Controller) Acquire(ctx context.Context, class Class, client string) (func(), error) {
+	l, ok := c.limiters[class]
+	if !ok {
+		return func() {}, nil
+	}
+	return l.acquire(ctx, client)
+}
+
+// limiter limits the concurrent requests of a class.
+type limiter struct {
+	class   Class
+	limit   Limit
+	observe func(Class, Stats)
+
+	lock     sync.Mutex
+	inflight int
+	clients  map[string]int
+	// waiters are the queued requests in arrival order.
+	waiters []*waiter
+}
+
+type waiter struct {
+	client   string
+	admitted chan struct{}
+}
+
+// admittable returns whether the request of client can be admitted now, the
+// lock must be held.
+func (l *limiter) admittable(client string) bool {
+	if l.limit.MaxInflight > 0 && l.inflight >= l.limit.MaxInflight {
+		return false
+	}
+	if l.limit.MaxInflightPerClient > 0 && l.clients[client] >= l.limit.MaxInflightPerClient {
+		return false
+	}
+	return true
+}
+
+// admit counts the request of client as inflight, the lock must be held.
+func (l *limiter) admit(client string) {
+	l.inflight++
+	l.clients[client]++
+}
+
+func (l *limiter) notify() {
+	if l.observe != nil {
+		l.observe(l.class, Stats{Inflight: l.inflight, Queued: len(l.waiters)})
+	}
+}
+
+func (l *limiter) acquire(ctx context.Context, client string) (func(), error) {
+	l.lock.Lock()
+
+	// the queued requests are admitted once they can be on release, so the
+	// request which can be admitted now doesn't jump the queue.
+	if l.admittable(client) {
+		l.admit(client)
+		l.notify()
+		l.lock.Unlock()
+		return l.releaseFunc(client), nil
+	}
+
+	if len(l.waiters) >= l.limit.MaxQueued {
+		l.lock.Unlock()
+		return nil, ErrRejected
+	}
+
+	w := &waiter{client: client, admitted: make(chan struct{})}
+	l.waiters = append(l.waiters, w)
+	l.notify()
+	l.lock.Unlock()
+
+	timer := time.NewTimer(time.Duration(l.limit.QueueTimeout) * time.Second)
+	defer timer.Stop()
+
+	var err error
+	select {
+	case <-w.admitted:
+		return l.releaseFunc(client), nil
+	case <-timer.C:
+		err = ErrRejected
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for i, one := range l.waiters {
+		if one == w {
+			l.waiters = append(l.waiters[:i], l.waiters[i+1:]...)
+			l.notify()
+			return nil, err
+		}
+	}
+
+	// admitted while timing out, give it back.
+	l.release(client)
+	return nil, err
+}
+
+func (l *limiter) releaseFunc(client string) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.lock.Lock()
+			defer l.lock.Unlock()
+			l.release(client)
+		})
+	}
+}
+
+// release releases the request of client and admits the queued requests
+// in order, the lock must be held.
+func (l *limiter) release(client string) {
+	l.inflight--
+	if l.clients[client]--; l.clients[client] <= 0 {
+		delete(l.clients, client)
+	}
+
+	waiters := l.waiters[:0]
+	for _, w := range l.waiters {
+		if l.admittable(w.client) {
+			l.admit(w.client)
+			close(w.admitted)
+			continue
+		}
+		waiters = append(waiters, w)
+	}
+	l.waiters = waiters
+	l.notify()
+}
@@ -0,0 +1,132 @@
+package admission
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func queued(c *Controller, class Class) int {
+	l := c.limiters[class]
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return len(l.waiters)
+}
+
+func TestValidate(t *testing.T) {
+	cfg := Config{Mutating: Limit{MaxInflight: 2, MaxQueued: 4}}
+	assert.NoError(t, cfg.Validate())
+	assert.True(t, cfg.Enabled())
+	assert.Equal(t, defaultQueueTimeout, cfg.Mutating.QueueTimeout)
+	assert.Equal(t, defaultRetryAfter, cfg.Mutating.RetryAfter)
+	assert.False(t, cfg.Read.Enabled())
+
+	cfg = Config{Read: Limit{MaxQueued: 4}}
+	assert.Error(t, cfg.Validate())
+
+	cfg = Config{Streaming: Limit{MaxInflight: -1}}
+	assert.Error(t, cfg.Validate())
+
+	assert.False(t, (&Config{}).Enabled())
+}
+
+func TestAcquireMaxInflight(t *testing.T) {
+	c := New(Config{Mutating: Limit{MaxInflight: 2}}, nil)
+	ctx := context.Background()
+
+	release1, err := c.Acquire(ctx, ClassMutating, "a")
+	assert.NoError(t, err)
+	_, err = c.Acquire(ctx, ClassMutating, "b")
+	assert.NoError(t, err)
+
+	_, err = c.Acquire(ctx, ClassMutating, "c")
+	assert.Equal(t, ErrRejected, err)
+
+	// the classes without limits are not controlled.
+	_, err = c.Acquire(ctx, ClassRead, "c")
+	assert.NoError(t, err)
+
+	// release twice is harmless.
+	release1()
+	release1()
+	_, err = c.Acquire(ctx, ClassMutating, "c")
+	assert.NoError(t, err)
+	_, err = c.Acquire(ctx, ClassMutating, "d")
+	assert.Equal(t, ErrRejected, err)
+}
+
+func TestAcquirePerClient(t *testing.T) {
+	stats := map[Class]Stats{}
+	c := New(Config{Read: Limit{MaxInflightPerClient: 1}}, func(class Class, s Stats) {
+		stats[class] = s
+	})
+	ctx := context.Background()
+
+	_, err := c.Acquire(ctx, ClassRead, "uid:1000")
+	assert.NoError(t, err)
+	_, err = c.Acquire(ctx, ClassRead, "uid:1000")
+	assert.Equal(t, ErrRejected, err)
+
+	// other clients are not starved.
+	_, err = c.Acquire(ctx, ClassRead, "uid:0")
+	assert.NoError(t, err)
+	assert.Equal(t, Stats{Inflight: 2}, stats[ClassRead])
+}
+
+func TestAcquireQueued(t *testing.T) {
+	c := New(Config{Mutating: Limit{MaxInflight: 1, MaxQueued: 1, QueueTimeout: 10}}, nil)
+	ctx := context.Background()
+
+	release, err := c.Acquire(ctx, ClassMutating, "a")
+	assert.NoError(t, err)
+
+	admitted := make(chan error)
+	go func() {
+		_, err := c.Acquire(ctx, ClassMutating, "b")
+		admitted <- err
+	}()
+
+	// wait for the request of b to be queued, the queue is full then.
+	for i := 0; queued(c, ClassMutating) != 1; i++ {
+		if i > 1000 {
+			t.Fatal("request is not queued")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	_, err = c.Acquire(ctx, ClassMutating, "c")
+	assert.Equal(t, ErrRejected, err)
+
+	release()
+	select {
+	case err := <-admitted:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("queued request is not admitted after release")
+	}
+}
+
+func TestAcquireQueueCanceled(t *testing.T) {
+	c := New(Config{Mutating: Limit{MaxInflight: 1, MaxQueued: 1, QueueTimeout: 10}}, nil)
+
+	_, err := c.Acquire(context.Background(), ClassMutating, "a")
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = c.Acquire(ctx, ClassMutating, "b")
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 0, queued(c, ClassMutating))
+}
+
+func TestAcquireQueueTimeout(t *testing.T) {
+	c := New(Config{Mutating: Limit{MaxInflight: 1, MaxQueued: 1, QueueTimeout: 1}}, nil)
+
+	_, err := c.Acquire(context.Background(), ClassMutating, "a")
+	assert.NoError(t, err)
+
+	_, err = c.Acquire(context.Background(), ClassMutating, "b")
+	assert.Equal(t, ErrRejected, err)
+	assert.Equal(t, 0, queued(c, ClassMutating))
+}
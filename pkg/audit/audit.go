@@ -0,0 +1,219 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// FileDriver writes the audit records into a rotating file.
+	FileDriver = "file"
+
+	// SyslogDriver sends the audit records to syslog.
+	SyslogDriver = "syslog"
+
+	// OutcomeSuccess means the API call succeeded.
+	OutcomeSuccess = "success"
+
+	// OutcomeFailure means the API call failed or was denied.
+	OutcomeFailure = "failure"
+)
+
+// Config is the configuration of audit log.
+type Config struct {
+	// Enable specifies whether to record the API calls.
+	Enable bool `json:"enable,omitempty"`
+
+	// Driver is where to write the audit records, file or syslog.
+	Driver string `json:"driver,omitempty"`
+
+	// Path is the file path of audit log when driver is file.
+	Path string `json:"path,omitempty"`
+
+	// MaxSize is the max size (in megabytes) of audit log file before rotated.
+	MaxSize int `json:"max-size,omitempty"`
+
+	// MaxBackups is the max number of rotated audit log files to retain.
+	MaxBackups int `json:"max-backups,omitempty"`
+
+	// SyslogAddress is the address of syslog server, such as udp://host:514,
+	// local syslog is used if empty.
+	SyslogAddress string `json:"syslog-address,omitempty"`
+
+	// SyslogTag is the tag of the syslog message.
+	SyslogTag string `json:"syslog-tag,omitempty"`
+
+	// IncludeReads specifies whether to record the read-only API calls.
+	IncludeReads bool `json:"include-reads,omitempty"`
+}
+
+// PeerCred is the credentials of the process connected to unix socket.
+type PeerCred struct {
+	UID uint32 `json:"uid"`
+	GID uint32 `json:"gid"`
+	PID int32  `json:"pid"`
+}
+
+// Client is the identity of the API caller.
+type Client struct {
+	// Address is the remote address of the connection.
+	Address string `json:"address,omitempty"`
+
+	// TLSSubject is the subject common name of the client certificate.
+	TLSSubject string `json:"tls_subject,omitempty"`
+
+	// TLSIssuer is the issuer common name of the client certificate.
+	TLSIssuer string `json:"tls_issuer,omitempty"`
+
+	// Peer is the credentials of the unix socket peer.
+	Peer *PeerCred `json:"peer,omitempty"`
+}
+
+// Record is the audit record of one API call.
+type Record struct {
+	Time      time.Time `json:"time"`
+	RequestID string    `json:"request_id"`
+	Method    string    `json:"method"`
+	Path      string    `json:"path"`
+	Client    Client    `json:"client"`
+
+	// Targets contains the objects operated by the call, such as the
+	// container name in path or the container ID created.
+	Targets map[string]string `json:"targets,omitempty"`
+
+	// Body is the redacted request body, only json body is recorded.
+	Body          json.RawMessage `json:"body,omitempty"`
+	BodyTruncated bool            `json:"body_truncated,omitempty"`
+
+	Status    int     `json:"status"`
+	Outcome   string  `json:"outcome"`
+	Error     string  `json:"error,omitempty"`
+	LatencyMs float64 `json:"latency_ms"`
+
+	lock sync.Mutex
+}
+
+// AddTarget records the object operated by the call.
+func (r *Record) AddTarget(key, value string) {
+	if value == "" {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.Targets == nil {
+		r.Targets = map[string]string{}
+	}
+	r.Targets[key] = value
+}
+
+// Finish fills the result of the call into record.
+func (r *Record) Finish(status int, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.Status = status
+	r.LatencyMs = float64(time.Since(r.Time)) / float64(time.Millisecond)
+	r.Outcome = OutcomeSuccess
+	if err != nil || status >= http.StatusBadRequest {
+		r.Outcome = OutcomeFailure
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+}
+
+type recordKey struct{}
+
+// WithRecord returns a context carrying the audit record.
+func WithRecord(ctx context.Context, r *Record) context.Context {
+	return context.WithValue(ctx, recordKey{}, r)
+}
+
+// AddTarget records the object operated by the call in context, it's no-op
+// if the call is not audited.
+func AddTarget(ctx context.Context, key, value string) {
+	if r, ok := ctx.Value(recordKey{}).(*Record); ok {
+		r.AddTarget(key, value)
+	}
+}
+
+// Logger writes the audit records, one json object per line.
+type Logger struct {
+	sync.Mutex
+
+	w            io.WriteCloser
+	includeReads bool
+}
+
+// NewLogger creates the audit logger by config.
+func NewLogger(cfg Config) (*Logger, error) {
+	var (
+		w   io.WriteCloser
+		err error
+	)
+
+	switch cfg.Driver {
+	case "", FileDriver:
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("audit log path should not be empty")
+		}
+		w, err = NewRotateFile(cfg.Path, int64(cfg.MaxSize)*1024*1024, cfg.MaxBackups)
+	case SyslogDriver:
+		w, err = newSyslogWriter(cfg.SyslogAddress, cfg.SyslogTag)
+	default:
+		return nil, fmt.Errorf("unknown audit log driver %s", cfg.Driver)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Logger{
+		w:            w,
+		includeReads: cfg.IncludeReads,
+	}, nil
+}
+
+// ShouldAudit returns true if the call with method should be recorded.
+func (l *Logger) ShouldAudit(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return l.includeReads
+	}
+	return true
+}
+
+// Log writes the record.
+func (l *Logger) Log(r *Record) error {
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+
+	r.lock.Lock()
+	err := enc.Encode(r)
+	r.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	_, err = l.w.Write(buf.Bytes())
+	return err
+}
+
+// Close closes the underlying writer.
+func (l *Logger) Close() error {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.w.Close()
+}
@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// RedactedValue replaces the sensitive value in request body.
+const RedactedValue = "<redacted>"
+
+// sensitiveKeywords are the keywords of the json keys whose value should be
+// redacted, the key is matched case-insensitively.
+var sensitiveKeywords = []string{
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"credential",
+	"privatekey",
+}
+
+// sensitiveKeys are the json keys whose value should be redacted.
+var sensitiveKeys = map[string]struct{}{
+	"auth":          {},
+	"authorization": {},
+}
+
+// envKeys are the json keys of environment list, such as ["KEY=VALUE"],
+// only the name of the variable is kept.
+var envKeys = map[string]struct{}{
+	"env": {},
+}
+
+// Redact redacts the sensitive values in json data. It returns nil if data
+// is not valid json.
+func Redact(data []byte) json.RawMessage {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil
+	}
+
+	// keep the <redacted> readable instead of \u003credacted\u003e.
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redactValue(v)); err != nil {
+		return nil
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n")
+}
+
+func redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			lower := strings.ToLower(k)
+			switch {
+			case isSensitiveKey(lower):
+				if item != nil {
+					val[k] = RedactedValue
+				}
+			case isEnvKey(lower):
+				val[k] = redactEnv(item)
+			default:
+				val[k] = redactValue(item)
+			}
+		}
+		return val
+	case []interface{}:
+		for i, item := range val {
+			val[i] = redactValue(item)
+		}
+		return val
+	}
+	return v
+}
+
+func isSensitiveKey(key string) bool {
+	if _, ok := sensitiveKeys[key]; ok {
+		return true
+	}
+	for _, keyword := range sensitiveKeywords {
+		if strings.Contains(key, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEnvKey(key string) bool {
+	_, ok := envKeys[key]
+	return ok
+}
+
+// redactEnv keeps the name of environment and redacts the value.
+func redactEnv(v interface{}) interface{} {
+	list, ok := v.([]interface{})
+	if !ok {
+		return redactValue(v)
+	}
+
+	for i, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			continue
+		}
+		if idx := strings.Index(s, "="); idx >= 0 {
+			list[i] = s[:idx+1] + RedactedValue
+		}
+	}
+	return list
+}
@@ -0,0 +1,43 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "invalid json",
+			input:    "not json",
+			expected: "",
+		},
+		{
+			name:     "env",
+			input:    `{"Env":["A=1","PASSWORD=abc","B"],"Image":"busybox"}`,
+			expected: `{"Env":["A=<redacted>","PASSWORD=<redacted>","B"],"Image":"busybox"}`,
+		},
+		{
+			name:     "sensitive keys",
+			input:    `{"Username":"u","Password":"p","IdentityToken":"t","Auth":"a","Author":"me"}`,
+			expected: `{"Auth":"<redacted>","Author":"me","IdentityToken":"<redacted>","Password":"<redacted>","Username":"u"}`,
+		},
+		{
+			name:     "nested",
+			input:    `{"HostConfig":{"Binds":["/a:/b"]},"Items":[{"secret":"s","env":["X=1"]}]}`,
+			expected: `{"HostConfig":{"Binds":["/a:/b"]},"Items":[{"env":["X=<redacted>"],"secret":"<redacted>"}]}`,
+		},
+		{
+			name:     "null value",
+			input:    `{"Password":null}`,
+			expected: `{"Password":null}`,
+		},
+	} {
+		assert.Equal(t, tc.expected, string(Redact([]byte(tc.input))), tc.name)
+	}
+}
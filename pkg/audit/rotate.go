@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// defaultMaxSize is the default max size of audit log file, 100MB.
+	defaultMaxSize = 100 * 1024 * 1024
+
+	// defaultMaxBackups is the default number of rotated files to retain.
+	defaultMaxBackups = 5
+)
+
+// RotateFile is a file writer which rotates the file by size. The rotated
+// files are named as path.1, path.2, ... and path.1 is the newest one.
+type RotateFile struct {
+	sync.Mutex
+
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	f    *os.File
+	size int64
+}
+
+// NewRotateFile opens the file to append, the file is rotated when its size
+// exceeds maxSize and at most maxBackups rotated files are retained.
+func NewRotateFile(path string, maxSize int64, maxBackups int) (*RotateFile, error) {
+	if maxSize <= 0 {
+		maxSize = defaultMaxSize
+	}
+	if maxBackups <= 0 {
+		maxBackups = defaultMaxBackups
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create dir of %s: %v", path, err)
+	}
+
+	rf := &RotateFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+func (rf *RotateFile) open() error {
+	f, err := os.OpenFile(rf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %v", rf.path, err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to stat %s: %v", rf.path, err)
+	}
+
+	rf.f = f
+	rf.size = info.Size()
+	return nil
+}
+
+// Write writes the data into file, the file is rotated before writing if the
+// data makes the file exceed the max size.
+func (rf *RotateFile) Write(p []byte) (int, error) {
+	rf.Lock()
+	defer rf.Unlock()
+
+	if rf.f == nil {
+		return 0, os.ErrClosed
+	}
+
+	if rf.size > 0 && rf.size+int64(len(p)) > rf.maxSize {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := rf.f.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+// rotate renames path.N-1 to path.N, ..., path to path.1 and reopens path.
+func (rf *RotateFile) rotate() error {
+	if err := rf.f.Close(); err != nil {
+		return err
+	}
+	rf.f = nil
+
+	for i := rf.maxBackups; i > 0; i-- {
+		src := rf.path
+		if i > 1 {
+			src = fmt.Sprintf("%s.%d", rf.path, i-1)
+		}
+		dst := fmt.Sprintf("%s.%d", rf.path, i)
+
+		if err := os.Rename(src, dst); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to rotate %s: %v", src, err)
+		}
+	}
+
+	return rf.open()
+}
+
+// Close closes the file.
+func (rf *RotateFile) Close() error {
+	rf.Lock()
+	defer rf.Unlock()
+
+	if rf.f == nil {
+		return nil
+	}
+
+	err := rf.f.Close()
+	rf.f = nil
+	return err
+}
@@ -0,0 +1,56 @@
+package audit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotateFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit-rotate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "sub", "audit.log")
+	rf, err := NewRotateFile(path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{"111111\n", "222222\n", "333333\n", "444444\n"} {
+		_, err := rf.Write([]byte(line))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, rf.Close())
+
+	for file, expected := range map[string]string{
+		path:        "444444\n",
+		path + ".1": "333333\n",
+		path + ".2": "222222\n",
+	} {
+		data, err := ioutil.ReadFile(file)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, string(data))
+	}
+
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+
+	// reopen appends to the existing file.
+	rf, err = NewRotateFile(path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = rf.Write([]byte("5\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, rf.Close())
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "444444\n5\n", string(data))
+}
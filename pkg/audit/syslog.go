@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"fmt"
+	"io"
+	"log/syslog"
+	"strings"
+)
+
+// defaultSyslogTag is the tag of syslog message if not specified.
+const defaultSyslogTag = "pouchd-audit"
+
+// newSyslogWriter connects to syslog, the address is in format
+// [protocol]://[address], such as udp://127.0.0.1:514 or unix:///dev/log.
+func newSyslogWriter(address, tag string) (io.WriteCloser, error) {
+	var network, raddr string
+
+	if address != "" {
+		parts := strings.SplitN(address, "://", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid syslog address %s: must be in format [protocol]://[address]", address)
+		}
+
+		switch parts[0] {
+		case "tcp", "udp", "unix", "unixgram":
+		default:
+			return nil, fmt.Errorf("invalid syslog address %s: unsupported protocol %s", address, parts[0])
+		}
+		network, raddr = parts[0], parts[1]
+	}
+
+	if tag == "" {
+		tag = defaultSyslogTag
+	}
+
+	w, err := syslog.Dial(network, raddr, syslog.LOG_INFO|syslog.LOG_AUTHPRIV, tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect syslog: %v", err)
+	}
+	return w, nil
+}
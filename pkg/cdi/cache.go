@@ -0,0 +1,193 @@
+package cdi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+var (
+	// DefaultSpecDirs is the default directories to load CDI spec files.
+	// The spec in the later directory overrides the one with same kind
+	// in the former directory.
+	DefaultSpecDirs = []string{"/etc/cdi", "/var/run/cdi"}
+)
+
+// Provider generates the CDI specs dynamically, such as the vendor which
+// needs to discover the devices on host.
+type Provider interface {
+	// Kind returns the vendor.com/class of the devices provided.
+	Kind() string
+
+	// Spec returns the CDI spec of the devices.
+	Spec() (*Spec, error)
+}
+
+// Cache keeps the CDI specs loaded from spec directories and providers.
+type Cache struct {
+	sync.RWMutex
+
+	dirs      []string
+	providers []Provider
+	specs     map[string]*Spec
+}
+
+// NewCache creates a cache to load CDI specs from the given directories.
+func NewCache(dirs []string, providers ...Provider) *Cache {
+	return &Cache{
+		dirs:      dirs,
+		providers: providers,
+		specs:     map[string]*Spec{},
+	}
+}
+
+// Refresh reloads all the CDI specs.
+//
+// The spec files in directories have higher priority than the providers,
+// which allows the administrator to override the built-in provider.
+func (c *Cache) Refresh() error {
+	loaded := map[string]*Spec{}
+
+	for _, p := range c.providers {
+		spec, err := p.Spec()
+		if err != nil {
+			log.With(nil).Warnf("failed to get CDI spec from provider %s: %v", p.Kind(), err)
+			continue
+		}
+		if spec == nil {
+			continue
+		}
+		if err := spec.Validate(); err != nil {
+			log.With(nil).Warnf("invalid CDI spec from provider %s: %v", p.Kind(), err)
+			continue
+		}
+		loaded[spec.Kind] = spec
+	}
+
+	for _, dir := range c.dirs {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return fmt.Errorf("failed to read CDI spec dir %s: %v", dir, err)
+		}
+
+		for _, f := range files {
+			if f.IsDir() {
+				continue
+			}
+
+			switch filepath.Ext(f.Name()) {
+			case ".json", ".yaml", ".yml":
+			default:
+				continue
+			}
+
+			spec, err := ReadSpecFile(filepath.Join(dir, f.Name()))
+			if err != nil {
+				// one bad spec file should not break all the devices.
+				log.With(nil).Warnf("failed to load CDI spec: %v", err)
+				continue
+			}
+			loaded[spec.Kind] = spec
+		}
+	}
+
+	c.Lock()
+	c.specs = loaded
+	c.Unlock()
+	return nil
+}
+
+// GetSpec returns the CDI spec by the kind.
+func (c *Cache) GetSpec(kind string) (*Spec, bool) {
+	c.RLock()
+	defer c.RUnlock()
+
+	spec, ok := c.specs[kind]
+	return spec, ok
+}
+
+// ListDevices returns all the qualified device names.
+func (c *Cache) ListDevices() []string {
+	c.RLock()
+	defer c.RUnlock()
+
+	var devices []string
+	for kind, spec := range c.specs {
+		for _, d := range spec.Devices {
+			devices = append(devices, QualifiedName(kind, d.Name))
+		}
+	}
+	sort.Strings(devices)
+	return devices
+}
+
+// InjectDevices applies the container edits of the requested devices into
+// the OCI spec. The container edits of spec is applied once for each kind.
+func (c *Cache) InjectDevices(s *specs.Spec, devices ...string) error {
+	c.RLock()
+	defer c.RUnlock()
+
+	var (
+		edits   []*ContainerEdits
+		applied = map[string]struct{}{}
+	)
+
+	for _, device := range devices {
+		kind, name, err := ParseQualifiedName(device)
+		if err != nil {
+			return err
+		}
+
+		spec, ok := c.specs[kind]
+		if !ok {
+			return fmt.Errorf("unresolvable CDI device %s: unknown kind %s", device, kind)
+		}
+
+		var dev *Device
+		for i := range spec.Devices {
+			if spec.Devices[i].Name == name {
+				dev = &spec.Devices[i]
+				break
+			}
+		}
+		if dev == nil {
+			return fmt.Errorf("unresolvable CDI device %s", device)
+		}
+
+		if _, ok := applied[kind]; !ok {
+			applied[kind] = struct{}{}
+			edits = append(edits, &spec.ContainerEdits)
+		}
+		edits = append(edits, &dev.ContainerEdits)
+	}
+
+	joined := map[string]struct{}{}
+	for _, e := range edits {
+		if err := e.apply(s, joined); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InjectKind applies the container edits of spec without any device.
+func (c *Cache) InjectKind(s *specs.Spec, kind string) error {
+	c.RLock()
+	defer c.RUnlock()
+
+	spec, ok := c.specs[kind]
+	if !ok {
+		return fmt.Errorf("unknown CDI kind %s", kind)
+	}
+	return spec.ContainerEdits.Apply(s)
+}
@@ -0,0 +1,129 @@
+package cdi
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+const vendorSpecYAML = `cdiVersion: "0.3.0"
+kind: vendor.com/device
+containerEdits:
+  hooks:
+  - hookName: prestart
+    path: /usr/bin/vendor-hook
+    args: ["vendor-hook", "prestart"]
+devices:
+- name: dev0
+  containerEdits:
+    env: ["VENDOR_DEVICE=dev0"]
+    deviceNodes:
+    - path: /dev/vendor0
+      hostPath: /dev/null
+      permissions: rw
+    mounts:
+    - hostPath: /usr/lib/vendor
+      containerPath: /usr/lib/vendor
+      options: ["ro"]
+- name: dev1
+  containerEdits:
+    env: ["VENDOR_DEVICE=dev1"]
+`
+
+func writeSpec(t *testing.T, dir, name, content string) {
+	if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCacheInjectDevices(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdi")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeSpec(t, dir, "vendor.yaml", vendorSpecYAML)
+	// broken spec should be skipped
+	writeSpec(t, dir, "broken.json", `{"kind": "broken"}`)
+	// not a spec file
+	writeSpec(t, dir, "README", "hello")
+
+	c := NewCache([]string{dir, filepath.Join(dir, "not-exist")})
+	assert.NoError(t, c.Refresh())
+	assert.Equal(t, []string{"vendor.com/device=dev0", "vendor.com/device=dev1"}, c.ListDevices())
+
+	s := &specs.Spec{Process: &specs.Process{Env: []string{"VENDOR_DEVICE=none", "PATH=/bin"}}}
+	assert.NoError(t, c.InjectDevices(s, "vendor.com/device=dev0"))
+
+	assert.Equal(t, []string{"VENDOR_DEVICE=dev0", "PATH=/bin"}, s.Process.Env)
+	assert.Len(t, s.Hooks.Prestart, 1)
+	assert.Equal(t, "/usr/bin/vendor-hook", s.Hooks.Prestart[0].Path)
+
+	assert.Len(t, s.Linux.Devices, 1)
+	assert.Equal(t, "/dev/vendor0", s.Linux.Devices[0].Path)
+	assert.Equal(t, "c", s.Linux.Devices[0].Type)
+	assert.Len(t, s.Linux.Resources.Devices, 1)
+	assert.Equal(t, "rw", s.Linux.Resources.Devices[0].Access)
+
+	assert.Len(t, s.Mounts, 1)
+	assert.Equal(t, []string{"rbind", "ro"}, s.Mounts[0].Options)
+
+	// unknown kind or device
+	assert.Error(t, c.InjectDevices(&specs.Spec{}, "vendor.com/device=dev2"))
+	assert.Error(t, c.InjectDevices(&specs.Spec{}, "other.com/device=dev0"))
+	assert.Error(t, c.InjectDevices(&specs.Spec{}, "/dev/sda"))
+}
+
+func TestCacheOverride(t *testing.T) {
+	dir1, err := ioutil.TempDir("", "cdi")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir1)
+	dir2, err := ioutil.TempDir("", "cdi")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir2)
+
+	writeSpec(t, dir1, "vendor.yaml", vendorSpecYAML)
+	writeSpec(t, dir2, "vendor.json", `{"cdiVersion": "0.3.0", "kind": "vendor.com/device", "devices": [{"name": "only"}]}`)
+
+	c := NewCache([]string{dir1, dir2})
+	assert.NoError(t, c.Refresh())
+	assert.Equal(t, []string{"vendor.com/device=only"}, c.ListDevices())
+}
+
+func TestNvidiaProvider(t *testing.T) {
+	devRoot, err := ioutil.TempDir("", "cdi-dev")
+	assert.NoError(t, err)
+	defer os.RemoveAll(devRoot)
+
+	// fake device nodes
+	for _, name := range []string{"nvidia1", "nvidia0", "nvidiactl", "nvidia-uvm"} {
+		writeSpec(t, devRoot, name, "")
+	}
+
+	p := NewNvidiaProvider("/usr/bin/nvidia-container-runtime-hook", devRoot)
+	c := NewCache(nil, p)
+	assert.NoError(t, c.Refresh())
+	assert.Equal(t, []string{"nvidia.com/gpu=0", "nvidia.com/gpu=1", "nvidia.com/gpu=all"}, c.ListDevices())
+
+	s := &specs.Spec{}
+	assert.NoError(t, c.InjectDevices(s, "nvidia.com/gpu=1"))
+	assert.Equal(t, []string{"NVIDIA_VISIBLE_DEVICES=1"}, s.Process.Env)
+	assert.Equal(t, []specs.Hook{{
+		Path: "/usr/bin/nvidia-container-runtime-hook",
+		Args: []string{"/usr/bin/nvidia-container-runtime-hook", "prestart"},
+	}}, s.Hooks.Prestart)
+
+	// the visible devices of all requested GPUs are joined.
+	s = &specs.Spec{Process: &specs.Process{Env: []string{"NVIDIA_VISIBLE_DEVICES=void"}}}
+	assert.NoError(t, c.InjectDevices(s, "nvidia.com/gpu=0", "nvidia.com/gpu=1", "nvidia.com/gpu=0"))
+	assert.Equal(t, []string{"NVIDIA_VISIBLE_DEVICES=0,1"}, s.Process.Env)
+	assert.Len(t, s.Hooks.Prestart, 1)
+
+	// the hook can't be found
+	c = NewCache(nil, NewNvidiaProvider("not-exist-nvidia-hook", devRoot))
+	assert.NoError(t, c.Refresh())
+	assert.Empty(t, c.ListDevices())
+}
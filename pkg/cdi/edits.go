@@ -0,0 +1,249 @@
+package cdi
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// PrestartHook is run after the container is created but before the
+	// user-specified program is executed.
+	PrestartHook = "prestart"
+
+	// CreateRuntimeHook is treated as prestart hook since the runtime-spec
+	// used by pouchd doesn't support it.
+	CreateRuntimeHook = "createRuntime"
+
+	// PoststartHook is run after the user-specified program is started.
+	PoststartHook = "poststart"
+
+	// PoststopHook is run after the container is deleted.
+	PoststopHook = "poststop"
+)
+
+var supportedHooks = map[string]struct{}{
+	PrestartHook:      {},
+	CreateRuntimeHook: {},
+	PoststartHook:     {},
+	PoststopHook:      {},
+}
+
+// listEnvs are the env whose values are comma separated lists of devices,
+// the values set by the edits of different devices are joined instead of
+// overridden.
+var listEnvs = map[string]struct{}{
+	NvidiaVisibleDevicesEnv: {},
+}
+
+// Apply applies the container edits to the OCI spec.
+func (e *ContainerEdits) Apply(s *specs.Spec) error {
+	return e.apply(s, nil)
+}
+
+// apply applies the container edits to the OCI spec, the keys of list env
+// set by the edits applied before are recorded in joined.
+func (e *ContainerEdits) apply(s *specs.Spec, joined map[string]struct{}) error {
+	if s == nil {
+		return fmt.Errorf("spec should not be nil")
+	}
+
+	if len(e.Env) > 0 {
+		if s.Process == nil {
+			s.Process = &specs.Process{}
+		}
+		s.Process.Env = mergeEnv(s.Process.Env, e.Env, joined)
+	}
+
+	for _, d := range e.DeviceNodes {
+		if err := applyDeviceNode(s, d); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range e.Mounts {
+		s.Mounts = append(s.Mounts, specs.Mount{
+			Source:      m.HostPath,
+			Destination: m.ContainerPath,
+			Type:        mountType(m),
+			Options:     mountOptions(m),
+		})
+	}
+
+	for _, h := range e.Hooks {
+		if s.Hooks == nil {
+			s.Hooks = &specs.Hooks{}
+		}
+
+		hook := specs.Hook{
+			Path:    h.Path,
+			Args:    h.Args,
+			Env:     h.Env,
+			Timeout: h.Timeout,
+		}
+		switch h.HookName {
+		case PrestartHook, CreateRuntimeHook:
+			s.Hooks.Prestart = append(s.Hooks.Prestart, hook)
+		case PoststartHook:
+			s.Hooks.Poststart = append(s.Hooks.Poststart, hook)
+		case PoststopHook:
+			s.Hooks.Poststop = append(s.Hooks.Poststop, hook)
+		default:
+			return fmt.Errorf("unsupported hook %s", h.HookName)
+		}
+	}
+	return nil
+}
+
+// applyDeviceNode adds the device node and device cgroup rule into spec.
+func applyDeviceNode(s *specs.Spec, d *DeviceNode) error {
+	hostPath := d.HostPath
+	if hostPath == "" {
+		hostPath = d.Path
+	}
+
+	dev := specs.LinuxDevice{
+		Path:  d.Path,
+		Type:  d.Type,
+		Major: d.Major,
+		Minor: d.Minor,
+		UID:   d.UID,
+		GID:   d.GID,
+	}
+	if d.FileMode != nil {
+		mode := os.FileMode(*d.FileMode)
+		dev.FileMode = &mode
+	}
+
+	// fill the missing information by the device node on host.
+	if dev.Type == "" || (dev.Major == 0 && dev.Minor == 0) {
+		var stat unix.Stat_t
+		if err := unix.Stat(hostPath, &stat); err != nil {
+			return fmt.Errorf("failed to stat CDI device node %s: %v", hostPath, err)
+		}
+
+		switch stat.Mode & unix.S_IFMT {
+		case unix.S_IFBLK:
+			dev.Type = "b"
+		case unix.S_IFCHR:
+			dev.Type = "c"
+		case unix.S_IFIFO:
+			dev.Type = "p"
+		default:
+			return fmt.Errorf("CDI device node %s is not a device", hostPath)
+		}
+		dev.Major = int64(unix.Major(uint64(stat.Rdev)))
+		dev.Minor = int64(unix.Minor(uint64(stat.Rdev)))
+		if dev.FileMode == nil {
+			mode := os.FileMode(stat.Mode &^ unix.S_IFMT)
+			dev.FileMode = &mode
+		}
+	}
+
+	if s.Linux == nil {
+		s.Linux = &specs.Linux{}
+	}
+	s.Linux.Devices = append(removeDevice(s.Linux.Devices, dev.Path), dev)
+
+	if s.Linux.Resources == nil {
+		s.Linux.Resources = &specs.LinuxResources{}
+	}
+
+	access := d.Permissions
+	if access == "" {
+		access = "rwm"
+	}
+	major, minor := dev.Major, dev.Minor
+	s.Linux.Resources.Devices = append(s.Linux.Resources.Devices, specs.LinuxDeviceCgroup{
+		Allow:  true,
+		Type:   dev.Type,
+		Major:  &major,
+		Minor:  &minor,
+		Access: access,
+	})
+	return nil
+}
+
+// mergeEnv overrides the env in origin by the env with same key in edits.
+// If joined is not nil, the list env already set by the previous edits are
+// joined with the new values by comma.
+func mergeEnv(origin, edits []string, joined map[string]struct{}) []string {
+	index := map[string]int{}
+	for i, env := range origin {
+		index[strings.SplitN(env, "=", 2)[0]] = i
+	}
+
+	for _, env := range edits {
+		key := strings.SplitN(env, "=", 2)[0]
+		if _, ok := listEnvs[key]; ok && joined != nil {
+			if i, ok := index[key]; ok {
+				if _, ok := joined[key]; ok {
+					env = joinListEnv(origin[i], env)
+				}
+			}
+			joined[key] = struct{}{}
+		}
+		if i, ok := index[key]; ok {
+			origin[i] = env
+			continue
+		}
+		index[key] = len(origin)
+		origin = append(origin, env)
+	}
+	return origin
+}
+
+// joinListEnv joins the values of list env, the duplicate values are removed.
+func joinListEnv(origin, env string) string {
+	okv, kv := strings.SplitN(origin, "=", 2), strings.SplitN(env, "=", 2)
+	if len(okv) != 2 || len(kv) != 2 || okv[1] == "" {
+		return env
+	}
+
+	values := strings.Split(okv[1], ",")
+	seen := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		seen[v] = struct{}{}
+	}
+	for _, v := range strings.Split(kv[1], ",") {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			values = append(values, v)
+		}
+	}
+	return kv[0] + "=" + strings.Join(values, ",")
+}
+
+func removeDevice(devices []specs.LinuxDevice, path string) []specs.LinuxDevice {
+	result := devices[:0]
+	for _, d := range devices {
+		if filepath.Clean(d.Path) != filepath.Clean(path) {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+func mountType(m *Mount) string {
+	if m.Type != "" {
+		return m.Type
+	}
+	return "bind"
+}
+
+func mountOptions(m *Mount) []string {
+	if mountType(m) != "bind" {
+		return m.Options
+	}
+
+	for _, o := range m.Options {
+		if o == "bind" || o == "rbind" {
+			return m.Options
+		}
+	}
+	return append([]string{"rbind"}, m.Options...)
+}
@@ -0,0 +1,132 @@
+package cdi
+
+import (
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+const (
+	// NvidiaGPUKind is the kind of NVIDIA GPU devices.
+	NvidiaGPUKind = "nvidia.com/gpu"
+
+	// NvidiaHookName is a custom OCI prestart hook binary to runc in order
+	// to enable GPU containers.
+	NvidiaHookName = "nvidia-container-runtime-hook"
+
+	// NvidiaVisibleDevicesEnv tells the NVIDIA hook which GPUs should be
+	// injected into the container.
+	NvidiaVisibleDevicesEnv = "NVIDIA_VISIBLE_DEVICES"
+
+	// NvidiaDriverCapabilitiesEnv tells the NVIDIA hook which driver
+	// libraries should be injected into the container.
+	NvidiaDriverCapabilitiesEnv = "NVIDIA_DRIVER_CAPABILITIES"
+)
+
+var nvidiaDevicePattern = regexp.MustCompile(`^nvidia([0-9]+)$`)
+
+// nvidiaProvider provides the NVIDIA GPUs through nvidia-container-runtime-hook.
+//
+// The hook does the real work to inject the device nodes and driver
+// libraries, so that the spec only contains the hook and the env which
+// tells the hook the GPU to be used.
+type nvidiaProvider struct {
+	hookName string
+	devRoot  string
+}
+
+// NewNvidiaProvider returns the provider of NVIDIA GPUs. The hookName is
+// looked up in PATH if it is not absolute, and the GPUs are discovered by
+// the /dev/nvidiaN nodes in devRoot.
+func NewNvidiaProvider(hookName, devRoot string) Provider {
+	if hookName == "" {
+		hookName = NvidiaHookName
+	}
+	if devRoot == "" {
+		devRoot = "/dev"
+	}
+
+	return &nvidiaProvider{
+		hookName: hookName,
+		devRoot:  devRoot,
+	}
+}
+
+// Kind returns nvidia.com/gpu.
+func (p *nvidiaProvider) Kind() string {
+	return NvidiaGPUKind
+}
+
+// Spec returns the NVIDIA GPUs spec, all is always provided.
+func (p *nvidiaProvider) Spec() (*Spec, error) {
+	hookPath, err := p.HookPath()
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &Spec{
+		Version: "0.3.0",
+		Kind:    NvidiaGPUKind,
+		Devices: []Device{nvidiaDevice("all")},
+		ContainerEdits: ContainerEdits{
+			Hooks: []*Hook{
+				{
+					HookName: PrestartHook,
+					Path:     hookPath,
+					Args:     []string{hookPath, "prestart"},
+				},
+			},
+		},
+	}
+
+	for _, idx := range p.deviceIndexes() {
+		spec.Devices = append(spec.Devices, nvidiaDevice(idx))
+	}
+	return spec, nil
+}
+
+// HookPath returns the absolute path of the NVIDIA hook.
+func (p *nvidiaProvider) HookPath() (string, error) {
+	if filepath.IsAbs(p.hookName) {
+		return p.hookName, nil
+	}
+	return exec.LookPath(p.hookName)
+}
+
+// deviceIndexes returns the indexes of /dev/nvidiaN in order.
+func (p *nvidiaProvider) deviceIndexes() []string {
+	files, err := ioutil.ReadDir(p.devRoot)
+	if err != nil {
+		return nil
+	}
+
+	var indexes []int
+	for _, f := range files {
+		m := nvidiaDevicePattern.FindStringSubmatch(f.Name())
+		if m == nil {
+			continue
+		}
+		if idx, err := strconv.Atoi(m[1]); err == nil {
+			indexes = append(indexes, idx)
+		}
+	}
+	sort.Ints(indexes)
+
+	result := make([]string, 0, len(indexes))
+	for _, idx := range indexes {
+		result = append(result, strconv.Itoa(idx))
+	}
+	return result
+}
+
+func nvidiaDevice(name string) Device {
+	return Device{
+		Name: name,
+		ContainerEdits: ContainerEdits{
+			Env: []string{NvidiaVisibleDevicesEnv + "=" + name},
+		},
+	}
+}
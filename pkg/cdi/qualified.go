@@ -0,0 +1,62 @@
+package cdi
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// vendorPattern matches the vendor part of kind, like nvidia.com.
+	vendorPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]*[a-zA-Z0-9])?$`)
+
+	// classPattern matches the class part of kind, like gpu.
+	classPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_-]*[a-zA-Z0-9])?$`)
+
+	// namePattern matches the name of device.
+	namePattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.:-]*[a-zA-Z0-9])?$`)
+)
+
+// QualifiedName returns the fully qualified device name, vendor.com/class=name.
+func QualifiedName(kind, name string) string {
+	return kind + "=" + name
+}
+
+// IsQualifiedName checks whether the device is a CDI qualified name or not.
+func IsQualifiedName(device string) bool {
+	_, _, err := ParseQualifiedName(device)
+	return err == nil
+}
+
+// ParseQualifiedName splits the qualified name into kind and device name.
+func ParseQualifiedName(device string) (string, string, error) {
+	parts := strings.SplitN(device, "=", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("%s is not a qualified CDI device name", device)
+	}
+
+	kind, name := parts[0], parts[1]
+	if _, _, err := ParseKind(kind); err != nil {
+		return "", "", err
+	}
+	if err := validateDeviceName(name); err != nil {
+		return "", "", err
+	}
+	return kind, name, nil
+}
+
+// ParseKind splits the kind into vendor and class.
+func ParseKind(kind string) (string, string, error) {
+	parts := strings.SplitN(kind, "/", 2)
+	if len(parts) != 2 || !vendorPattern.MatchString(parts[0]) || !classPattern.MatchString(parts[1]) {
+		return "", "", fmt.Errorf("invalid CDI kind %q, should be vendor.com/class", kind)
+	}
+	return parts[0], parts[1], nil
+}
+
+func validateDeviceName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("invalid CDI device name %q", name)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package cdi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQualifiedName(t *testing.T) {
+	for _, tc := range []struct {
+		device string
+		kind   string
+		name   string
+		hasErr bool
+	}{
+		{"nvidia.com/gpu=0", "nvidia.com/gpu", "0", false},
+		{"vendor.com/class=dev-name_1", "vendor.com/class", "dev-name_1", false},
+		{"/dev/sda", "", "", true},
+		{"/dev/sda=1", "", "", true},
+		{"vendor.com=dev", "", "", true},
+		{"vendor.com/class=", "", "", true},
+		{"vendor.com/cl.ass=dev", "", "", true},
+	} {
+		kind, name, err := ParseQualifiedName(tc.device)
+		if tc.hasErr {
+			assert.Error(t, err, tc.device)
+			assert.False(t, IsQualifiedName(tc.device))
+			continue
+		}
+		assert.NoError(t, err, tc.device)
+		assert.Equal(t, tc.kind, kind)
+		assert.Equal(t, tc.name, name)
+		assert.True(t, IsQualifiedName(tc.device))
+	}
+}
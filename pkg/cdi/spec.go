@@ -0,0 +1,185 @@
+package cdi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Spec is the Container Device Interface specification of one vendor's
+// device class.
+type Spec struct {
+	// Version is the version of CDI specification.
+	Version string `json:"cdiVersion" yaml:"cdiVersion"`
+
+	// Kind is the vendor.com/class of the devices, like nvidia.com/gpu.
+	Kind string `json:"kind" yaml:"kind"`
+
+	// Devices is the list of devices provided by the spec.
+	Devices []Device `json:"devices" yaml:"devices"`
+
+	// ContainerEdits is applied to the container if any device of the
+	// spec is requested.
+	ContainerEdits ContainerEdits `json:"containerEdits,omitempty" yaml:"containerEdits,omitempty"`
+
+	// path is the file which the spec is loaded from.
+	path string
+}
+
+// Device is the device which can be requested by container.
+type Device struct {
+	// Name is the name of device, like 0 in nvidia.com/gpu=0.
+	Name string `json:"name" yaml:"name"`
+
+	// ContainerEdits is applied to the container if the device is requested.
+	ContainerEdits ContainerEdits `json:"containerEdits" yaml:"containerEdits"`
+}
+
+// ContainerEdits describes the changes to the container's OCI spec.
+type ContainerEdits struct {
+	Env         []string      `json:"env,omitempty" yaml:"env,omitempty"`
+	DeviceNodes []*DeviceNode `json:"deviceNodes,omitempty" yaml:"deviceNodes,omitempty"`
+	Mounts      []*Mount      `json:"mounts,omitempty" yaml:"mounts,omitempty"`
+	Hooks       []*Hook       `json:"hooks,omitempty" yaml:"hooks,omitempty"`
+}
+
+// DeviceNode is the device node injected into container.
+type DeviceNode struct {
+	// Path is the path of device node in container.
+	Path string `json:"path" yaml:"path"`
+
+	// HostPath is the path of device node on host, it is same to Path if
+	// it is not set.
+	HostPath    string  `json:"hostPath,omitempty" yaml:"hostPath,omitempty"`
+	Type        string  `json:"type,omitempty" yaml:"type,omitempty"`
+	Major       int64   `json:"major,omitempty" yaml:"major,omitempty"`
+	Minor       int64   `json:"minor,omitempty" yaml:"minor,omitempty"`
+	FileMode    *uint32 `json:"fileMode,omitempty" yaml:"fileMode,omitempty"`
+	Permissions string  `json:"permissions,omitempty" yaml:"permissions,omitempty"`
+	UID         *uint32 `json:"uid,omitempty" yaml:"uid,omitempty"`
+	GID         *uint32 `json:"gid,omitempty" yaml:"gid,omitempty"`
+}
+
+// Mount is the mount injected into container.
+type Mount struct {
+	HostPath      string   `json:"hostPath" yaml:"hostPath"`
+	ContainerPath string   `json:"containerPath" yaml:"containerPath"`
+	Type          string   `json:"type,omitempty" yaml:"type,omitempty"`
+	Options       []string `json:"options,omitempty" yaml:"options,omitempty"`
+}
+
+// Hook is the OCI hook injected into container.
+type Hook struct {
+	// HookName is the lifecycle of hook, like prestart, poststart and poststop.
+	HookName string   `json:"hookName" yaml:"hookName"`
+	Path     string   `json:"path" yaml:"path"`
+	Args     []string `json:"args,omitempty" yaml:"args,omitempty"`
+	Env      []string `json:"env,omitempty" yaml:"env,omitempty"`
+	Timeout  *int     `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+// Path returns the file path which the spec is loaded from.
+func (s *Spec) Path() string {
+	return s.path
+}
+
+// Validate checks the spec is valid or not.
+func (s *Spec) Validate() error {
+	if _, _, err := ParseKind(s.Kind); err != nil {
+		return err
+	}
+
+	if err := s.ContainerEdits.Validate(); err != nil {
+		return errors.Wrapf(err, "invalid container edits of %s", s.Kind)
+	}
+
+	names := map[string]struct{}{}
+	for _, d := range s.Devices {
+		if err := validateDeviceName(d.Name); err != nil {
+			return errors.Wrapf(err, "invalid device of %s", s.Kind)
+		}
+		if _, ok := names[d.Name]; ok {
+			return fmt.Errorf("duplicate device %s of %s", d.Name, s.Kind)
+		}
+		names[d.Name] = struct{}{}
+
+		if err := d.ContainerEdits.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid container edits of device %s", QualifiedName(s.Kind, d.Name))
+		}
+	}
+	return nil
+}
+
+// Validate checks the container edits is valid or not.
+func (e *ContainerEdits) Validate() error {
+	for _, env := range e.Env {
+		if !strings.Contains(env, "=") {
+			return fmt.Errorf("invalid env %q", env)
+		}
+	}
+
+	for _, d := range e.DeviceNodes {
+		if d == nil || d.Path == "" {
+			return fmt.Errorf("device node requires path")
+		}
+		if !filepath.IsAbs(d.Path) {
+			return fmt.Errorf("device node path %s should be absolute", d.Path)
+		}
+		if d.Type != "" && d.Type != "b" && d.Type != "c" && d.Type != "u" && d.Type != "p" {
+			return fmt.Errorf("invalid type %s of device node %s", d.Type, d.Path)
+		}
+		for _, p := range d.Permissions {
+			if p != 'r' && p != 'w' && p != 'm' {
+				return fmt.Errorf("invalid permissions %s of device node %s", d.Permissions, d.Path)
+			}
+		}
+	}
+
+	for _, m := range e.Mounts {
+		if m == nil || m.HostPath == "" || m.ContainerPath == "" {
+			return fmt.Errorf("mount requires hostPath and containerPath")
+		}
+	}
+
+	for _, h := range e.Hooks {
+		if h == nil || h.Path == "" {
+			return fmt.Errorf("hook requires path")
+		}
+		if _, ok := supportedHooks[h.HookName]; !ok {
+			return fmt.Errorf("unsupported hook %s", h.HookName)
+		}
+	}
+	return nil
+}
+
+// ReadSpecFile reads the CDI spec from json or yaml file.
+func ReadSpecFile(path string) (*Spec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &Spec{}
+	switch filepath.Ext(path) {
+	case ".json":
+		err = json.Unmarshal(data, spec)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, spec)
+	default:
+		return nil, fmt.Errorf("unsupported CDI spec file %s", path)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse CDI spec file %s", path)
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid CDI spec file %s", path)
+	}
+	spec.path = path
+	return spec, nil
+}
@@ -103,6 +103,21 @@ func TestSafeMapPutAndGet(t *testing.T) {
 	assert.Equal(t, value.data, []string{"asdfgh", "123344"})
 }
 
+// TestSafeMapPutIfAbsent is to valid the PutIfAbsent function
+func TestSafeMapPutIfAbsent(t *testing.T) {
+	safeMap := NewSafeMap()
+
+	assert.True(t, safeMap.PutIfAbsent("key", "value"))
+	assert.False(t, safeMap.PutIfAbsent("key", "value1"))
+
+	value, ok := safeMap.Get("key").String()
+	assert.True(t, ok)
+	assert.Equal(t, "value", value)
+
+	// the map not created by NewSafeMap can't store values.
+	assert.False(t, (&SafeMap{}).PutIfAbsent("key", "value"))
+}
+
 // TestSafeMapDirectNew test functions should not panic.
 func TestSafeMapDirectNew(t *testing.T) {
 	assert := assert.New(t)
@@ -86,6 +86,11 @@ func IsInvalidParam(err error) bool {
 	return checkError(err, codeInvalidParam)
 }
 
+// IsConflict checks the error is conflict with the state of object or not.
+func IsConflict(err error) bool {
+	return checkError(err, codeConflict)
+}
+
 // IsTimeout checks the error is time out or not.
 func IsTimeout(err error) bool {
 	return checkError(err, codeTimeout)
@@ -111,6 +116,35 @@ func IsInvalidAuthorization(err error) bool {
 	return checkError(err, codeInvalidAuthorization)
 }
 
+// kinds are the names of error codes, which are returned to client so that
+// it can tell the error apart without matching the message.
+var kinds = map[int]string{
+	codeInvalidParam:         "InvalidParam",
+	codeNotFound:             "NotFound",
+	codeAlreadyExisted:       "AlreadyExisted",
+	codeConflict:             "Conflict",
+	codeTooMany:              "TooMany",
+	codeTimeout:              "Timeout",
+	codeLockfailed:           "LockFailed",
+	codeNotImplemented:       "NotImplemented",
+	codeInUse:                "InUse",
+	codeNotModified:          "NotModified",
+	codePreCheckFailed:       "PreCheckFailed",
+	codeInvalidAuthorization: "InvalidAuthorization",
+	codeVolumeExisted:        "AlreadyExisted",
+	codeVolumeDriverNotFound: "NotFound",
+	codeVolumeMetaNotFound:   "NotFound",
+}
+
+// Kind returns the kind of error, such as NotFound, or empty string if the
+// error is not one of errtypes.
+func Kind(err error) string {
+	if err0, ok := causeError(err).(errorType); ok {
+		return kinds[err0.code]
+	}
+	return ""
+}
+
 func checkError(err error, code int) bool {
 	err = causeError(err)
 
@@ -23,3 +23,21 @@ func TestCheckError(t *testing.T) {
 		t.Error("check Wrap error")
 	}
 }
+
+func TestKind(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		kind string
+	}{
+		{errors.Wrap(ErrNotfound, "test"), "NotFound"},
+		{errors.Wrap(ErrVolumeNotFound, "test"), "NotFound"},
+		{ErrVolumeExisted, "AlreadyExisted"},
+		{errors.WithMessage(ErrInUse, "test"), "InUse"},
+		{ErrConflict, "Conflict"},
+		{errors.New("test"), ""},
+	} {
+		if kind := Kind(tc.err); kind != tc.kind {
+			t.Errorf("expected kind %q of %v, but got %q", tc.kind, tc.err, kind)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/pkg/trace"
+
+	"google.golang.org/grpc"
+)
+
+// TracingUnaryServerInterceptor returns a new unary server interceptor that records each
+// request in a span, the W3C trace context in request metadata is the parent of the span.
+func TracingUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		ctx, span := trace.StartSpan(trace.ExtractGRPC(ctx), info.FullMethod,
+			trace.WithKind(trace.SpanKindServer),
+			trace.WithAttribute("rpc.system", "grpc"),
+		)
+		defer func() {
+			span.Finish(err)
+		}()
+
+		return handler(ctx, req)
+	}
+}
@@ -22,6 +22,13 @@ type Backend interface {
 	// Keys return all keys.
 	Keys(bucket string) ([]string, error)
 
+	// Version returns the schema version stamped on bucket, 0 if the bucket
+	// is never stamped.
+	Version(bucket string) (int, error)
+
+	// SetVersion stamps the schema version on bucket.
+	SetVersion(bucket string, version int) error
+
 	// Path returns the path with the specified key.
 	Path(key string) string
 
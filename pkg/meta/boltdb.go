@@ -1,8 +1,11 @@
 package meta
 
 import (
+	"encoding/json"
+	"io"
 	"os"
 	"path"
+	"strconv"
 	"sync"
 	"time"
 
@@ -10,6 +13,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// versionsBucket is the bucket to save the schema versions of buckets.
+const versionsBucket = "pouch.meta.versions"
+
 func init() {
 	Register("boltdb", NewBolt)
 }
@@ -47,6 +53,24 @@ func NewBolt(cfg Config) (Backend, error) {
 	return b, nil
 }
 
+// CopyBolt calls write with a consistent copy of the boltdb file, which may
+// be used by others. The file is opened in read-only mode, so it waits for
+// the one writing it.
+func CopyBolt(file string, write func(size int64, copy io.WriterTo) error) error {
+	db, err := boltdb.Open(file, 0644, &boltdb.Options{
+		Timeout:  time.Minute,
+		ReadOnly: true,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to open boltdb %s", file)
+	}
+	defer db.Close()
+
+	return db.View(func(tx *boltdb.Tx) error {
+		return write(tx.Size(), tx)
+	})
+}
+
 func (b *bolt) prepare(db *boltdb.DB, bucket []byte) error {
 	b.Lock()
 	defer b.Unlock()
@@ -60,9 +84,58 @@ func (b *bolt) prepare(db *boltdb.DB, bucket []byte) error {
 	})
 }
 
-// Path returns boltdb store file.
+// Path returns the directory of key besides the boltdb store file, where
+// the files of object other than metadata are saved.
 func (b *bolt) Path(key string) string {
-	return b.db.Path()
+	return path.Join(path.Dir(b.db.Path()), key)
+}
+
+// Version returns the schema version of bucket.
+func (b *bolt) Version(bucket string) (int, error) {
+	var version int
+
+	b.Lock()
+	defer b.Unlock()
+
+	err := b.db.View(func(tx *boltdb.Tx) error {
+		v, err := boltVersion(tx, bucket)
+		version = v
+		return err
+	})
+
+	return version, err
+}
+
+func boltVersion(tx *boltdb.Tx, bucket string) (int, error) {
+	bkt := tx.Bucket([]byte(versionsBucket))
+	if bkt == nil {
+		return 0, nil
+	}
+
+	value := bkt.Get([]byte(bucket))
+	if value == nil {
+		return 0, nil
+	}
+
+	v, err := strconv.Atoi(string(value))
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid schema version of bucket %s", bucket)
+	}
+	return v, nil
+}
+
+// SetVersion stamps the schema version of bucket.
+func (b *bolt) SetVersion(bucket string, version int) error {
+	b.Lock()
+	defer b.Unlock()
+
+	return b.db.Update(func(tx *boltdb.Tx) error {
+		bkt, err := tx.CreateBucketIfNotExists([]byte(versionsBucket))
+		if err != nil {
+			return errors.Wrap(err, "failed to create bucket in boltdb")
+		}
+		return bkt.Put([]byte(bucket), []byte(strconv.Itoa(version)))
+	})
 }
 
 // Keys return all keys for boltdb.
@@ -161,6 +234,48 @@ func (b *bolt) List(bucket string) ([][]byte, error) {
 	return values, err
 }
 
+// snapshot reads the objects and schema versions of buckets in one read
+// transaction.
+func (b *bolt) snapshot(buckets []string) ([]*schemaBackup, error) {
+	var result []*schemaBackup
+
+	b.Lock()
+	defer b.Unlock()
+
+	err := b.db.View(func(tx *boltdb.Tx) error {
+		for _, bucket := range buckets {
+			bkt := tx.Bucket([]byte(bucket))
+			if bkt == nil {
+				return errors.Wrapf(ErrBucketNotFound, "failed to read bucket %s", bucket)
+			}
+
+			version, err := boltVersion(tx, bucket)
+			if err != nil {
+				return err
+			}
+
+			sb := &schemaBackup{
+				Bucket:  bucket,
+				From:    version,
+				To:      version,
+				Objects: map[string]json.RawMessage{},
+			}
+			// the values are only valid in transaction.
+			err = bkt.ForEach(func(k, v []byte) error {
+				sb.Objects[string(k)] = append(json.RawMessage(nil), v...)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			result = append(result, sb)
+		}
+		return nil
+	})
+
+	return result, err
+}
+
 // Close releases all database resources.
 // All transactions must be closed before closing the database.
 func (b *bolt) Close() error {
@@ -0,0 +1,93 @@
+package meta
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/pkg/errors"
+	"github.com/tchap/go-patricia/patricia"
+)
+
+// snapshotter is implemented by the backends which can read all the buckets
+// consistently, nothing is written during reading.
+type snapshotter interface {
+	snapshot(buckets []string) ([]*schemaBackup, error)
+}
+
+// Dump writes the objects and schema versions of all the buckets of store
+// into w in json, which can be loaded into a store of any backend by Load.
+// The buckets are read as a consistent snapshot of store, except for the
+// backends which don't support it, of which each object is read
+// consistently, but the objects written during dumping may be old or new.
+func (s *Store) Dump(w io.Writer) error {
+	backup := &storeBackup{Driver: s.Driver, BaseDir: s.BaseDir}
+
+	if sn, ok := s.backend.(snapshotter); ok {
+		names := make([]string, 0, len(s.Buckets))
+		for _, bucket := range s.Buckets {
+			names = append(names, bucket.Name)
+		}
+		buckets, err := sn.snapshot(names)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s store", s.Driver)
+		}
+		backup.Buckets = buckets
+		return json.NewEncoder(w).Encode(backup)
+	}
+
+	for _, bucket := range s.Buckets {
+		b, err := dumpBucket(s.backend, bucket.Name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read bucket %s", bucket.Name)
+		}
+		backup.Buckets = append(backup.Buckets, b)
+	}
+
+	return json.NewEncoder(w).Encode(backup)
+}
+
+// Load writes the objects and schema versions dumped by Dump into store,
+// the buckets must have no object. It returns the number of objects loaded
+// of each bucket, nothing is left in store if it fails.
+func (s *Store) Load(r io.Reader) (map[string]int, error) {
+	backup := &storeBackup{}
+	if err := json.NewDecoder(r).Decode(backup); err != nil {
+		return nil, errors.Wrap(err, "failed to decode dumped store")
+	}
+
+	for _, b := range backup.Buckets {
+		if s.Bucket(b.Bucket) == nil {
+			return nil, fmt.Errorf("bucket %s is not in store", b.Bucket)
+		}
+
+		keys, err := s.backend.Keys(b.Bucket)
+		if err != nil && err != ErrBucketNotFound {
+			return nil, err
+		}
+		if len(keys) > 0 {
+			return nil, fmt.Errorf("bucket %s of %s store at %s is not empty", b.Bucket, s.Driver, s.BaseDir)
+		}
+	}
+
+	if err := copyBuckets(s.backend, backup.Buckets); err != nil {
+		if rerr := clearBuckets(s.Driver, s.BaseDir, s.backend, backup.Buckets); rerr != nil {
+			log.With(nil).Errorf("failed to roll back %s store at %s: %v", s.Driver, s.BaseDir, rerr)
+		}
+		return nil, errors.Wrapf(err, "failed to write %s store", s.Driver)
+	}
+
+	result := make(map[string]int, len(backup.Buckets))
+	s.trieLock.Lock()
+	for _, b := range backup.Buckets {
+		for key := range b.Objects {
+			s.trie.Insert(patricia.Prefix(key), struct{}{})
+		}
+		result[b.Bucket] = len(b.Objects)
+	}
+	s.trieLock.Unlock()
+
+	return result, nil
+}
@@ -0,0 +1,117 @@
+package meta
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	boltdb "github.com/boltdb/bolt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDumpAndLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "meta-dump")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src, err := NewStore(schemaConfig("local", path.Join(dir, "local"), 2, renameA))
+	assert.NoError(t, err)
+	assert.NoError(t, src.Put(&Demo{A: 1, B: "k1"}))
+	assert.NoError(t, src.Put(&Demo{A: 2, B: "k2"}))
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, src.Dump(buf))
+	dumped := buf.Bytes()
+
+	// dumped store is loaded into the store of other backend.
+	dst, err := NewStore(schemaConfig("boltdb", path.Join(dir, "meta.db"), 2, renameA))
+	assert.NoError(t, err)
+	defer dst.Shutdown()
+
+	n, err := dst.Load(bytes.NewReader(dumped))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{MetaJSONFile: 2}, n)
+
+	objs, err := dst.List()
+	assert.NoError(t, err)
+	assert.Equal(t, &Demo{A: 1, B: "k1"}, objs["k1"])
+	assert.Equal(t, &Demo{A: 2, B: "k2"}, objs["k2"])
+	keys, err := dst.KeysWithPrefix("k")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	version, err := dst.Version()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, version)
+
+	// store with objects can't be loaded into.
+	_, err = dst.Load(bytes.NewReader(dumped))
+	assert.Error(t, err)
+	objs, err = dst.List()
+	assert.NoError(t, err)
+	assert.Len(t, objs, 2)
+}
+
+func TestDumpBolt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "meta-dump")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src, err := NewStore(schemaConfig("boltdb", path.Join(dir, "meta.db"), 2, renameA))
+	assert.NoError(t, err)
+	defer src.Shutdown()
+	assert.NoError(t, src.Put(&Demo{A: 1, B: "k1"}))
+	assert.NoError(t, src.Put(&Demo{A: 2, B: "k2"}))
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, src.Dump(buf))
+
+	dst, err := NewStore(schemaConfig("local", path.Join(dir, "local"), 2, renameA))
+	assert.NoError(t, err)
+
+	n, err := dst.Load(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{MetaJSONFile: 2}, n)
+
+	objs, err := dst.List()
+	assert.NoError(t, err)
+	assert.Equal(t, &Demo{A: 1, B: "k1"}, objs["k1"])
+	assert.Equal(t, &Demo{A: 2, B: "k2"}, objs["k2"])
+	version, err := dst.Version()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, version)
+}
+
+func TestCopyBolt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "meta-dump")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := path.Join(dir, "meta.db")
+	s, err := NewStore(schemaConfig("boltdb", file, 1))
+	assert.NoError(t, err)
+	assert.NoError(t, s.Put(&Demo{A: 1, B: "k1"}))
+	assert.NoError(t, s.Shutdown())
+
+	copied := path.Join(dir, "copied.db")
+	err = CopyBolt(file, func(size int64, copy io.WriterTo) error {
+		buf := &bytes.Buffer{}
+		n, err := copy.WriteTo(buf)
+		assert.Equal(t, size, n)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(copied, buf.Bytes(), 0644)
+	})
+	assert.NoError(t, err)
+
+	db, err := boltdb.Open(copied, 0644, nil)
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.NoError(t, db.View(func(tx *boltdb.Tx) error {
+		assert.NotNil(t, tx.Bucket([]byte(MetaJSONFile)).Get([]byte("k1")))
+		return nil
+	}))
+}
@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -13,6 +14,10 @@ import (
 	"github.com/alibaba/pouch/pkg/log"
 )
 
+// versionsFile is the file in base directory to save the schema versions
+// of buckets.
+const versionsFile = ".versions.json"
+
 func init() {
 	Register("local", NewLocalStore)
 }
@@ -174,6 +179,82 @@ func (s *localStore) Keys(fileName string) ([]string, error) {
 	return keys, nil
 }
 
+// Version returns the schema version of bucket saved in versions file.
+func (s *localStore) Version(bucket string) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	versions, err := s.versions()
+	if err != nil {
+		return 0, err
+	}
+	return versions[bucket], nil
+}
+
+// SetVersion saves the schema version of bucket in versions file.
+func (s *localStore) SetVersion(bucket string, version int) error {
+	s.Lock()
+	defer s.Unlock()
+
+	versions, err := s.versions()
+	if err != nil {
+		return err
+	}
+	versions[bucket] = version
+
+	data, err := json.Marshal(versions)
+	if err != nil {
+		return err
+	}
+	return writeFileSync(path.Join(s.base, versionsFile), data, 0644)
+}
+
+func (s *localStore) versions() (map[string]int, error) {
+	versions := make(map[string]int)
+
+	data, err := ioutil.ReadFile(path.Join(s.base, versionsFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return versions, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &versions); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %v", versionsFile, err)
+	}
+	return versions, nil
+}
+
+// snapshot reads the objects and schema versions of buckets from cache,
+// the writes are blocked until it returns.
+func (s *localStore) snapshot(buckets []string) ([]*schemaBackup, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	versions, err := s.versions()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*schemaBackup, 0, len(buckets))
+	for _, bucket := range buckets {
+		sb := &schemaBackup{
+			Bucket:  bucket,
+			From:    versions[bucket],
+			To:      versions[bucket],
+			Objects: map[string]json.RawMessage{},
+		}
+		for k, v := range s.cache {
+			if strings.HasSuffix(k, "/"+bucket) {
+				sb.Objects[strings.TrimSuffix(k, "/"+bucket)] = v
+			}
+		}
+		result = append(result, sb)
+	}
+	return result, nil
+}
+
 // Close do nothing in local store
 func (s *localStore) Close() error {
 	return nil
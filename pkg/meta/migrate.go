@@ -0,0 +1,200 @@
+package meta
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/pkg/errors"
+)
+
+// storeBackup is the backup of all buckets of a store before it's migrated
+// to another backend.
+type storeBackup struct {
+	Driver  string          `json:"driver"`
+	BaseDir string          `json:"base-dir"`
+	Buckets []*schemaBackup `json:"buckets"`
+}
+
+// MigrateResult is the result of migrating store between backends.
+type MigrateResult struct {
+	// Objects is the number of objects migrated of each bucket.
+	Objects map[string]int
+
+	// BackupFile is where the objects of source store are saved.
+	BackupFile string
+}
+
+// Migrate moves the objects and schema versions of the buckets from the src
+// store to the dst store, which must have no object in the buckets. The
+// objects of src are saved in backupFile first, and are removed from src
+// only after all of them are written to dst, any failure before it rolls
+// dst back, so that the objects are either in src or in dst. The stores
+// must not be used by others during migration.
+func Migrate(src, dst Config, backupFile string) (*MigrateResult, error) {
+	if src.Driver == dst.Driver && src.BaseDir == dst.BaseDir {
+		return nil, fmt.Errorf("source and destination of migration are the same")
+	}
+
+	srcBackend, err := openBackend(src)
+	if err != nil {
+		return nil, err
+	}
+	defer srcBackend.Close()
+
+	backup := &storeBackup{Driver: src.Driver, BaseDir: src.BaseDir}
+	for _, bucket := range src.Buckets {
+		b, err := dumpBucket(srcBackend, bucket.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read bucket %s of %s store", bucket.Name, src.Driver)
+		}
+		backup.Buckets = append(backup.Buckets, b)
+	}
+
+	data, err := json.Marshal(backup)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFileSync(backupFile, data, 0600); err != nil {
+		return nil, errors.Wrapf(err, "failed to write backup %s", backupFile)
+	}
+
+	dstBackend, err := openBackend(dst)
+	if err != nil {
+		return nil, err
+	}
+	defer dstBackend.Close()
+
+	for _, b := range backup.Buckets {
+		keys, err := dstBackend.Keys(b.Bucket)
+		if err != nil && err != ErrBucketNotFound {
+			return nil, err
+		}
+		if len(keys) > 0 {
+			return nil, fmt.Errorf("bucket %s of %s store at %s is not empty", b.Bucket, dst.Driver, dst.BaseDir)
+		}
+	}
+
+	result := &MigrateResult{Objects: make(map[string]int), BackupFile: backupFile}
+	if err := copyBuckets(dstBackend, backup.Buckets); err != nil {
+		if rerr := clearBuckets(dst.Driver, dst.BaseDir, dstBackend, backup.Buckets); rerr != nil {
+			log.With(nil).Errorf("failed to roll back %s store at %s: %v", dst.Driver, dst.BaseDir, rerr)
+		}
+		return nil, errors.Wrapf(err, "failed to write %s store", dst.Driver)
+	}
+
+	for _, b := range backup.Buckets {
+		result.Objects[b.Bucket] = len(b.Objects)
+	}
+
+	// the objects are all in dst now, it's safe to remove them from src,
+	// and a failure of it leaves stale objects only.
+	if err := clearBuckets(src.Driver, src.BaseDir, srcBackend, backup.Buckets); err != nil {
+		log.With(nil).Warnf("failed to remove the objects from %s store at %s: %v", src.Driver, src.BaseDir, err)
+	}
+	if src.Driver == "boltdb" {
+		if err := os.Remove(src.BaseDir); err != nil && !os.IsNotExist(err) {
+			log.With(nil).Warnf("failed to remove %s: %v", src.BaseDir, err)
+		}
+	}
+
+	return result, nil
+}
+
+func openBackend(cfg Config) (Backend, error) {
+	create, ok := backendFactory[cfg.Driver]
+	if !ok {
+		return nil, fmt.Errorf("store driver %s not found", cfg.Driver)
+	}
+
+	backend, err := create(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("create driver %s failed: %v", cfg.Driver, err)
+	}
+	return backend, nil
+}
+
+// dumpBucket reads all the objects and the schema version of bucket.
+func dumpBucket(backend Backend, bucket string) (*schemaBackup, error) {
+	version, err := backend.Version(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := backend.Keys(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &schemaBackup{
+		Bucket:  bucket,
+		From:    version,
+		To:      version,
+		Objects: make(map[string]json.RawMessage, len(keys)),
+	}
+	for _, key := range keys {
+		value, err := backend.Get(bucket, key)
+		if err != nil {
+			if err == ErrObjectNotFound {
+				continue
+			}
+			return nil, err
+		}
+		b.Objects[key] = value
+	}
+	return b, nil
+}
+
+func copyBuckets(backend Backend, buckets []*schemaBackup) error {
+	for _, b := range buckets {
+		for key, value := range b.Objects {
+			if err := backend.Put(b.Bucket, key, value); err != nil {
+				return err
+			}
+		}
+		if err := backend.SetVersion(b.Bucket, b.From); err != nil {
+			return err
+		}
+
+		keys, err := backend.Keys(b.Bucket)
+		if err != nil {
+			return err
+		}
+		if len(keys) != len(b.Objects) {
+			return fmt.Errorf("bucket %s has %d objects after migration, expected %d", b.Bucket, len(keys), len(b.Objects))
+		}
+	}
+	return nil
+}
+
+// clearBuckets removes the objects of buckets from store. The local store
+// removes the whole directory of key, which also contains the files other
+// than metadata, so only the metadata files are removed for it.
+func clearBuckets(driver, baseDir string, backend Backend, buckets []*schemaBackup) error {
+	for _, b := range buckets {
+		for key := range b.Objects {
+			var err error
+			if driver == "local" {
+				err = os.Remove(path.Join(baseDir, key, b.Bucket))
+				if os.IsNotExist(err) {
+					err = nil
+				}
+			} else {
+				err = backend.Remove(b.Bucket, key)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if driver == "local" {
+		if err := os.Remove(path.Join(baseDir, versionsFile)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
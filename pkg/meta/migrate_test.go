@@ -0,0 +1,88 @@
+package meta
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "meta-migrate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	local := schemaConfig("local", path.Join(dir, "containers"), 1)
+	bolt := schemaConfig("boltdb", path.Join(dir, "containers", "meta.db"), 1)
+
+	s, err := NewStore(local)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Put(&Demo{A: 1, B: "k1"}))
+	assert.NoError(t, s.Put(&Demo{A: 2, B: "k2"}))
+	// the files other than meta data in the directory of key are kept.
+	assert.NoError(t, ioutil.WriteFile(path.Join(s.Path("k1"), "hosts"), []byte("hosts"), 0644))
+
+	result, err := Migrate(local, bolt, path.Join(dir, "local.backup"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Objects[MetaJSONFile])
+
+	_, err = os.Stat(path.Join(dir, "containers", "k1", MetaJSONFile))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(path.Join(dir, "containers", "k1", "hosts"))
+	assert.NoError(t, err)
+	_, err = os.Stat(result.BackupFile)
+	assert.NoError(t, err)
+
+	s, err = NewStore(bolt)
+	assert.NoError(t, err)
+	objs, err := s.List()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]Object{"k1": &Demo{A: 1, B: "k1"}, "k2": &Demo{A: 2, B: "k2"}}, objs)
+	version, err := s.Version()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, version)
+	assert.Equal(t, path.Join(dir, "containers", "k1"), s.Path("k1"))
+	assert.NoError(t, s.Shutdown())
+
+	// migrate back.
+	_, err = Migrate(bolt, local, path.Join(dir, "boltdb.backup"))
+	assert.NoError(t, err)
+	_, err = os.Stat(bolt.BaseDir)
+	assert.True(t, os.IsNotExist(err))
+
+	s, err = NewStore(local)
+	assert.NoError(t, err)
+	objs, err = s.List()
+	assert.NoError(t, err)
+	assert.Len(t, objs, 2)
+}
+
+func TestMigrateToNonEmptyStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "meta-migrate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	buckets := []Bucket{{Name: MetaJSONFile, Type: reflect.TypeOf(Demo{})}}
+	local := Config{Driver: "local", BaseDir: path.Join(dir, "containers"), Buckets: buckets}
+	bolt := Config{Driver: "boltdb", BaseDir: path.Join(dir, "meta.db"), Buckets: buckets}
+
+	for _, cfg := range []Config{local, bolt} {
+		s, err := NewStore(cfg)
+		assert.NoError(t, err)
+		assert.NoError(t, s.Put(&Demo{A: 1, B: cfg.Driver}))
+		assert.NoError(t, s.Shutdown())
+	}
+
+	_, err = Migrate(local, bolt, path.Join(dir, "local.backup"))
+	assert.Error(t, err)
+
+	// the source is untouched.
+	s, err := NewStore(local)
+	assert.NoError(t, err)
+	obj, err := s.Get("local")
+	assert.NoError(t, err)
+	assert.Equal(t, &Demo{A: 1, B: "local"}, obj)
+}
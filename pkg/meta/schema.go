@@ -0,0 +1,220 @@
+package meta
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/pkg/errors"
+)
+
+// Schema describes the schema version of the objects in a bucket, and the
+// migrations to upgrade the objects written by older versions.
+type Schema struct {
+	// Bucket is the name of bucket.
+	Bucket string
+
+	// Version is the schema version of the objects written by current code,
+	// the objects written before the version is stamped are version 0.
+	Version int
+
+	// Migrations upgrade the stored objects version by version.
+	Migrations []Migration
+}
+
+// Migration upgrades an object from Version-1 to Version. It works on the
+// raw json of object, since the go type may have changed.
+type Migration struct {
+	// Version is the schema version after migration.
+	Version int
+
+	// Upgrade converts the json of object.
+	Upgrade func(key string, value []byte) ([]byte, error)
+}
+
+// schemaBackup is the backup of objects before migration, it's also the
+// journal to roll back an interrupted migration.
+type schemaBackup struct {
+	Bucket  string                     `json:"bucket"`
+	From    int                        `json:"from"`
+	To      int                        `json:"to"`
+	Objects map[string]json.RawMessage `json:"objects"`
+}
+
+// schemaBackupPath returns the path of backup file before migrating bucket
+// from the version.
+func schemaBackupPath(baseDir, bucket string, from int) string {
+	return fmt.Sprintf("%s.%s.v%d.backup", baseDir, bucket, from)
+}
+
+// upgradeSchema runs the migrations of schema over the objects in bucket,
+// and stamps the bucket with the version of schema. The old objects are
+// saved in a backup file before migration, the backup is restored if the
+// migration fails or the daemon is killed during migration.
+func upgradeSchema(baseDir string, backend Backend, schema Schema) error {
+	current, err := backend.Version(schema.Bucket)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get schema version of bucket %s", schema.Bucket)
+	}
+
+	if current > schema.Version {
+		return fmt.Errorf("bucket %s has schema version %d, newer than %d supported, it's written by a newer version of pouchd", schema.Bucket, current, schema.Version)
+	}
+
+	backupPath := schemaBackupPath(baseDir, schema.Bucket, current)
+	if err := recoverSchema(backupPath, backend, schema.Bucket, current); err != nil {
+		return err
+	}
+
+	if current == schema.Version {
+		return nil
+	}
+
+	keys, err := backend.Keys(schema.Bucket)
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return backend.SetVersion(schema.Bucket, schema.Version)
+	}
+
+	migrations := make([]Migration, 0, len(schema.Migrations))
+	for _, m := range schema.Migrations {
+		if m.Version > current && m.Version <= schema.Version {
+			migrations = append(migrations, m)
+		}
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	// the versions are compatible, stamping is enough.
+	if len(migrations) == 0 {
+		return backend.SetVersion(schema.Bucket, schema.Version)
+	}
+
+	// upgrade all the objects in memory first, nothing is changed if any
+	// object fails to upgrade.
+	backup := &schemaBackup{
+		Bucket:  schema.Bucket,
+		From:    current,
+		To:      schema.Version,
+		Objects: make(map[string]json.RawMessage, len(keys)),
+	}
+	upgraded := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		value, err := backend.Get(schema.Bucket, key)
+		if err != nil {
+			if err == ErrObjectNotFound {
+				continue
+			}
+			return err
+		}
+		backup.Objects[key] = value
+
+		for _, m := range migrations {
+			if value, err = m.Upgrade(key, value); err != nil {
+				return errors.Wrapf(err, "failed to upgrade object %s of bucket %s to version %d", key, schema.Bucket, m.Version)
+			}
+		}
+		upgraded[key] = value
+	}
+
+	if err := writeSchemaBackup(backupPath, backup); err != nil {
+		return err
+	}
+
+	for key, value := range upgraded {
+		if err := backend.Put(schema.Bucket, key, value); err != nil {
+			if rerr := restoreSchemaBackup(backend, backup); rerr != nil {
+				log.With(nil).Errorf("failed to restore bucket %s from backup %s: %v", schema.Bucket, backupPath, rerr)
+			}
+			return errors.Wrapf(err, "failed to write upgraded object %s of bucket %s", key, schema.Bucket)
+		}
+	}
+
+	if err := backend.SetVersion(schema.Bucket, schema.Version); err != nil {
+		if rerr := restoreSchemaBackup(backend, backup); rerr != nil {
+			log.With(nil).Errorf("failed to restore bucket %s from backup %s: %v", schema.Bucket, backupPath, rerr)
+		}
+		return errors.Wrapf(err, "failed to stamp schema version of bucket %s", schema.Bucket)
+	}
+
+	log.With(nil).Infof("bucket %s of %s is upgraded from schema version %d to %d, %d objects migrated, the old objects are saved in %s",
+		schema.Bucket, baseDir, current, schema.Version, len(upgraded), backupPath)
+	return nil
+}
+
+// recoverSchema restores the objects from backup if the migration from the
+// version was interrupted, which is known by the backup of the version
+// existing while the stamped version is not changed.
+func recoverSchema(backupPath string, backend Backend, bucket string, version int) error {
+	data, err := ioutil.ReadFile(backupPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	backup := &schemaBackup{}
+	if err := json.Unmarshal(data, backup); err != nil {
+		return errors.Wrapf(err, "failed to decode schema backup %s", backupPath)
+	}
+	if backup.Bucket != bucket || backup.From != version || backup.To == version {
+		return nil
+	}
+
+	log.With(nil).Warnf("migration of bucket %s from schema version %d was interrupted, restore it from %s", bucket, version, backupPath)
+	return restoreSchemaBackup(backend, backup)
+}
+
+func restoreSchemaBackup(backend Backend, backup *schemaBackup) error {
+	for key, value := range backup.Objects {
+		if err := backend.Put(backup.Bucket, key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeSchemaBackup(file string, backup *schemaBackup) error {
+	data, err := json.Marshal(backup)
+	if err != nil {
+		return err
+	}
+	if err := writeFileSync(file, data, 0600); err != nil {
+		return errors.Wrapf(err, "failed to write schema backup %s", file)
+	}
+	return nil
+}
+
+// writeFileSync writes the data into a temporary file and renames it to
+// file after synced, so that the file is either old or new.
+func writeFileSync(file string, data []byte, perm os.FileMode) error {
+	tmp := file + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	return os.Rename(tmp, file)
+}
@@ -0,0 +1,135 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// renameA is the migration to version 2 which renames field "a" to "A".
+var renameA = Migration{
+	Version: 2,
+	Upgrade: func(key string, value []byte) ([]byte, error) {
+		return bytes.Replace(value, []byte(`"a":`), []byte(`"A":`), 1), nil
+	},
+}
+
+func schemaConfig(driver, baseDir string, version int, migrations ...Migration) Config {
+	return Config{
+		Driver:  driver,
+		BaseDir: baseDir,
+		Buckets: []Bucket{{Name: MetaJSONFile, Type: reflect.TypeOf(Demo{})}},
+		Schemas: []Schema{{Bucket: MetaJSONFile, Version: version, Migrations: migrations}},
+	}
+}
+
+func testUpgradeSchema(t *testing.T, driver string) {
+	dir, err := ioutil.TempDir("", "meta-schema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	baseDir := path.Join(dir, "store")
+	if driver == "boltdb" {
+		baseDir = path.Join(dir, "meta.db")
+	}
+
+	// a fresh store is stamped with current version.
+	s, err := NewStore(schemaConfig(driver, baseDir, 1))
+	assert.NoError(t, err)
+	version, err := s.Version()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, version)
+
+	// write the objects of version 1 in raw json.
+	assert.NoError(t, s.backend.Put(MetaJSONFile, "k1", []byte(`{"a":1,"B":"k1"}`)))
+	assert.NoError(t, s.backend.Put(MetaJSONFile, "k2", []byte(`{"a":2,"B":"k2"}`)))
+	assert.NoError(t, s.Shutdown())
+
+	s, err = NewStore(schemaConfig(driver, baseDir, 2, renameA))
+	assert.NoError(t, err)
+
+	version, err = s.Version()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, version)
+
+	obj, err := s.Get("k2")
+	assert.NoError(t, err)
+	assert.Equal(t, &Demo{A: 2, B: "k2"}, obj)
+
+	// the old objects are kept in backup.
+	_, err = os.Stat(schemaBackupPath(baseDir, MetaJSONFile, 1))
+	assert.NoError(t, err)
+	assert.NoError(t, s.Shutdown())
+
+	// the store written by newer version can't be opened.
+	_, err = NewStore(schemaConfig(driver, baseDir, 1))
+	assert.Error(t, err)
+}
+
+func TestUpgradeSchemaLocal(t *testing.T) {
+	testUpgradeSchema(t, "local")
+}
+
+func TestUpgradeSchemaBoltdb(t *testing.T) {
+	testUpgradeSchema(t, "boltdb")
+}
+
+func TestUpgradeSchemaFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "meta-schema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := NewStore(schemaConfig("local", dir, 1))
+	assert.NoError(t, err)
+	assert.NoError(t, s.backend.Put(MetaJSONFile, "k1", []byte(`{"a":1,"B":"k1"}`)))
+
+	broken := Migration{
+		Version: 2,
+		Upgrade: func(key string, value []byte) ([]byte, error) {
+			return nil, fmt.Errorf("broken")
+		},
+	}
+	_, err = NewStore(schemaConfig("local", dir, 2, broken))
+	assert.Error(t, err)
+
+	// nothing is changed.
+	s, err = NewStore(schemaConfig("local", dir, 1))
+	assert.NoError(t, err)
+	value, err := s.backend.Get(MetaJSONFile, "k1")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1,"B":"k1"}`, string(value))
+}
+
+func TestRecoverInterruptedSchemaUpgrade(t *testing.T) {
+	dir, err := ioutil.TempDir("", "meta-schema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := NewStore(schemaConfig("local", dir, 1))
+	assert.NoError(t, err)
+
+	// simulate a migration to version 2 interrupted after k1 is written.
+	assert.NoError(t, writeSchemaBackup(schemaBackupPath(dir, MetaJSONFile, 1), &schemaBackup{
+		Bucket:  MetaJSONFile,
+		From:    1,
+		To:      2,
+		Objects: map[string]json.RawMessage{"k1": json.RawMessage(`{"a":1,"B":"k1"}`), "k2": json.RawMessage(`{"a":2,"B":"k2"}`)},
+	}))
+	assert.NoError(t, s.backend.Put(MetaJSONFile, "k1", []byte(`{"A":1,"B":"k1"}`)))
+	assert.NoError(t, s.backend.Put(MetaJSONFile, "k2", []byte(`{"a":2,"B":"k2"}`)))
+
+	// the objects are restored and migrated again.
+	s, err = NewStore(schemaConfig("local", dir, 2, renameA))
+	assert.NoError(t, err)
+	objs, err := s.List()
+	assert.NoError(t, err)
+	assert.Equal(t, &Demo{A: 1, B: "k1"}, objs["k1"])
+	assert.Equal(t, &Demo{A: 2, B: "k2"}, objs["k2"])
+}
@@ -30,6 +30,10 @@ type Config struct {
 	Driver  string
 	Buckets []Bucket
 	BaseDir string
+
+	// Schemas are the schema versions of buckets, the stored objects are
+	// upgraded to the versions when the store is created.
+	Schemas []Schema
 }
 
 // Object is an interface.
@@ -64,6 +68,13 @@ func NewStore(cfg Config) (*Store, error) {
 		return nil, fmt.Errorf("create driver %s failed: %v", cfg.Driver, err)
 	}
 
+	for _, schema := range cfg.Schemas {
+		if err := upgradeSchema(cfg.BaseDir, backend, schema); err != nil {
+			backend.Close()
+			return nil, err
+		}
+	}
+
 	s := &Store{
 		Config:   cfg,
 		backend:  backend,
@@ -264,6 +275,11 @@ func (s *Store) KeysWithPrefix(prefix string) ([]string, error) {
 	return keys, err
 }
 
+// Version returns the schema version stamped on current bucket.
+func (s *Store) Version() (int, error) {
+	return s.backend.Version(s.current.Name)
+}
+
 // Path returns the path with specified key.
 func (s *Store) Path(key string) string {
 	return s.backend.Path(key)
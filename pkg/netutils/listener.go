@@ -4,40 +4,218 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"syscall"
 
+	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/user"
 )
 
-// GetListener get a listener for an address.
-func GetListener(addr string, tlsConfig *tls.Config) (net.Listener, error) {
+// defaultUnixSocketGroup is the group of unix socket if it's not specified.
+const defaultUnixSocketGroup = "pouch"
+
+// ListenerConfig is the config of listening address in format
+// [protocol]://[address]?[options], such as
+// unix:///var/run/pouchd.sock?group=docker&mode=0660.
+type ListenerConfig struct {
+	Protocol string
+	Address  string
+
+	// Owner and Group are the name or id of the owner and group of unix
+	// socket, Mode is its permission.
+	Owner string
+	Group string
+	Mode  os.FileMode
+
+	// TLS overrides the TLS config of daemon for the listener.
+	TLS *ListenerTLS
+}
+
+// ListenerTLS is the TLS config of a listener.
+type ListenerTLS struct {
+	// Disable disables the TLS of daemon for the listener.
+	Disable bool
+	Cert    string
+	Key     string
+	CA      string
+	// Verify requires and verifies the certificate of client.
+	Verify bool
+}
+
+// ParseListenAddress parses the listening address with options.
+func ParseListenAddress(addr string) (*ListenerConfig, error) {
 	addrParts := strings.SplitN(addr, "://", 2)
 	if len(addrParts) != 2 {
 		return nil, fmt.Errorf("invalid listening address %s: must be in format [protocol]://[address]", addr)
 	}
 
-	switch addrParts[0] {
+	cfg := &ListenerConfig{Protocol: addrParts[0], Address: addrParts[1], Mode: 0660}
+	switch cfg.Protocol {
+	case "tcp", "unix", "fd", "vsock":
+	default:
+		return nil, fmt.Errorf("unsupported protocol %s of listening address %s: only tcp, unix, fd and vsock are supported", cfg.Protocol, addr)
+	}
+
+	idx := strings.Index(cfg.Address, "?")
+	if idx < 0 {
+		return cfg, nil
+	}
+
+	options, err := url.ParseQuery(cfg.Address[idx+1:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid options of listening address %s: %v", addr, err)
+	}
+	cfg.Address = cfg.Address[:idx]
+
+	tlsCfg := &ListenerTLS{}
+	for key, values := range options {
+		value := values[len(values)-1]
+
+		switch key {
+		case "owner", "group", "mode":
+			if cfg.Protocol != "unix" {
+				return nil, fmt.Errorf("option %s of listening address %s is only for unix socket", key, addr)
+			}
+		}
+
+		switch key {
+		case "owner":
+			cfg.Owner = value
+		case "group":
+			cfg.Group = value
+		case "mode":
+			mode, err := strconv.ParseUint(value, 8, 32)
+			if err != nil || mode&^0777 != 0 {
+				return nil, fmt.Errorf("invalid mode %s of listening address %s", value, addr)
+			}
+			cfg.Mode = os.FileMode(mode)
+		case "tls":
+			enable, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid tls %s of listening address %s", value, addr)
+			}
+			tlsCfg.Disable = !enable
+		case "tlscert":
+			tlsCfg.Cert = value
+		case "tlskey":
+			tlsCfg.Key = value
+		case "tlscacert":
+			tlsCfg.CA = value
+		case "tlsverify":
+			if tlsCfg.Verify, err = strconv.ParseBool(value); err != nil {
+				return nil, fmt.Errorf("invalid tlsverify %s of listening address %s", value, addr)
+			}
+		default:
+			return nil, fmt.Errorf("unknown option %s of listening address %s", key, addr)
+		}
+	}
+
+	if *tlsCfg == (ListenerTLS{}) {
+		return cfg, nil
+	}
+	if tlsCfg.Disable && (tlsCfg.Cert != "" || tlsCfg.Key != "" || tlsCfg.CA != "" || tlsCfg.Verify) {
+		return nil, fmt.Errorf("tls of listening address %s is disabled, but tls options are specified", addr)
+	}
+	if !tlsCfg.Disable && (tlsCfg.Cert == "" || tlsCfg.Key == "") {
+		return nil, fmt.Errorf("both tlscert and tlskey must be specified for listening address %s", addr)
+	}
+	cfg.TLS = tlsCfg
+	return cfg, nil
+}
+
+// GetListener get a listener for an address.
+func GetListener(addr string, tlsConfig *tls.Config) (net.Listener, error) {
+	listeners, err := GetListeners(addr, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(listeners) != 1 {
+		for _, l := range listeners {
+			l.Close()
+		}
+		return nil, fmt.Errorf("listening address %s has %d sockets, expected one", addr, len(listeners))
+	}
+	return listeners[0], nil
+}
+
+// GetListeners gets the listeners for an address, fd:// may have several
+// sockets passed by systemd. The tlsConfig of daemon is used by the
+// listeners other than unix socket, unless the address has its own TLS
+// options.
+func GetListeners(addr string, tlsConfig *tls.Config) ([]net.Listener, error) {
+	return GetListenersWithNextProtos(addr, tlsConfig, nil)
+}
+
+// GetListenersWithNextProtos is the same as GetListeners, except that the
+// TLS listeners negotiate the protocols by ALPN, such as h2 of gRPC, with
+// either the tlsConfig of daemon or the TLS options of address.
+func GetListenersWithNextProtos(addr string, tlsConfig *tls.Config, nextProtos []string) ([]net.Listener, error) {
+	cfg, err := ParseListenAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.TLS != nil {
+		tlsConfig = nil
+		if !cfg.TLS.Disable {
+			if tlsConfig, err = httputils.GenTLSConfig(cfg.TLS.Key, cfg.TLS.Cert, cfg.TLS.CA); err != nil {
+				return nil, err
+			}
+			if cfg.TLS.Verify {
+				tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+		}
+	}
+	if tlsConfig != nil && len(nextProtos) > 0 {
+		tlsConfig = tlsConfig.Clone()
+		tlsConfig.NextProtos = nextProtos
+	}
+
+	var listeners []net.Listener
+	switch cfg.Protocol {
 	case "tcp":
-		l, err := net.Listen("tcp", addrParts[1])
+		l, err := net.Listen("tcp", cfg.Address)
 		if err != nil {
-			return l, err
-		}
-		if tlsConfig != nil {
-			l = tls.NewListener(l, tlsConfig)
+			return nil, err
 		}
-		return l, err
+		listeners = append(listeners, l)
 	case "unix":
-		return newUnixSocket(addrParts[1])
+		l, err := newUnixSocket(cfg)
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, l)
+	case "fd":
+		if listeners, err = activatedListeners(cfg.Address); err != nil {
+			return nil, err
+		}
+	case "vsock":
+		l, err := listenVsock(cfg.Address)
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, l)
+	}
 
-	default:
-		return nil, fmt.Errorf("only unix socket or tcp address is support")
+	if tlsConfig == nil {
+		return listeners, nil
+	}
+	for i, l := range listeners {
+		// TLS of daemon is not used by unix socket.
+		if l.Addr().Network() == "unix" && cfg.TLS == nil {
+			continue
+		}
+		listeners[i] = tls.NewListener(l, tlsConfig)
 	}
+	return listeners, nil
 }
 
-func newUnixSocket(path string) (net.Listener, error) {
+func newUnixSocket(cfg *ListenerConfig) (net.Listener, error) {
+	path := cfg.Address
 	if err := syscall.Unlink(path); err != nil && !os.IsNotExist(err) {
 		return nil, err
 	}
@@ -48,35 +226,62 @@ func newUnixSocket(path string) (net.Listener, error) {
 		return nil, err
 	}
 
-	// chmod unix socket, make other group writable
-	if err := os.Chmod(path, 0660); err != nil {
+	// chmod unix socket, make other group writable by default
+	if err := os.Chmod(path, cfg.Mode); err != nil {
 		l.Close()
 		return nil, fmt.Errorf("failed to chmod %s: %s", path, err)
 	}
 
-	gid, err := user.ParseID(user.GroupFile, "pouch", func(line, str string, idInt int, idErr error) (uint32, bool) {
-		var (
-			name, placeholder string
-			id                int
-		)
-
-		user.ParseString(line, &name, &placeholder, &id)
-		if str == name {
-			return uint32(id), true
+	uid := 0
+	if cfg.Owner != "" {
+		id, err := lookupID(user.PasswdFile, cfg.Owner)
+		if err != nil {
+			l.Close()
+			return nil, fmt.Errorf("failed to find owner of unix socket %s: %v", path, err)
 		}
-		return 0, false
-	})
+		uid = int(id)
+	}
+
+	group := cfg.Group
+	if group == "" {
+		group = defaultUnixSocketGroup
+	}
+	gid, err := lookupID(user.GroupFile, group)
 	if err != nil {
+		if cfg.Group != "" {
+			l.Close()
+			return nil, fmt.Errorf("failed to find group of unix socket %s: %v", path, err)
+		}
+
 		// ignore error when group pouch not exist, group pouch should to be
 		// created before pouchd started, it means code not create pouch group
 		log.With(nil).Warnf("failed to find group pouch, cannot change unix socket %s to pouch group", path)
-		return l, nil
+		gid = 0
+		if cfg.Owner == "" {
+			return l, nil
+		}
 	}
 
-	// chown unix socket with group pouch
-	if err := os.Chown(path, 0, int(gid)); err != nil {
+	// chown unix socket with group pouch by default
+	if err := os.Chown(path, uid, int(gid)); err != nil {
 		l.Close()
 		return nil, fmt.Errorf("failed to chown %s: %s", path, err)
 	}
 	return l, nil
 }
+
+// lookupID finds the id of name or id in passwd or group file.
+func lookupID(file, str string) (uint32, error) {
+	return user.ParseID(file, str, func(line, str string, idInt int, idErr error) (uint32, bool) {
+		var (
+			name, placeholder string
+			id                int
+		)
+
+		user.ParseString(line, &name, &placeholder, &id)
+		if str == name || (idErr == nil && idInt == id) {
+			return uint32(id), true
+		}
+		return 0, false
+	})
+}
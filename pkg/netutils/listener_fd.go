@@ -0,0 +1,121 @@
+package netutils
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+// listenFdsStart is the first fd passed by systemd socket activation.
+const listenFdsStart = 3
+
+var (
+	activationOnce sync.Once
+	activationLock sync.Mutex
+	// activationFiles are the sockets passed by systemd, the used ones are
+	// set to nil.
+	activationFiles []*os.File
+)
+
+// activatedFiles returns the sockets passed by systemd socket activation,
+// they are named by LISTEN_FDNAMES or LISTEN_FD_<fd>. The environments are
+// unset so that the sockets are not passed to children.
+func activatedFiles() []*os.File {
+	activationOnce.Do(func() {
+		defer func() {
+			os.Unsetenv("LISTEN_PID")
+			os.Unsetenv("LISTEN_FDS")
+			os.Unsetenv("LISTEN_FDNAMES")
+		}()
+
+		pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+		if err != nil || pid != os.Getpid() {
+			return
+		}
+		nfds, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+		if err != nil || nfds <= 0 {
+			return
+		}
+		names := strings.Split(os.Getenv("LISTEN_FDNAMES"), ":")
+
+		for i := 0; i < nfds; i++ {
+			fd := listenFdsStart + i
+			unix.CloseOnExec(fd)
+
+			name := "LISTEN_FD_" + strconv.Itoa(fd)
+			if i < len(names) && names[i] != "" {
+				name = names[i]
+			}
+			activationFiles = append(activationFiles, os.NewFile(uintptr(fd), name))
+		}
+	})
+	return activationFiles
+}
+
+// activatedListeners returns the listeners of the sockets passed by systemd
+// which match the address, all of them if address is empty, or the ones
+// with the fd number or the name in LISTEN_FDNAMES. Each socket can only be
+// used once.
+func activatedListeners(address string) ([]net.Listener, error) {
+	activationLock.Lock()
+	defer activationLock.Unlock()
+
+	files, err := selectActivatedFiles(activatedFiles(), address)
+	if err != nil {
+		return nil, err
+	}
+
+	var listeners []net.Listener
+	for _, i := range files {
+		l, err := net.FileListener(activationFiles[i])
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, fmt.Errorf("failed to listen to socket %s passed by systemd: %v", activationFiles[i].Name(), err)
+		}
+		listeners = append(listeners, l)
+	}
+
+	// the listeners have their own duplicated fds.
+	for _, i := range files {
+		activationFiles[i].Close()
+		activationFiles[i] = nil
+	}
+	return listeners, nil
+}
+
+// selectActivatedFiles returns the indexes of the files matching address.
+func selectActivatedFiles(files []*os.File, address string) ([]int, error) {
+	fd, fdErr := strconv.Atoi(address)
+
+	var (
+		selected []int
+		used     bool
+	)
+	for i, f := range files {
+		if f == nil {
+			// the name of used file is unknown, check it by fd.
+			if address == "" || (fdErr == nil && fd == listenFdsStart+i) {
+				used = true
+			}
+			continue
+		}
+		if address == "" || f.Name() == address || (fdErr == nil && fd == listenFdsStart+i) {
+			selected = append(selected, i)
+		}
+	}
+
+	if len(selected) == 0 {
+		if used {
+			return nil, fmt.Errorf("socket %s passed by systemd is already used", address)
+		}
+		return nil, fmt.Errorf("no socket %s is passed by systemd, check LISTEN_FDS and LISTEN_FDNAMES", address)
+	}
+	return selected, nil
+}
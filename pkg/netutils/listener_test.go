@@ -1,9 +1,24 @@
 package netutils
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGetListenerBasic(t *testing.T) {
@@ -28,7 +43,7 @@ func TestGetListenerBasic(t *testing.T) {
 		{
 			"otherProtocolTest",
 			args{"udp://127.0.0.1:12345"},
-			fmt.Errorf("only unix socket or tcp address is support"),
+			fmt.Errorf("unsupported protocol udp of listening address udp://127.0.0.1:12345: only tcp, unix, fd and vsock are supported"),
 		},
 		{
 			"invalidAddressTest",
@@ -49,3 +64,169 @@ func TestGetListenerBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestParseListenAddress(t *testing.T) {
+	cfg, err := ParseListenAddress("unix:///run/pouchd.sock?owner=1000&group=docker&mode=0600")
+	assert.NoError(t, err)
+	assert.Equal(t, &ListenerConfig{
+		Protocol: "unix",
+		Address:  "/run/pouchd.sock",
+		Owner:    "1000",
+		Group:    "docker",
+		Mode:     0600,
+	}, cfg)
+
+	cfg, err = ParseListenAddress("tcp://0.0.0.0:2376?tlscert=cert.pem&tlskey=key.pem&tlscacert=ca.pem&tlsverify=1")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.0.0.0:2376", cfg.Address)
+	assert.Equal(t, &ListenerTLS{Cert: "cert.pem", Key: "key.pem", CA: "ca.pem", Verify: true}, cfg.TLS)
+
+	cfg, err = ParseListenAddress("fd://pouchd.socket?tls=false")
+	assert.NoError(t, err)
+	assert.Equal(t, "pouchd.socket", cfg.Address)
+	assert.Equal(t, &ListenerTLS{Disable: true}, cfg.TLS)
+
+	for _, addr := range []string{
+		"tcp://0.0.0.0:2375?mode=0600",
+		"unix:///run/pouchd.sock?mode=0999",
+		"unix:///run/pouchd.sock?unknown=1",
+		"tcp://0.0.0.0:2376?tlscert=cert.pem",
+		"tcp://0.0.0.0:2376?tls=false&tlsverify=1",
+	} {
+		_, err := ParseListenAddress(addr)
+		assert.Error(t, err, addr)
+	}
+}
+
+func TestUnixSocketOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "listener")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	sock := path.Join(dir, "pouchd.sock")
+	l, err := GetListener(fmt.Sprintf("unix://%s?owner=%d&group=%d&mode=0600", sock, os.Getuid(), os.Getgid()), nil)
+	assert.NoError(t, err)
+	defer l.Close()
+
+	fi, err := os.Stat(sock)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	_, err = GetListener(fmt.Sprintf("unix://%s?group=no-such-group", path.Join(dir, "other.sock")), nil)
+	assert.Error(t, err)
+}
+
+func TestSelectActivatedFiles(t *testing.T) {
+	files := []*os.File{
+		os.NewFile(uintptr(listenFdsStart), "pouchd.socket"),
+		os.NewFile(uintptr(listenFdsStart+1), "LISTEN_FD_4"),
+		nil,
+	}
+
+	selected, err := selectActivatedFiles(files, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, selected)
+
+	selected, err = selectActivatedFiles(files, "pouchd.socket")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0}, selected)
+
+	selected, err = selectActivatedFiles(files, "4")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, selected)
+
+	_, err = selectActivatedFiles(files, "5")
+	assert.EqualError(t, err, "socket 5 passed by systemd is already used")
+
+	_, err = selectActivatedFiles(files, "cri.socket")
+	assert.Error(t, err)
+}
+
+func TestActivatedListeners(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer l.Close()
+
+	f, err := l.(*net.TCPListener).File()
+	assert.NoError(t, err)
+
+	activationOnce.Do(func() {})
+	activationFiles = []*os.File{f}
+	defer func() { activationFiles = nil }()
+
+	listeners, err := GetListeners("fd://", nil)
+	assert.NoError(t, err)
+	assert.Len(t, listeners, 1)
+	assert.Equal(t, l.Addr().String(), listeners[0].Addr().String())
+	listeners[0].Close()
+
+	// each socket can only be used once.
+	_, err = GetListeners("fd://", nil)
+	assert.Error(t, err)
+}
+
+func TestParseVsockAddress(t *testing.T) {
+	addr, err := parseVsockAddress("2375")
+	assert.NoError(t, err)
+	assert.Equal(t, "4294967295:2375", addr.String())
+
+	addr, err = parseVsockAddress("3:2375")
+	assert.NoError(t, err)
+	assert.Equal(t, &vsockAddr{cid: 3, port: 2375}, addr)
+
+	for _, address := range []string{"", "x:2375", "3:"} {
+		_, err := parseVsockAddress(address)
+		assert.Error(t, err, address)
+	}
+}
+
+// writeTestCert writes a self-signed certificate and its key into dir.
+func writeTestCert(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "pouchd"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certFile, keyFile := path.Join(dir, "cert.pem"), path.Join(dir, "key.pem")
+	assert.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certFile, keyFile
+}
+
+func TestGetListenersWithNextProtos(t *testing.T) {
+	dir, err := ioutil.TempDir("", "listener")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	certFile, keyFile := writeTestCert(t, dir)
+
+	// the TLS options of address negotiate the protocols too.
+	ls, err := GetListenersWithNextProtos(fmt.Sprintf("tcp://127.0.0.1:0?tlscert=%s&tlskey=%s", certFile, keyFile), nil, []string{"h2"})
+	assert.NoError(t, err)
+	if !assert.Len(t, ls, 1) {
+		return
+	}
+	defer ls[0].Close()
+
+	go func() {
+		conn, err := ls[0].Accept()
+		if err != nil {
+			return
+		}
+		conn.(*tls.Conn).Handshake()
+		conn.Close()
+	}()
+
+	conn, err := tls.Dial("tcp", ls[0].Addr().String(), &tls.Config{InsecureSkipVerify: true, NextProtos: []string{"h2"}})
+	assert.NoError(t, err)
+	defer conn.Close()
+	assert.Equal(t, "h2", conn.ConnectionState().NegotiatedProtocol)
+}
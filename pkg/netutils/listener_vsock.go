@@ -0,0 +1,145 @@
+package netutils
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// vsockAddr is the address of vsock in format cid:port.
+type vsockAddr struct {
+	cid  uint32
+	port uint32
+}
+
+// Network implements net.Addr.
+func (a *vsockAddr) Network() string {
+	return "vsock"
+}
+
+// String implements net.Addr.
+func (a *vsockAddr) String() string {
+	return fmt.Sprintf("%d:%d", a.cid, a.port)
+}
+
+// parseVsockAddress parses the address in format [cid:]port, the cid is
+// any cid of the host if it's not specified.
+func parseVsockAddress(address string) (*vsockAddr, error) {
+	addr := &vsockAddr{cid: unix.VMADDR_CID_ANY}
+
+	port := address
+	if idx := strings.Index(address, ":"); idx >= 0 {
+		cid, err := strconv.ParseUint(address[:idx], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cid of vsock address %s", address)
+		}
+		addr.cid = uint32(cid)
+		port = address[idx+1:]
+	}
+
+	p, err := strconv.ParseUint(port, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port of vsock address %s", address)
+	}
+	addr.port = uint32(p)
+	return addr, nil
+}
+
+// vsockListener is the listener of vsock, the socket is nonblocking and
+// polled by runtime through os.File.
+type vsockListener struct {
+	file *os.File
+	addr *vsockAddr
+}
+
+// listenVsock listens to vsock address in format [cid:]port.
+func listenVsock(address string) (net.Listener, error) {
+	addr, err := parseVsockAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	fd, err := unix.Socket(unix.AF_VSOCK, unix.SOCK_STREAM|unix.SOCK_CLOEXEC|unix.SOCK_NONBLOCK, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create vsock socket")
+	}
+	if err := unix.Bind(fd, &unix.SockaddrVM{CID: addr.cid, Port: addr.port}); err != nil {
+		unix.Close(fd)
+		return nil, errors.Wrapf(err, "failed to bind vsock %s", address)
+	}
+	if err := unix.Listen(fd, unix.SOMAXCONN); err != nil {
+		unix.Close(fd)
+		return nil, errors.Wrapf(err, "failed to listen to vsock %s", address)
+	}
+
+	return &vsockListener{
+		file: os.NewFile(uintptr(fd), "vsock:"+address),
+		addr: addr,
+	}, nil
+}
+
+// Accept implements net.Listener.
+func (l *vsockListener) Accept() (net.Conn, error) {
+	raw, err := l.file.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		nfd       int
+		sa        unix.Sockaddr
+		acceptErr error
+	)
+	if err := raw.Read(func(fd uintptr) bool {
+		nfd, sa, acceptErr = unix.Accept4(int(fd), unix.SOCK_CLOEXEC|unix.SOCK_NONBLOCK)
+		return acceptErr != unix.EAGAIN
+	}); err != nil {
+		return nil, err
+	}
+	if acceptErr != nil {
+		return nil, acceptErr
+	}
+
+	remote := &vsockAddr{}
+	if vm, ok := sa.(*unix.SockaddrVM); ok {
+		remote.cid, remote.port = vm.CID, vm.Port
+	}
+	return &vsockConn{
+		File:   os.NewFile(uintptr(nfd), "vsock:"+remote.String()),
+		local:  l.addr,
+		remote: remote,
+	}, nil
+}
+
+// Close implements net.Listener.
+func (l *vsockListener) Close() error {
+	return l.file.Close()
+}
+
+// Addr implements net.Listener.
+func (l *vsockListener) Addr() net.Addr {
+	return l.addr
+}
+
+// vsockConn is the connection of vsock, the reading, writing and deadlines
+// are implemented by os.File.
+type vsockConn struct {
+	*os.File
+	local  net.Addr
+	remote net.Addr
+}
+
+// LocalAddr implements net.Conn.
+func (c *vsockConn) LocalAddr() net.Addr {
+	return c.local
+}
+
+// RemoteAddr implements net.Conn.
+func (c *vsockConn) RemoteAddr() net.Addr {
+	return c.remote
+}
@@ -0,0 +1,36 @@
+package netutils
+
+import (
+	"fmt"
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+// GetPeerCred returns the credentials of the process connected to the unix
+// socket connection by SO_PEERCRED.
+func GetPeerCred(conn net.Conn) (*unix.Ucred, error) {
+	uc, ok := conn.(*net.UnixConn)
+	if !ok {
+		return nil, fmt.Errorf("connection %s is not unix socket", conn.LocalAddr())
+	}
+
+	raw, err := uc.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		cred    *unix.Ucred
+		credErr error
+	)
+	if err := raw.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	}); err != nil {
+		return nil, err
+	}
+	if credErr != nil {
+		return nil, fmt.Errorf("failed to get peer credentials: %v", credErr)
+	}
+	return cred, nil
+}
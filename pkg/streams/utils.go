@@ -5,6 +5,8 @@ import (
 	"io"
 
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/term"
+
 	"golang.org/x/sync/errgroup"
 )
 
@@ -35,6 +37,11 @@ type AttachConfig struct {
 
 	Stdin          io.ReadCloser
 	Stdout, Stderr io.Writer
+
+	// DetachKeys is the escape keys to detach from the process's stream,
+	// the process keeps running and its stdin is not closed after detached,
+	// and the attach returns term.ErrDetached.
+	DetachKeys []byte
 }
 
 // CopyPipes will watchs the data pipe's channel, like sticked to the pipe.
@@ -85,25 +92,6 @@ func (s *Stream) Attach(ctx context.Context, cfg *AttachConfig) <-chan error {
 		stdout, stderr io.ReadCloser
 	)
 
-	if cfg.UseStdin {
-		group.Go(func() error {
-			log.With(nil).Debug("start to attach stdin to stream")
-			defer log.With(nil).Debug("stop attach stdin to stream")
-
-			defer func() {
-				if cfg.CloseStdin {
-					s.StdinPipe().Close()
-				}
-			}()
-
-			_, err := io.Copy(s.StdinPipe(), cfg.Stdin)
-			if err == io.ErrClosedPipe {
-				err = nil
-			}
-			return err
-		})
-	}
-
 	attachFn := func(styp string, w io.Writer, r io.ReadCloser) error {
 		log.With(nil).Debugf("start to attach %s to stream", styp)
 		defer log.With(nil).Debugf("stop attach %s to stream", styp)
@@ -141,6 +129,42 @@ func (s *Stream) Attach(ctx context.Context, cfg *AttachConfig) <-chan error {
 		})
 	}
 
+	if cfg.UseStdin {
+		var stdin io.Reader = cfg.Stdin
+		if len(cfg.DetachKeys) > 0 {
+			stdin = term.NewEscapeProxy(cfg.Stdin, cfg.DetachKeys)
+		}
+
+		group.Go(func() error {
+			log.With(nil).Debug("start to attach stdin to stream")
+			defer log.With(nil).Debug("stop attach stdin to stream")
+
+			_, err := io.Copy(s.StdinPipe(), stdin)
+			if err == term.ErrDetached {
+				log.With(nil).Debug("detach from stream by escape keys")
+
+				// NOTE: keep the stdin of process open, and stop
+				// attaching the stdout/stderr.
+				if cfg.UseStdout {
+					stdout.Close()
+				}
+				if cfg.UseStderr {
+					stderr.Close()
+				}
+				return err
+			}
+
+			if cfg.CloseStdin {
+				s.StdinPipe().Close()
+			}
+
+			if err == io.ErrClosedPipe {
+				err = nil
+			}
+			return err
+		})
+	}
+
 	var (
 		errCh      = make(chan error, 1)
 		groupErrCh = make(chan error, 1)
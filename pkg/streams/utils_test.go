@@ -5,6 +5,8 @@ import (
 	"context"
 	"io"
 	"testing"
+
+	"github.com/alibaba/pouch/pkg/term"
 )
 
 func TestAttachWithCloseStdin(t *testing.T) {
@@ -73,3 +75,49 @@ func TestAttachWithCloseStdin(t *testing.T) {
 		t.Fatalf("failed to stop stream: %v", err)
 	}
 }
+
+func TestAttachWithDetachKeys(t *testing.T) {
+	var (
+		aStdin  = &bufferWrapper{bytes.NewBufferString("hello\x10\x11world")}
+		aStdout = bytes.NewBuffer(nil)
+	)
+
+	attachCfg := &AttachConfig{
+		UseStdin:   true,
+		Stdin:      aStdin,
+		UseStdout:  true,
+		Stdout:     aStdout,
+		CloseStdin: true,
+		DetachKeys: []byte{16, 17},
+	}
+
+	stream := NewStream()
+	stream.NewStdinInput()
+
+	received := make(chan string, 1)
+	go func() {
+		buf := make([]byte, 5)
+		io.ReadFull(stream.Stdin(), buf)
+		received <- string(buf)
+	}()
+
+	attachErr := stream.Attach(context.Background(), attachCfg)
+	if err := <-attachErr; err != term.ErrDetached {
+		t.Fatalf("expected to be detached, but got %v", err)
+	}
+
+	if got := <-received; got != "hello" {
+		t.Fatalf("expected to get (hello) in stdin, but got (%s)", got)
+	}
+
+	// the stdin of process should not be closed after detached.
+	go stream.StdinPipe().Write([]byte("again"))
+	buf := make([]byte, 5)
+	if _, err := io.ReadFull(stream.Stdin(), buf); err != nil || string(buf) != "again" {
+		t.Fatalf("expected stdin to be open after detached, but got (%s, %v)", buf, err)
+	}
+
+	if err := stream.Close(); err != nil {
+		t.Fatalf("failed to stop stream: %v", err)
+	}
+}
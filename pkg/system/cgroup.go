@@ -2,6 +2,7 @@ package system
 
 import (
 	"bufio"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
@@ -51,6 +52,10 @@ type CgroupInfo struct {
 
 // NewCgroupInfo news a CgroupInfo struct
 func NewCgroupInfo() *CgroupInfo {
+	if IsCgroup2UnifiedMode() {
+		return getCgroupV2Info(CgroupV2Mountpoint)
+	}
+
 	cgroupRootPath := getCgroupRootMount("/proc/self/mountinfo")
 	if cgroupRootPath == "" {
 		return nil
@@ -105,6 +110,47 @@ func getPidsCgroupInfo(root string) *PidsCgroupInfo {
 	}
 }
 
+// getCgroupV2Info gets the cgroup information by the controllers enabled in
+// cgroup v2 root. Swappiness and oom_kill_disable are not supported by v2.
+func getCgroupV2Info(root string) *CgroupInfo {
+	data, err := ioutil.ReadFile(path.Join(root, "cgroup.controllers"))
+	if err != nil {
+		return nil
+	}
+
+	controllers := map[string]bool{}
+	for _, c := range strings.Fields(string(data)) {
+		controllers[c] = true
+	}
+
+	memory, cpu, cpuset, io := controllers["memory"], controllers["cpu"], controllers["cpuset"], controllers["io"]
+	return &CgroupInfo{
+		Memory: &MemoryCgroupInfo{
+			MemoryLimit:       memory,
+			MemoryReservation: memory,
+			MemorySwap:        memory,
+		},
+		CPU: &CPUCgroupInfo{
+			CpusetCpus: cpuset,
+			CpusetMems: cpuset,
+			CPUShares:  cpu,
+			CPUQuota:   cpu,
+			CPUPeriod:  cpu,
+		},
+		Blkio: &BlkioCgroupInfo{
+			BlkioWeight:          io,
+			BlkioWeightDevice:    io,
+			BlkioDeviceReadBps:   io,
+			BlkioDeviceWriteBps:  io,
+			BlkioDeviceReadIOps:  io,
+			BlkioDeviceWriteIOps: io,
+		},
+		Pids: &PidsCgroupInfo{
+			Pids: controllers["pids"],
+		},
+	}
+}
+
 func isCgroupEnable(f ...string) bool {
 	_, exist := os.Stat(path.Join(f...))
 	return exist == nil
@@ -0,0 +1,444 @@
+package system
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+// CgroupV2Mountpoint is the mountpoint of cgroup v2 unified hierarchy.
+const CgroupV2Mountpoint = "/sys/fs/cgroup"
+
+var (
+	cgroup2Once sync.Once
+	cgroup2Mode bool
+)
+
+// IsCgroup2UnifiedMode checks whether the host mounts cgroup v2 unified
+// hierarchy on /sys/fs/cgroup.
+func IsCgroup2UnifiedMode() bool {
+	cgroup2Once.Do(func() {
+		var st unix.Statfs_t
+		if err := unix.Statfs(CgroupV2Mountpoint, &st); err != nil {
+			return
+		}
+		cgroup2Mode = st.Type == unix.CGROUP2_SUPER_MAGIC
+	})
+	return cgroup2Mode
+}
+
+// CgroupV2Path converts the cgroups path in OCI spec into the absolute
+// directory in cgroup v2 hierarchy.
+//
+// The systemd cgroups path is in form of slice:prefix:name, which is
+// placed at /sys/fs/cgroup/<expanded slice>/<prefix>-<name>.scope.
+func CgroupV2Path(cgroupsPath string, useSystemd bool) (string, error) {
+	if !useSystemd {
+		return filepath.Join(CgroupV2Mountpoint, filepath.Clean("/"+cgroupsPath)), nil
+	}
+
+	parts := strings.Split(cgroupsPath, ":")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("invalid systemd cgroups path %s, should be slice:prefix:name", cgroupsPath)
+	}
+
+	slice, err := expandSlice(parts[0])
+	if err != nil {
+		return "", err
+	}
+
+	unit := parts[2]
+	if parts[1] != "" {
+		unit = parts[1] + "-" + unit
+	}
+	if !strings.HasSuffix(unit, ".slice") {
+		unit += ".scope"
+	}
+	return filepath.Join(CgroupV2Mountpoint, slice, unit), nil
+}
+
+// expandSlice expands the systemd slice name into path, a-b.slice is
+// placed at /a.slice/a-b.slice.
+func expandSlice(slice string) (string, error) {
+	if slice == "" || slice == "-.slice" {
+		return "/", nil
+	}
+
+	if !strings.HasSuffix(slice, ".slice") || strings.Contains(slice, "/") {
+		return "", fmt.Errorf("invalid systemd slice %s", slice)
+	}
+
+	var (
+		path   string
+		prefix string
+	)
+	for _, component := range strings.Split(strings.TrimSuffix(slice, ".slice"), "-") {
+		if component == "" {
+			return "", fmt.Errorf("invalid systemd slice %s", slice)
+		}
+		path += "/" + prefix + component + ".slice"
+		prefix += component + "-"
+	}
+	return path, nil
+}
+
+// WriteCgroupV2Files writes the values into the controller files of cgroup
+// v2 directory, the key of values is the file name like memory.max. The
+// value with multiple lines is written line by line, since the nested keyed
+// file like io.max only accepts one device in each write.
+func WriteCgroupV2Files(dir string, values map[string]string) error {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		for _, line := range strings.Split(values[k], "\n") {
+			if line == "" {
+				continue
+			}
+			if err := ioutil.WriteFile(filepath.Join(dir, k), []byte(line), 0); err != nil {
+				return fmt.Errorf("failed to write %q into %s: %v", line, filepath.Join(dir, k), err)
+			}
+		}
+	}
+	return nil
+}
+
+// CgroupV2Metrics is the metrics of cgroup v2 controllers. The stat of
+// controller is nil if the controller is not enabled.
+type CgroupV2Metrics struct {
+	Pids   *CgroupV2PidsStat
+	CPU    *CgroupV2CPUStat
+	Memory *CgroupV2MemoryStat
+	IO     *CgroupV2IOStat
+}
+
+// CgroupV2PidsStat is the stat of pids controller.
+type CgroupV2PidsStat struct {
+	Current uint64
+	// Limit is zero if there is no limit.
+	Limit uint64
+}
+
+// CgroupV2CPUStat is the stat of cpu controller, the time is in microseconds.
+type CgroupV2CPUStat struct {
+	UsageUsec     uint64
+	UserUsec      uint64
+	SystemUsec    uint64
+	NrPeriods     uint64
+	NrThrottled   uint64
+	ThrottledUsec uint64
+	Pressure      *Pressure
+}
+
+// CgroupV2MemoryStat is the stat of memory controller. The limits are
+// math.MaxUint64 if there is no limit.
+type CgroupV2MemoryStat struct {
+	Usage     uint64
+	Limit     uint64
+	High      uint64
+	SwapUsage uint64
+	SwapLimit uint64
+	// Events is the content of memory.events, like max and oom_kill.
+	Events map[string]uint64
+	// Stats is the content of memory.stat.
+	Stats    map[string]uint64
+	Pressure *Pressure
+}
+
+// CgroupV2IOEntry is the io.stat of one device.
+type CgroupV2IOEntry struct {
+	Major  uint64
+	Minor  uint64
+	Rbytes uint64
+	Wbytes uint64
+	Rios   uint64
+	Wios   uint64
+}
+
+// CgroupV2IOStat is the stat of io controller.
+type CgroupV2IOStat struct {
+	Entries  []CgroupV2IOEntry
+	Pressure *Pressure
+}
+
+// Pressure is the pressure stall information of a resource.
+type Pressure struct {
+	Some *PressureData
+	Full *PressureData
+}
+
+// PressureData is one line of the pressure file.
+type PressureData struct {
+	Avg10  float64
+	Avg60  float64
+	Avg300 float64
+	Total  uint64
+}
+
+// ReadCgroupV2Metrics reads the metrics from the cgroup v2 directory.
+func ReadCgroupV2Metrics(dir string) (*CgroupV2Metrics, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+
+	m := &CgroupV2Metrics{}
+	var err error
+	if m.Pids, err = readCgroupV2Pids(dir); err != nil {
+		return nil, err
+	}
+	if m.CPU, err = readCgroupV2CPU(dir); err != nil {
+		return nil, err
+	}
+	if m.Memory, err = readCgroupV2Memory(dir); err != nil {
+		return nil, err
+	}
+	if m.IO, err = readCgroupV2IO(dir); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func readCgroupV2Pids(dir string) (*CgroupV2PidsStat, error) {
+	current, err := readCgroupV2Uint(dir, "pids.current")
+	if err != nil {
+		return nil, ignoreNotExist(err)
+	}
+
+	limit, err := readCgroupV2Uint(dir, "pids.max")
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if limit == math.MaxUint64 {
+		limit = 0
+	}
+	return &CgroupV2PidsStat{Current: current, Limit: limit}, nil
+}
+
+func readCgroupV2CPU(dir string) (*CgroupV2CPUStat, error) {
+	kv, err := readCgroupV2KV(dir, "cpu.stat")
+	if err != nil {
+		return nil, ignoreNotExist(err)
+	}
+
+	pressure, err := readCgroupV2Pressure(dir, "cpu.pressure")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CgroupV2CPUStat{
+		UsageUsec:     kv["usage_usec"],
+		UserUsec:      kv["user_usec"],
+		SystemUsec:    kv["system_usec"],
+		NrPeriods:     kv["nr_periods"],
+		NrThrottled:   kv["nr_throttled"],
+		ThrottledUsec: kv["throttled_usec"],
+		Pressure:      pressure,
+	}, nil
+}
+
+func readCgroupV2Memory(dir string) (*CgroupV2MemoryStat, error) {
+	usage, err := readCgroupV2Uint(dir, "memory.current")
+	if err != nil {
+		return nil, ignoreNotExist(err)
+	}
+
+	m := &CgroupV2MemoryStat{Usage: usage}
+	for file, v := range map[string]*uint64{
+		"memory.max":          &m.Limit,
+		"memory.high":         &m.High,
+		"memory.swap.current": &m.SwapUsage,
+		"memory.swap.max":     &m.SwapLimit,
+	} {
+		if *v, err = readCgroupV2Uint(dir, file); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	if m.Stats, err = readCgroupV2KV(dir, "memory.stat"); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if m.Events, err = readCgroupV2KV(dir, "memory.events"); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if m.Pressure, err = readCgroupV2Pressure(dir, "memory.pressure"); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func readCgroupV2IO(dir string) (*CgroupV2IOStat, error) {
+	lines, err := readCgroupV2Lines(dir, "io.stat")
+	if err != nil {
+		return nil, ignoreNotExist(err)
+	}
+
+	stat := &CgroupV2IOStat{}
+	for _, line := range lines {
+		// 8:0 rbytes=1024 wbytes=0 rios=1 wios=0 dbytes=0 dios=0
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		var entry CgroupV2IOEntry
+		if _, err := fmt.Sscanf(fields[0], "%d:%d", &entry.Major, &entry.Minor); err != nil {
+			return nil, fmt.Errorf("invalid device %s in io.stat: %v", fields[0], err)
+		}
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			v, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %s in io.stat: %v", field, err)
+			}
+			switch kv[0] {
+			case "rbytes":
+				entry.Rbytes = v
+			case "wbytes":
+				entry.Wbytes = v
+			case "rios":
+				entry.Rios = v
+			case "wios":
+				entry.Wios = v
+			}
+		}
+		stat.Entries = append(stat.Entries, entry)
+	}
+
+	if stat.Pressure, err = readCgroupV2Pressure(dir, "io.pressure"); err != nil {
+		return nil, err
+	}
+	return stat, nil
+}
+
+// readCgroupV2Pressure reads the PSI file, returns nil if the kernel
+// doesn't support PSI.
+func readCgroupV2Pressure(dir, file string) (*Pressure, error) {
+	lines, err := readCgroupV2Lines(dir, file)
+	if err != nil {
+		// PSI file can't be read if the kernel is booted with psi=0.
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		if pe, ok := err.(*os.PathError); ok && pe.Err == unix.EOPNOTSUPP {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	p := &Pressure{}
+	for _, line := range lines {
+		// some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		data := &PressureData{}
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+
+			var err error
+			switch kv[0] {
+			case "avg10":
+				data.Avg10, err = strconv.ParseFloat(kv[1], 64)
+			case "avg60":
+				data.Avg60, err = strconv.ParseFloat(kv[1], 64)
+			case "avg300":
+				data.Avg300, err = strconv.ParseFloat(kv[1], 64)
+			case "total":
+				data.Total, err = strconv.ParseUint(kv[1], 10, 64)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %s in %s: %v", field, file, err)
+			}
+		}
+
+		switch fields[0] {
+		case "some":
+			p.Some = data
+		case "full":
+			p.Full = data
+		}
+	}
+	return p, nil
+}
+
+// readCgroupV2Uint reads the single value file, max is math.MaxUint64.
+func readCgroupV2Uint(dir, file string) (uint64, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, file))
+	if err != nil {
+		return 0, err
+	}
+	return parseCgroupV2Uint(strings.TrimSpace(string(data)))
+}
+
+// readCgroupV2KV reads the flat keyed file, like memory.stat.
+func readCgroupV2KV(dir, file string) (map[string]uint64, error) {
+	lines, err := readCgroupV2Lines(dir, file)
+	if err != nil {
+		return nil, err
+	}
+
+	kv := make(map[string]uint64, len(lines))
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		v, err := parseCgroupV2Uint(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %s in %s: %v", line, file, err)
+		}
+		kv[fields[0]] = v
+	}
+	return kv, nil
+}
+
+func readCgroupV2Lines(dir, file string) ([]string, error) {
+	f, err := os.Open(filepath.Join(dir, file))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+func parseCgroupV2Uint(s string) (uint64, error) {
+	if s == "max" {
+		return math.MaxUint64, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+func ignoreNotExist(err error) error {
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,144 @@
+package system
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCgroupV2Path(t *testing.T) {
+	for _, tc := range []struct {
+		cgroupsPath string
+		useSystemd  bool
+		expected    string
+		hasErr      bool
+	}{
+		{"/default/abc", false, "/sys/fs/cgroup/default/abc", false},
+		{"default/abc", false, "/sys/fs/cgroup/default/abc", false},
+		{"system.slice:pouch:abc", true, "/sys/fs/cgroup/system.slice/pouch-abc.scope", false},
+		{"user-1000.slice:pouch:abc", true, "/sys/fs/cgroup/user.slice/user-1000.slice/pouch-abc.scope", false},
+		{"-.slice::abc", true, "/sys/fs/cgroup/abc.scope", false},
+		{"system.slice:abc", true, "", true},
+		{"system:pouch:abc", true, "", true},
+		{"a--b.slice:pouch:abc", true, "", true},
+	} {
+		path, err := CgroupV2Path(tc.cgroupsPath, tc.useSystemd)
+		if tc.hasErr {
+			assert.Error(t, err, tc.cgroupsPath)
+			continue
+		}
+		assert.NoError(t, err, tc.cgroupsPath)
+		assert.Equal(t, tc.expected, path)
+	}
+}
+
+func writeCgroupFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestReadCgroupV2Metrics(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cgroup2")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeCgroupFiles(t, dir, map[string]string{
+		"pids.current":   "3\n",
+		"pids.max":       "max\n",
+		"cpu.stat":       "usage_usec 2000\nuser_usec 1500\nsystem_usec 500\nnr_periods 10\nnr_throttled 2\nthrottled_usec 300\n",
+		"cpu.pressure":   "some avg10=1.50 avg60=0.20 avg300=0.00 total=12345\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=0\n",
+		"memory.current": "4096\n",
+		"memory.max":     "8192\n",
+		"memory.high":    "max\n",
+		"memory.stat":    "anon 1024\nfile 2048\ninactive_file 512\n",
+		"memory.events":  "low 0\nhigh 1\nmax 2\noom 0\noom_kill 0\n",
+		"io.stat":        "8:0 rbytes=1024 wbytes=2048 rios=1 wios=2 dbytes=0 dios=0\n",
+	})
+
+	m, err := ReadCgroupV2Metrics(dir)
+	assert.NoError(t, err)
+
+	assert.Equal(t, &CgroupV2PidsStat{Current: 3, Limit: 0}, m.Pids)
+
+	assert.Equal(t, uint64(2000), m.CPU.UsageUsec)
+	assert.Equal(t, uint64(300), m.CPU.ThrottledUsec)
+	assert.Equal(t, &PressureData{Avg10: 1.5, Avg60: 0.2, Total: 12345}, m.CPU.Pressure.Some)
+	assert.Equal(t, &PressureData{}, m.CPU.Pressure.Full)
+
+	assert.Equal(t, uint64(4096), m.Memory.Usage)
+	assert.Equal(t, uint64(8192), m.Memory.Limit)
+	assert.Equal(t, uint64(math.MaxUint64), m.Memory.High)
+	assert.Equal(t, uint64(512), m.Memory.Stats["inactive_file"])
+	assert.Equal(t, uint64(2), m.Memory.Events["max"])
+	// the kernel doesn't support PSI.
+	assert.Nil(t, m.Memory.Pressure)
+
+	assert.Equal(t, []CgroupV2IOEntry{{Major: 8, Minor: 0, Rbytes: 1024, Wbytes: 2048, Rios: 1, Wios: 2}}, m.IO.Entries)
+
+	// the controllers are not enabled.
+	empty, err := ioutil.TempDir("", "cgroup2")
+	assert.NoError(t, err)
+	defer os.RemoveAll(empty)
+
+	m, err = ReadCgroupV2Metrics(empty)
+	assert.NoError(t, err)
+	assert.Equal(t, &CgroupV2Metrics{}, m)
+
+	_, err = ReadCgroupV2Metrics(filepath.Join(empty, "not-exist"))
+	assert.Error(t, err)
+}
+
+func TestWriteCgroupV2Files(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cgroup2")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeCgroupFiles(t, dir, map[string]string{"memory.max": "", "io.max": ""})
+
+	assert.NoError(t, WriteCgroupV2Files(dir, map[string]string{
+		"memory.max": "1024",
+		"io.max":     "8:0 rbps=1\n8:16 wbps=2",
+	}))
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "memory.max"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1024", string(data))
+
+	// the regular file only keeps the last write.
+	data, err = ioutil.ReadFile(filepath.Join(dir, "io.max"))
+	assert.NoError(t, err)
+	assert.Equal(t, "8:16 wbps=2", string(data))
+}
+
+func TestGetCgroupV2Info(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cgroup2")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, getCgroupV2Info(dir))
+
+	writeCgroupFiles(t, dir, map[string]string{"cgroup.controllers": "cpuset cpu io memory pids\n"})
+	info := getCgroupV2Info(dir)
+	assert.True(t, info.Memory.MemoryLimit)
+	assert.True(t, info.Memory.MemorySwap)
+	assert.False(t, info.Memory.MemorySwappiness)
+	assert.False(t, info.Memory.OOMKillDisable)
+	assert.True(t, info.CPU.CpusetCpus)
+	assert.True(t, info.CPU.CPUQuota)
+	assert.True(t, info.Blkio.BlkioWeight)
+	assert.True(t, info.Pids.Pids)
+
+	writeCgroupFiles(t, dir, map[string]string{"cgroup.controllers": "memory\n"})
+	info = getCgroupV2Info(dir)
+	assert.True(t, info.Memory.MemoryLimit)
+	assert.False(t, info.CPU.CPUShares)
+	assert.False(t, info.Blkio.BlkioWeight)
+	assert.False(t, info.Pids.Pids)
+}
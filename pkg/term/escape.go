@@ -0,0 +1,124 @@
+package term
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrDetached is returned by the reader of escape proxy when the escape
+// keys are read.
+var ErrDetached = errors.New("detached by escape keys")
+
+// ToBytes converts the escape keys into bytes. The keys are separated by
+// comma, and each key is either a single character or `ctrl-<value>`, where
+// value is a letter or one of `@`, `[`, `\`, `]`, `^` and `_`, such as
+// `ctrl-p,ctrl-q`.
+func ToBytes(keys string) ([]byte, error) {
+	var codes []byte
+
+	for _, key := range strings.Split(keys, ",") {
+		key = strings.TrimSpace(key)
+
+		if len(key) == 1 {
+			codes = append(codes, key[0])
+			continue
+		}
+
+		lower := strings.ToLower(key)
+		if !strings.HasPrefix(lower, "ctrl-") || len(lower) != len("ctrl-")+1 {
+			return nil, fmt.Errorf("invalid escape key %q", key)
+		}
+
+		c := lower[len(lower)-1]
+		switch {
+		case c >= 'a' && c <= 'z':
+			codes = append(codes, c-'a'+1)
+		case c == '@':
+			codes = append(codes, 0)
+		case c >= '[' && c <= '_':
+			codes = append(codes, c-'['+27)
+		default:
+			return nil, fmt.Errorf("invalid escape key %q", key)
+		}
+	}
+
+	return codes, nil
+}
+
+// escapeProxy reads from the underlying reader until the escape keys are
+// read. The keys partially matched are held back, and passed through if
+// the following key doesn't match.
+type escapeProxy struct {
+	r    io.Reader
+	keys []byte
+
+	// matched is the number of escape keys matched.
+	matched int
+
+	// pending is the data which has been read but not returned.
+	pending []byte
+	err     error
+}
+
+// NewEscapeProxy returns a reader which returns ErrDetached once the escape
+// keys are read from r, the escape keys are not returned.
+func NewEscapeProxy(r io.Reader, keys []byte) io.Reader {
+	return &escapeProxy{
+		r:    r,
+		keys: keys,
+	}
+}
+
+// Read implements io.Reader.
+func (p *escapeProxy) Read(b []byte) (int, error) {
+	if len(p.pending) == 0 && p.err == nil {
+		p.fill(len(b))
+	}
+
+	n := copy(b, p.pending)
+	p.pending = p.pending[n:]
+	if len(p.pending) == 0 && p.err != nil {
+		return n, p.err
+	}
+	return n, nil
+}
+
+// fill reads from the underlying reader and filters the escape keys.
+func (p *escapeProxy) fill(size int) {
+	if size == 0 {
+		return
+	}
+
+	buf := make([]byte, size)
+	n, err := p.r.Read(buf)
+
+	for _, c := range buf[:n] {
+		if c == p.keys[p.matched] {
+			p.matched++
+			if p.matched == len(p.keys) {
+				p.err = ErrDetached
+				return
+			}
+			continue
+		}
+
+		// the partially matched keys are normal input.
+		if p.matched > 0 {
+			p.pending = append(p.pending, p.keys[:p.matched]...)
+			p.matched = 0
+			if c == p.keys[0] {
+				p.matched = 1
+				continue
+			}
+		}
+		p.pending = append(p.pending, c)
+	}
+
+	if err != nil {
+		p.pending = append(p.pending, p.keys[:p.matched]...)
+		p.matched = 0
+		p.err = err
+	}
+}
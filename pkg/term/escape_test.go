@@ -0,0 +1,57 @@
+package term
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToBytes(t *testing.T) {
+	for _, tc := range []struct {
+		keys     string
+		expected []byte
+		err      bool
+	}{
+		{keys: "ctrl-p,ctrl-q", expected: []byte{16, 17}},
+		{keys: "ctrl-P, a", expected: []byte{16, 'a'}},
+		{keys: "ctrl-@,ctrl-[,ctrl-_", expected: []byte{0, 27, 31}},
+		{keys: "ctrl-1", err: true},
+		{keys: "ab", err: true},
+		{keys: "", err: true},
+	} {
+		codes, err := ToBytes(tc.keys)
+		if tc.err {
+			assert.Error(t, err, tc.keys)
+			continue
+		}
+		assert.NoError(t, err, tc.keys)
+		assert.Equal(t, tc.expected, codes, tc.keys)
+	}
+}
+
+func TestEscapeProxy(t *testing.T) {
+	keys := []byte{16, 17}
+
+	for _, tc := range []struct {
+		input    []byte
+		expected []byte
+		err      error
+	}{
+		{input: []byte("hello"), expected: []byte("hello"), err: nil},
+		{input: []byte("hi\x10\x11world"), expected: []byte("hi"), err: ErrDetached},
+		{input: []byte("a\x10b\x10\x10\x11"), expected: []byte("a\x10b\x10"), err: ErrDetached},
+		{input: []byte("end\x10"), expected: []byte("end\x10"), err: nil},
+	} {
+		out, err := ioutil.ReadAll(NewEscapeProxy(bytes.NewReader(tc.input), keys))
+		assert.Equal(t, tc.err, err, string(tc.input))
+		assert.Equal(t, tc.expected, out, string(tc.input))
+
+		// the escape keys may be split in reads.
+		out, err = ioutil.ReadAll(NewEscapeProxy(iotest.OneByteReader(bytes.NewReader(tc.input)), keys))
+		assert.Equal(t, tc.err, err, string(tc.input))
+		assert.Equal(t, tc.expected, out, string(tc.input))
+	}
+}
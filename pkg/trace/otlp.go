@@ -0,0 +1,268 @@
+package trace
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+)
+
+// instrumentationScope is the name of instrumentation scope of the spans.
+const instrumentationScope = "github.com/alibaba/pouch"
+
+// otlpExporter exports the spans to OTLP/HTTP endpoint in JSON batches. The
+// spans are dropped if the queue is full or the export request fails, so
+// that tracing never blocks the requests.
+type otlpExporter struct {
+	config   Config
+	client   *http.Client
+	resource otlpResource
+
+	queue    chan *Span
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func newOTLPExporter(config Config) *otlpExporter {
+	attributes := []otlpKeyValue{otlpAttribute("service.name", config.ServiceName)}
+	if hostname, err := os.Hostname(); err == nil {
+		attributes = append(attributes, otlpAttribute("host.name", hostname))
+	}
+
+	e := &otlpExporter{
+		config:   config,
+		client:   &http.Client{Timeout: time.Duration(config.Timeout) * time.Second},
+		resource: otlpResource{Attributes: attributes},
+		queue:    make(chan *Span, config.MaxQueueSize),
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+	go e.run()
+	return e
+}
+
+func (e *otlpExporter) export(s *Span) {
+	select {
+	case e.queue <- s:
+	default:
+		log.With(nil).Debugf("tracing queue is full, drop span %s", s.name)
+	}
+}
+
+func (e *otlpExporter) shutdown(ctx context.Context) error {
+	e.stopOnce.Do(func() {
+		close(e.stopCh)
+	})
+
+	select {
+	case <-e.doneCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run collects the spans into batches, and exports a batch when it's full
+// or the flush interval passes.
+func (e *otlpExporter) run() {
+	defer close(e.doneCh)
+
+	ticker := time.NewTicker(time.Duration(e.config.FlushInterval) * time.Second)
+	defer ticker.Stop()
+
+	var batch []*Span
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := e.send(batch); err != nil {
+			log.With(nil).Warnf("failed to export %d spans to %s: %v", len(batch), e.config.Endpoint, err)
+		}
+		batch = nil
+	}
+
+	for {
+		select {
+		case s := <-e.queue:
+			batch = append(batch, s)
+			if len(batch) >= e.config.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-e.stopCh:
+			for {
+				select {
+				case s := <-e.queue:
+					batch = append(batch, s)
+					if len(batch) >= e.config.BatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// send posts a batch of spans to the endpoint.
+func (e *otlpExporter) send(batch []*Span) error {
+	spans := make([]otlpSpan, 0, len(batch))
+	for _, s := range batch {
+		spans = append(spans, newOTLPSpan(s))
+	}
+
+	body, err := json.Marshal(otlpTracesData{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: e.resource,
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: instrumentationScope},
+				Spans: spans,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// The types below are the JSON encoding of OTLP ExportTraceServiceRequest.
+
+type otlpTracesData struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	TraceState        string         `json:"traceState,omitempty"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              SpanKind       `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+// otlpStatusCodeError is the STATUS_CODE_ERROR of OTLP, the status of the
+// successful spans is left unset.
+const otlpStatusCodeError = 2
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+func newOTLPSpan(s *Span) otlpSpan {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	span := otlpSpan{
+		TraceID:           s.context.TraceID.String(),
+		SpanID:            s.context.SpanID.String(),
+		TraceState:        s.context.TraceState,
+		Name:              s.name,
+		Kind:              s.kind,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+	}
+	if s.parentID.IsValid() {
+		span.ParentSpanID = s.parentID.String()
+	}
+	for k, v := range s.attributes {
+		span.Attributes = append(span.Attributes, otlpAttribute(k, v))
+	}
+	if s.failed {
+		span.Status = otlpStatus{Code: otlpStatusCodeError, Message: s.errMessage}
+	}
+	return span
+}
+
+func otlpAttribute(key string, value interface{}) otlpKeyValue {
+	var v otlpAnyValue
+	switch val := value.(type) {
+	case string:
+		v.StringValue = &val
+	case bool:
+		v.BoolValue = &val
+	case int:
+		i := strconv.Itoa(val)
+		v.IntValue = &i
+	case int32:
+		i := strconv.FormatInt(int64(val), 10)
+		v.IntValue = &i
+	case int64:
+		i := strconv.FormatInt(val, 10)
+		v.IntValue = &i
+	case float64:
+		v.DoubleValue = &val
+	default:
+		s := fmt.Sprintf("%v", val)
+		v.StringValue = &s
+	}
+	return otlpKeyValue{Key: key, Value: v}
+}
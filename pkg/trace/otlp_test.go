@@ -0,0 +1,64 @@
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOTLPExporter(t *testing.T) {
+	received := make(chan otlpTracesData, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/traces", req.URL.Path)
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+
+		var data otlpTracesData
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&data))
+		received <- data
+	}))
+	defer server.Close()
+
+	tracer, err := New(Config{
+		Endpoint:    server.URL,
+		SampleRatio: 1,
+		Headers:     map[string]string{"Authorization": "Bearer token"},
+	})
+	assert.NoError(t, err)
+
+	ctx, parent := tracer.Start(context.Background(), "POST /containers/create", WithKind(SpanKindServer))
+	_, child := tracer.Start(ctx, "ContainerManager.Create", WithAttribute("container.name", "foo"))
+	child.Finish(fmt.Errorf("no such image"))
+	parent.End()
+
+	// the spans in queue are exported when tracer is shutdown.
+	assert.NoError(t, tracer.Shutdown(context.Background()))
+
+	data := <-received
+	if !assert.Len(t, data.ResourceSpans, 1) || !assert.Len(t, data.ResourceSpans[0].ScopeSpans, 1) {
+		return
+	}
+	assert.Equal(t, "service.name", data.ResourceSpans[0].Resource.Attributes[0].Key)
+	assert.Equal(t, "pouchd", *data.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+
+	spans := data.ResourceSpans[0].ScopeSpans[0].Spans
+	if !assert.Len(t, spans, 2) {
+		return
+	}
+	assert.Equal(t, "ContainerManager.Create", spans[0].Name)
+	assert.Equal(t, SpanKindInternal, spans[0].Kind)
+	assert.Equal(t, parent.SpanContext().SpanID.String(), spans[0].ParentSpanID)
+	assert.Equal(t, parent.SpanContext().TraceID.String(), spans[0].TraceID)
+	assert.Equal(t, otlpStatus{Code: otlpStatusCodeError, Message: "no such image"}, spans[0].Status)
+	assert.Equal(t, []otlpKeyValue{otlpAttribute("container.name", "foo")}, spans[0].Attributes)
+
+	assert.Equal(t, "POST /containers/create", spans[1].Name)
+	assert.Equal(t, SpanKindServer, spans[1].Kind)
+	assert.Empty(t, spans[1].ParentSpanID)
+	assert.Equal(t, otlpStatus{}, spans[1].Status)
+}
@@ -0,0 +1,143 @@
+package trace
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// TraceparentHeader is the header of W3C trace context, in the format of
+	// version-traceid-parentid-flags.
+	TraceparentHeader = "traceparent"
+
+	// TracestateHeader is the header of vendor specific trace states.
+	TracestateHeader = "tracestate"
+
+	traceparentVersion = "00"
+	flagSampled        = 0x01
+)
+
+// ParseTraceparent parses the traceparent header of W3C trace context.
+func ParseTraceparent(value string) (SpanContext, error) {
+	var sc SpanContext
+
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 {
+		return sc, fmt.Errorf("invalid traceparent %q", value)
+	}
+
+	// the future versions may append fields, but version ff is invalid.
+	version, err := hex.DecodeString(parts[0])
+	if err != nil || len(version) != 1 || version[0] == 0xff || (version[0] == 0 && len(parts) != 4) {
+		return sc, fmt.Errorf("invalid version of traceparent %q", value)
+	}
+
+	if len(parts[1]) != 32 || strings.ToLower(parts[1]) != parts[1] {
+		return sc, fmt.Errorf("invalid trace id of traceparent %q", value)
+	}
+	if _, err := hex.Decode(sc.TraceID[:], []byte(parts[1])); err != nil {
+		return sc, fmt.Errorf("invalid trace id of traceparent %q", value)
+	}
+
+	if len(parts[2]) != 16 || strings.ToLower(parts[2]) != parts[2] {
+		return sc, fmt.Errorf("invalid parent id of traceparent %q", value)
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(parts[2])); err != nil {
+		return sc, fmt.Errorf("invalid parent id of traceparent %q", value)
+	}
+
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil || len(flags) != 1 {
+		return sc, fmt.Errorf("invalid flags of traceparent %q", value)
+	}
+	sc.Sampled = flags[0]&flagSampled != 0
+
+	if !sc.IsValid() {
+		return sc, fmt.Errorf("invalid traceparent %q with zero id", value)
+	}
+	return sc, nil
+}
+
+// FormatTraceparent formats the span context as traceparent header.
+func FormatTraceparent(sc SpanContext) string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("%s-%s-%s-%s", traceparentVersion, sc.TraceID, sc.SpanID, flags)
+}
+
+// extract returns the context with the span context read by get, ctx is
+// returned as it is if there is no valid trace context.
+func extract(ctx context.Context, get func(string) string) context.Context {
+	value := get(TraceparentHeader)
+	if value == "" {
+		return ctx
+	}
+
+	sc, err := ParseTraceparent(value)
+	if err != nil {
+		return ctx
+	}
+	sc.TraceState = get(TracestateHeader)
+	return ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// ExtractHTTP returns the context with the trace context in http headers.
+func ExtractHTTP(ctx context.Context, header http.Header) context.Context {
+	return extract(ctx, header.Get)
+}
+
+// InjectHTTP sets the trace context of ctx in http headers.
+func InjectHTTP(ctx context.Context, header http.Header) {
+	sc := SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	header.Set(TraceparentHeader, FormatTraceparent(sc))
+	if sc.TraceState != "" {
+		header.Set(TracestateHeader, sc.TraceState)
+	}
+}
+
+// ExtractGRPC returns the context with the trace context in the incoming
+// grpc metadata.
+func ExtractGRPC(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return extract(ctx, func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	})
+}
+
+// InjectGRPC returns the context with the trace context of ctx in the
+// outgoing grpc metadata.
+func InjectGRPC(ctx context.Context) context.Context {
+	sc := SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return ctx
+	}
+
+	// replace the trace context of parent span, rather than append to it.
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	md.Set(TraceparentHeader, FormatTraceparent(sc))
+	if sc.TraceState != "" {
+		md.Set(TracestateHeader, sc.TraceState)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
@@ -0,0 +1,424 @@
+// Package trace records the spans of requests in the data model of
+// OpenTelemetry, propagates them in W3C trace context, and exports them to
+// an OTLP/HTTP endpoint.
+package trace
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	defaultServiceName   = "pouchd"
+	defaultTimeout       = 10
+	defaultBatchSize     = 512
+	defaultFlushInterval = 5
+	defaultMaxQueueSize  = 2048
+
+	// defaultTracesPath is the path of OTLP/HTTP traces endpoint.
+	defaultTracesPath = "/v1/traces"
+)
+
+// Config is the configuration of tracing.
+type Config struct {
+	// Endpoint is the OTLP/HTTP endpoint which the spans are exported to,
+	// such as http://127.0.0.1:4318, tracing is disabled if it's empty.
+	// The spans are posted to /v1/traces if the endpoint has no path.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// ServiceName is the service.name of the exported spans, pouchd by default.
+	ServiceName string `json:"service-name,omitempty"`
+
+	// SampleRatio is the ratio of the traces started by pouchd to be sampled,
+	// the traces from clients follow the sampled flag of their parents.
+	SampleRatio float64 `json:"sample-ratio,omitempty"`
+
+	// Headers are the extra http headers of export requests, such as
+	// authorization of the collector.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Timeout is the timeout in seconds of each export request.
+	Timeout int `json:"timeout,omitempty"`
+
+	// BatchSize is the max number of spans in an export request.
+	BatchSize int `json:"batch-size,omitempty"`
+
+	// FlushInterval is the max seconds that a span waits for the batch to be full.
+	FlushInterval int `json:"flush-interval,omitempty"`
+
+	// MaxQueueSize is the max number of spans waiting to be exported, the
+	// spans beyond it are dropped.
+	MaxQueueSize int `json:"max-queue-size,omitempty"`
+}
+
+// Enabled returns whether tracing is enabled.
+func (c *Config) Enabled() bool {
+	return c.Endpoint != ""
+}
+
+// Validate validates the tracing config, and fills the default values.
+func (c *Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	u, err := url.Parse(c.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid tracing endpoint (%s), must be http(s)://host[:port][/path]", c.Endpoint)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = defaultTracesPath
+		c.Endpoint = u.String()
+	}
+
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("invalid tracing sample ratio %v, must be in [0, 1]", c.SampleRatio)
+	}
+
+	for _, v := range []int{c.Timeout, c.BatchSize, c.FlushInterval, c.MaxQueueSize} {
+		if v < 0 {
+			return fmt.Errorf("timeout, batch-size, flush-interval and max-queue-size of tracing cannot be negative")
+		}
+	}
+
+	if c.ServiceName == "" {
+		c.ServiceName = defaultServiceName
+	}
+	if c.Timeout == 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.BatchSize == 0 {
+		c.BatchSize = defaultBatchSize
+	}
+	if c.FlushInterval == 0 {
+		c.FlushInterval = defaultFlushInterval
+	}
+	if c.MaxQueueSize == 0 {
+		c.MaxQueueSize = defaultMaxQueueSize
+	}
+	return nil
+}
+
+// TraceID identifies a trace.
+type TraceID [16]byte
+
+// IsValid returns whether the trace id is not all zero.
+func (id TraceID) IsValid() bool {
+	return id != TraceID{}
+}
+
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// SpanID identifies a span in trace.
+type SpanID [8]byte
+
+// IsValid returns whether the span id is not all zero.
+func (id SpanID) IsValid() bool {
+	return id != SpanID{}
+}
+
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// SpanContext is the part of span which is propagated across processes.
+type SpanContext struct {
+	TraceID    TraceID
+	SpanID     SpanID
+	Sampled    bool
+	TraceState string
+
+	// Remote represents the span context is extracted from a request.
+	Remote bool
+}
+
+// IsValid returns whether the span context has valid trace id and span id.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// SpanKind is the relationship between the span and its parent and children,
+// the values are the same as OTLP.
+type SpanKind int
+
+const (
+	// SpanKindInternal is an internal operation of pouchd.
+	SpanKindInternal SpanKind = 1
+	// SpanKindServer is a request handled by pouchd.
+	SpanKindServer SpanKind = 2
+	// SpanKindClient is a request sent by pouchd, such as the call to containerd.
+	SpanKindClient SpanKind = 3
+)
+
+// SpanOption sets the optional fields of span when it's started.
+type SpanOption func(*Span)
+
+// WithKind sets the kind of span, SpanKindInternal by default.
+func WithKind(kind SpanKind) SpanOption {
+	return func(s *Span) {
+		s.kind = kind
+	}
+}
+
+// WithAttribute sets an attribute of span.
+func WithAttribute(key string, value interface{}) SpanOption {
+	return func(s *Span) {
+		s.attributes[key] = value
+	}
+}
+
+// Span is a timed operation in trace. The methods of nil span do nothing,
+// so that the code is instrumented the same when tracing is disabled.
+type Span struct {
+	tracer   *Tracer
+	name     string
+	kind     SpanKind
+	context  SpanContext
+	parentID SpanID
+	start    time.Time
+
+	mu         sync.Mutex
+	end        time.Time
+	attributes map[string]interface{}
+	errMessage string
+	failed     bool
+	ended      bool
+}
+
+// SpanContext returns the span context.
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.context
+}
+
+// SetAttribute sets an attribute of span, the value should be string, bool,
+// integer or float, other values are recorded in the format of %v.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attributes[key] = value
+}
+
+// SetError marks the span failed with err.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failed, s.errMessage = true, err.Error()
+}
+
+// End ends the span, and exports it if it's sampled. The span can be ended
+// only once.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended, s.end = true, time.Now()
+	s.mu.Unlock()
+
+	if s.context.Sampled && s.tracer.exporter != nil {
+		s.tracer.exporter.export(s)
+	}
+}
+
+// Finish records err if it's not nil and ends the span, it's used in defer
+// with the named error of function.
+func (s *Span) Finish(err error) {
+	s.SetError(err)
+	s.End()
+}
+
+// spanExporter exports the ended spans.
+type spanExporter interface {
+	export(s *Span)
+	shutdown(ctx context.Context) error
+}
+
+// Tracer starts spans and exports them.
+type Tracer struct {
+	config   Config
+	exporter spanExporter
+
+	// sampleBound is the upper bound of the sampled trace ids, the same as
+	// TraceIDRatioBased sampler of OpenTelemetry.
+	sampleBound uint64
+}
+
+// New creates a tracer which exports spans to the OTLP endpoint in config.
+func New(config Config) (*Tracer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if !config.Enabled() {
+		return nil, fmt.Errorf("tracing endpoint is not set")
+	}
+	return newTracer(config, newOTLPExporter(config)), nil
+}
+
+func newTracer(config Config, exporter spanExporter) *Tracer {
+	return &Tracer{
+		config:      config,
+		exporter:    exporter,
+		sampleBound: uint64(config.SampleRatio * (1 << 63)),
+	}
+}
+
+// Start starts a span as the child of the span in ctx, and returns the
+// context with the span.
+func (t *Tracer) Start(ctx context.Context, name string, opts ...SpanOption) (context.Context, *Span) {
+	parent := SpanContextFromContext(ctx)
+
+	sc := SpanContext{SpanID: newSpanID()}
+	if parent.IsValid() {
+		sc.TraceID, sc.Sampled, sc.TraceState = parent.TraceID, parent.Sampled, parent.TraceState
+	} else {
+		sc.TraceID = newTraceID()
+		sc.Sampled = t.shouldSample(sc.TraceID)
+	}
+
+	span := &Span{
+		tracer:     t,
+		name:       name,
+		kind:       SpanKindInternal,
+		context:    sc,
+		parentID:   parent.SpanID,
+		start:      time.Now(),
+		attributes: map[string]interface{}{},
+	}
+	for _, opt := range opts {
+		opt(span)
+	}
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// shouldSample decides whether the new trace is sampled by its id.
+func (t *Tracer) shouldSample(id TraceID) bool {
+	if t.config.SampleRatio >= 1 {
+		return true
+	}
+	return binary.BigEndian.Uint64(id[8:16])>>1 < t.sampleBound
+}
+
+// Shutdown exports the spans in queue and stops the tracer.
+func (t *Tracer) Shutdown(ctx context.Context) error {
+	if t.exporter == nil {
+		return nil
+	}
+	return t.exporter.shutdown(ctx)
+}
+
+var (
+	globalMu sync.RWMutex
+	global   *Tracer
+)
+
+// Init creates the global tracer by config, tracing is disabled if the
+// endpoint of config is empty.
+func Init(config Config) error {
+	if !config.Enabled() {
+		return nil
+	}
+
+	t, err := New(config)
+	if err != nil {
+		return err
+	}
+
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	global = t
+	return nil
+}
+
+// Shutdown exports the spans in queue and stops the global tracer.
+func Shutdown(ctx context.Context) error {
+	globalMu.Lock()
+	t := global
+	global = nil
+	globalMu.Unlock()
+
+	if t == nil {
+		return nil
+	}
+	return t.Shutdown(ctx)
+}
+
+// StartSpan starts a span by the global tracer, it returns ctx and nil span
+// if tracing is disabled.
+func StartSpan(ctx context.Context, name string, opts ...SpanOption) (context.Context, *Span) {
+	globalMu.RLock()
+	t := global
+	globalMu.RUnlock()
+
+	if t == nil {
+		return ctx, nil
+	}
+	return t.Start(ctx, name, opts...)
+}
+
+type spanKey struct{}
+
+type remoteSpanContextKey struct{}
+
+// SpanFromContext returns the span in ctx, or nil if there is none.
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// SpanContextFromContext returns the span context of the span in ctx, or the
+// remote span context extracted from request.
+func SpanContextFromContext(ctx context.Context) SpanContext {
+	if span := SpanFromContext(ctx); span != nil {
+		return span.context
+	}
+	if ctx == nil {
+		return SpanContext{}
+	}
+	sc, _ := ctx.Value(remoteSpanContextKey{}).(SpanContext)
+	return sc
+}
+
+// ContextWithRemoteSpanContext returns the context with the span context
+// extracted from request, which is the parent of the spans started in ctx.
+func ContextWithRemoteSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	sc.Remote = true
+	return context.WithValue(ctx, remoteSpanContextKey{}, sc)
+}
+
+func newTraceID() (id TraceID) {
+	for !id.IsValid() {
+		rand.Read(id[:])
+	}
+	return id
+}
+
+func newSpanID() (id SpanID) {
+	for !id.IsValid() {
+		rand.Read(id[:])
+	}
+	return id
+}
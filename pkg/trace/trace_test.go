@@ -0,0 +1,152 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+type memoryExporter struct {
+	sync.Mutex
+	spans []*Span
+}
+
+func (e *memoryExporter) export(s *Span) {
+	e.Lock()
+	defer e.Unlock()
+	e.spans = append(e.spans, s)
+}
+
+func (e *memoryExporter) shutdown(ctx context.Context) error {
+	return nil
+}
+
+func TestConfigValidate(t *testing.T) {
+	c := Config{}
+	assert.NoError(t, c.Validate())
+	assert.False(t, c.Enabled())
+
+	c = Config{Endpoint: "http://127.0.0.1:4318", SampleRatio: 0.5}
+	assert.NoError(t, c.Validate())
+	assert.Equal(t, "http://127.0.0.1:4318/v1/traces", c.Endpoint)
+	assert.Equal(t, "pouchd", c.ServiceName)
+	assert.Equal(t, defaultBatchSize, c.BatchSize)
+
+	c = Config{Endpoint: "https://collector/otlp/traces"}
+	assert.NoError(t, c.Validate())
+	assert.Equal(t, "https://collector/otlp/traces", c.Endpoint)
+
+	for _, c := range []Config{
+		{Endpoint: "127.0.0.1:4318"},
+		{Endpoint: "grpc://127.0.0.1:4317"},
+		{Endpoint: "http://127.0.0.1:4318", SampleRatio: 1.5},
+		{Endpoint: "http://127.0.0.1:4318", BatchSize: -1},
+	} {
+		assert.Error(t, c.Validate(), c.Endpoint)
+	}
+}
+
+func TestTraceparent(t *testing.T) {
+	value := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	sc, err := ParseTraceparent(value)
+	assert.NoError(t, err)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", sc.SpanID.String())
+	assert.True(t, sc.Sampled)
+	assert.Equal(t, value, FormatTraceparent(sc))
+
+	sc, err = ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	assert.NoError(t, err)
+	assert.False(t, sc.Sampled)
+
+	// the future version may have more fields.
+	_, err = ParseTraceparent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra")
+	assert.NoError(t, err)
+
+	for _, v := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902bx-01",
+	} {
+		_, err := ParseTraceparent(v)
+		assert.Error(t, err, v)
+	}
+}
+
+func TestStartSpan(t *testing.T) {
+	exporter := &memoryExporter{}
+	tracer := newTracer(Config{SampleRatio: 1}, exporter)
+
+	header := http.Header{}
+	header.Set(TraceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	header.Set(TracestateHeader, "vendor=value")
+	ctx := ExtractHTTP(context.Background(), header)
+
+	ctx, parent := tracer.Start(ctx, "parent", WithKind(SpanKindServer))
+	_, child := tracer.Start(ctx, "child", WithAttribute("id", "c1"))
+	child.Finish(fmt.Errorf("failed"))
+	parent.Finish(nil)
+	parent.End()
+
+	assert.Len(t, exporter.spans, 2)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", parent.context.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", parent.parentID.String())
+	assert.Equal(t, "vendor=value", parent.context.TraceState)
+	assert.Equal(t, SpanKindServer, parent.kind)
+
+	assert.Equal(t, parent.context.TraceID, child.context.TraceID)
+	assert.Equal(t, parent.context.SpanID, child.parentID)
+	assert.Equal(t, "c1", child.attributes["id"])
+	assert.True(t, child.failed)
+	assert.Equal(t, "failed", child.errMessage)
+
+	// the trace context of the current span is propagated.
+	out := http.Header{}
+	InjectHTTP(ctx, out)
+	assert.Equal(t, FormatTraceparent(parent.context), out.Get(TraceparentHeader))
+	assert.Equal(t, "vendor=value", out.Get(TracestateHeader))
+
+	md, _ := metadata.FromOutgoingContext(InjectGRPC(InjectGRPC(ctx)))
+	assert.Equal(t, []string{FormatTraceparent(parent.context)}, md.Get(TraceparentHeader))
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+	assert.Equal(t, parent.context.SpanID, SpanContextFromContext(ExtractGRPC(ctx)).SpanID)
+}
+
+func TestSampling(t *testing.T) {
+	exporter := &memoryExporter{}
+	tracer := newTracer(Config{SampleRatio: 0}, exporter)
+
+	// the new traces are not sampled with ratio 0.
+	_, span := tracer.Start(context.Background(), "root")
+	assert.True(t, span.SpanContext().IsValid())
+	assert.False(t, span.SpanContext().Sampled)
+	span.End()
+	assert.Len(t, exporter.spans, 0)
+
+	// the sampled flag of parent is followed.
+	sc, _ := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	_, span = tracer.Start(ContextWithRemoteSpanContext(context.Background(), sc), "child")
+	span.End()
+	assert.Len(t, exporter.spans, 1)
+}
+
+func TestNilSpan(t *testing.T) {
+	ctx, span := StartSpan(context.Background(), "disabled")
+	assert.Nil(t, span)
+	assert.Nil(t, SpanFromContext(ctx))
+
+	span.SetAttribute("key", "value")
+	span.Finish(fmt.Errorf("failed"))
+	assert.False(t, span.SpanContext().IsValid())
+}
@@ -10,19 +10,19 @@ import (
 
 // acceptedFilters defines filter key ps support
 var acceptedFilters = map[string]bool{
-	"id":     true,
-	"label":  true,
-	"name":   true,
-	"status": true,
-
-	/*
-		// TODO(huamin.thm): the following list key should also support
-		"before":  true,
-		"since":   true,
-		"exited":  true,
-		"volume":  true,
-		"network": true,
-	*/
+	"id":       true,
+	"label":    true,
+	"name":     true,
+	"status":   true,
+	"ancestor": true,
+	"before":   true,
+	"since":    true,
+	"exited":   true,
+	"volume":   true,
+	"network":  true,
+	"health":   true,
+	"publish":  true,
+	"runtime":  true,
 }
 
 // getAcceptKeys gets all accepted filter keys
@@ -80,14 +80,14 @@ func TestValidate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "failure case with future filter name",
+			name: "successful case with before filter",
 			args: args{
 				filter: map[string][]string{
 					"id":     {"a"},
-					"before": {"b"}, // this will be implemented in the future.
+					"before": {"b"},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
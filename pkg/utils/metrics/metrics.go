@@ -68,6 +68,18 @@ func NewLabelGauge(subsystem, name, help string, labels ...string) *prometheus.G
 		}, labels)
 }
 
+// NewGauge return a new Gauge
+func NewGauge(subsystem, name, help string) prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   subsystem,
+			Name:        name,
+			Help:        help,
+			ConstLabels: nil,
+		})
+}
+
 // NewLabelTimer return a new HistogramVec
 func NewLabelTimer(subsystem, name, help string, labels ...string) *prometheus.HistogramVec {
 	return prometheus.NewHistogramVec(
@@ -3,6 +3,7 @@ package volume
 import (
 	"context"
 	"fmt"
+	"io"
 	"path"
 	"reflect"
 	"strings"
@@ -25,6 +26,26 @@ type Core struct {
 	lock  *kmutex.KMutex
 }
 
+// MetaConfig returns the config of volume metadata store in the boltdb file.
+func MetaConfig(metaPath string) metastore.Config {
+	return metastore.Config{
+		Driver:  "boltdb",
+		BaseDir: metaPath,
+		Buckets: []metastore.Bucket{
+			{
+				Name: "volume",
+				Type: reflect.TypeOf(types.Volume{}),
+			},
+		},
+	}
+}
+
+// Dump writes the metadata of all volumes into w, it can be loaded into the
+// metadata store of volumes by Load of pkg/meta.
+func (c *Core) Dump(w io.Writer) error {
+	return c.store.Dump(w)
+}
+
 // NewCore returns Core struct instance with volume config.
 func NewCore(cfg Config) (*Core, error) {
 	c := &Core{
@@ -48,16 +69,7 @@ func NewCore(cfg Config) (*Core, error) {
 	}
 
 	// initialize volume metadata store.
-	volumeStore, err := metastore.NewStore(metastore.Config{
-		Driver:  "boltdb",
-		BaseDir: cfg.VolumeMetaPath,
-		Buckets: []metastore.Bucket{
-			{
-				Name: "volume",
-				Type: reflect.TypeOf(types.Volume{}),
-			},
-		},
-	})
+	volumeStore, err := metastore.NewStore(MetaConfig(cfg.VolumeMetaPath))
 	if err != nil {
 		log.With(nil).Errorf("failed to create volume meta store: %v", err)
 		return nil, err
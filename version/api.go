@@ -0,0 +1,32 @@
+package version
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CompareAPIVersion compares the api versions in format major.minor, it
+// returns -1 if v1 is older than v2, 1 if v1 is newer than v2, and 0 if they
+// are equal. The missing or invalid parts are taken as 0.
+func CompareAPIVersion(v1, v2 string) int {
+	p1 := strings.Split(strings.TrimPrefix(v1, "v"), ".")
+	p2 := strings.Split(strings.TrimPrefix(v2, "v"), ".")
+
+	for i := 0; i < len(p1) || i < len(p2); i++ {
+		var n1, n2 int
+		if i < len(p1) {
+			n1, _ = strconv.Atoi(p1[i])
+		}
+		if i < len(p2) {
+			n2, _ = strconv.Atoi(p2[i])
+		}
+
+		if n1 < n2 {
+			return -1
+		}
+		if n1 > n2 {
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,25 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareAPIVersion(t *testing.T) {
+	for _, tc := range []struct {
+		v1, v2   string
+		expected int
+	}{
+		{"1.24", "1.24", 0},
+		{"v1.24", "1.24", 0},
+		{"1.24", "1.25", -1},
+		{"1.25", "1.24", 1},
+		{"1.9", "1.10", -1},
+		{"1.24.1", "1.24", 1},
+		{"1.24.0", "1.24", 0},
+		{"2.0", "1.99", 1},
+	} {
+		assert.Equal(t, tc.expected, CompareAPIVersion(tc.v1, tc.v2), "%s %s", tc.v1, tc.v2)
+	}
+}
@@ -9,7 +9,10 @@ var (
 	BuildTime = "unknown"
 
 	// APIVersion means the api version daemon serves
-	APIVersion = "1.24"
+	APIVersion = "1.25"
+
+	// MinAPIVersion is the oldest api version daemon serves
+	MinAPIVersion = "1.24"
 
 	// GitCommit is the commit id to build Pouch
 	GitCommit = "unknown"